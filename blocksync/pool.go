@@ -23,6 +23,8 @@ const (
    requestRetrySeconds = 30
    minRecvRate = 7680
    maxDiffBetweenCurrentAndReceivedBlockHeight = 100
+   // nominal block size used to weigh a peer's RTT against its receive rate
+   peerCostBlockSize = 64 * 1024
 )
 
 var peerTimeout = 15 * time.Second
@@ -207,6 +209,17 @@ func (pool *BlockPool) SetPeerRange(peerID p2p.ID, base int64, height int64) {
    }
 }
 
+// SetPeerRTT sets the round-trip time to the peer, used to prefer
+// low-latency peers when requesting blocks.
+func (pool *BlockPool) SetPeerRTT(peerID p2p.ID, rtt time.Duration) {
+   pool.mtx.Lock()
+   defer pool.mtx.Unlock()
+
+   if peer := pool.peers[peerID]; peer != nil {
+       peer.rtt = rtt
+   }
+}
+
 func (pool *BlockPool) RemovePeer(peerID p2p.ID) {
    pool.mtx.Lock()
    defer pool.mtx.Unlock()
@@ -243,10 +256,15 @@ func (pool *BlockPool) updateMaxPeerHeight() {
    pool.maxPeerHeight = max
 }
 
+// pickIncrAvailablePeer picks the available peer expected to deliver the
+// block at height the soonest, given its RTT, receive rate and pending
+// requests.
 func (pool *BlockPool) pickIncrAvailablePeer(height int64) *bpPeer {
    pool.mtx.Lock()
    defer pool.mtx.Unlock()
 
+   var best *bpPeer
+   var bestCost time.Duration
    for _, peer := range pool.peers {
        if peer.didTimeout {
            pool.removePeer(peer.id)
@@ -258,10 +276,14 @@ func (pool *BlockPool) pickIncrAvailablePeer(height int64) *bpPeer {
        if height < peer.base || height > peer.height {
            continue
        }
-       peer.incrPending()
-       return peer
+       if cost := peer.cost(); best == nil || cost < bestCost {
+           best, bestCost = peer, cost
+       }
    }
-   return nil
+   if best != nil {
+       best.incrPending()
+   }
+   return best
 }
 
 func (pool *BlockPool) makeNextRequester() {
@@ -306,6 +328,7 @@ type bpPeer struct {
    pool *BlockPool
    id p2p.ID
    recvMonitor *flow.Monitor
+   rtt time.Duration
    timeout *time.Timer
    logger log.Logger
 }
@@ -357,6 +380,19 @@ func (peer *bpPeer) decrPending(recvSize int) {
    }
 }
 
+// cost estimates how long the peer takes to deliver one more block, once its
+// pending requests are served.
+func (peer *bpPeer) cost() time.Duration {
+   rate := int64(minRecvRate)
+   if peer.recvMonitor != nil {
+       if curRate := peer.recvMonitor.Status().CurRate; curRate > 0 {
+           rate = curRate
+       }
+   }
+   transfer := time.Duration(int64(peer.numPending+1) * peerCostBlockSize * int64(time.Second) / rate)
+   return peer.rtt + transfer
+}
+
 func (peer *bpPeer) onTimeout() {
    peer.pool.mtx.Lock()
    defer peer.pool.mtx.Unlock()
@@ -198,3 +198,33 @@ func TestBlockPoolRemovePeer(t *testing.T) {
    }
    assert.Equal(t, int64(0), pool.MaxPeerHeight())
 }
+
+func TestBlockPoolPicksLowestCostPeer(t *testing.T) {
+   pool := NewBlockPool(1, make(chan BlockRequest), make(chan peerError))
+   pool.SetLogger(log.TestingLogger())
+
+   pool.SetPeerRange("near", 0, 10)
+   pool.SetPeerRange("far", 0, 10)
+   pool.SetPeerRange("short", 0, 5)
+   pool.SetPeerRTT("near", 10*time.Millisecond)
+   pool.SetPeerRTT("far", 500*time.Millisecond)
+   pool.SetPeerRTT("short", time.Millisecond)
+   t.Cleanup(func() {
+       for _, peer := range pool.peers {
+           if peer.timeout != nil {
+               peer.timeout.Stop()
+           }
+       }
+   })
+
+   // the lowest-latency peer which has the block is picked first, then the
+   // pending request makes the other peer cheaper
+   peer := pool.pickIncrAvailablePeer(8)
+   require.NotNil(t, peer)
+   assert.Equal(t, p2p.ID("near"), peer.id)
+   peer = pool.pickIncrAvailablePeer(8)
+   require.NotNil(t, peer)
+   assert.Equal(t, p2p.ID("far"), peer.id)
+
+   assert.Nil(t, pool.pickIncrAvailablePeer(11))
+}
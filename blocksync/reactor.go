@@ -188,6 +188,7 @@ func (bcR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 
    case *bcproto.StatusResponse:
        bcR.pool.SetPeerRange(e.Src.ID(), msg.Base, msg.Height)
+       bcR.pool.SetPeerRTT(e.Src.ID(), e.Src.Status().RTT)
 
    case *bcproto.NoBlockResponse:
        bcR.Logger.Debug("Peer has no block", "peer", e.Src, "height", msg.Height)
@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/p2p/pex"
+)
+
+// AddrBookCmd inspects and exports the address book saved in the node's
+// database. The node must be stopped, as the database can't be opened twice.
+var AddrBookCmd = &cobra.Command{
+	Use:   "addrbook",
+	Short: "Inspect and export the address book of a stopped node",
+}
+
+var addrBookListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the known addresses",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := loadAddrBookEntries()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ADDRESS\tBUCKET\tGROUP\tATTEMPTS\tLAST SUCCESS\tSIGNED")
+		for _, e := range entries {
+			lastSuccess := "never"
+			if !e.LastSuccess.IsZero() {
+				lastSuccess = e.LastSuccess.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%v\t%s\t%s\t%d\t%s\t%t\n",
+				e.Addr, e.BucketType, e.Group, e.Attempts, lastSuccess, e.Signed)
+		}
+		return w.Flush()
+	},
+}
+
+var addrBookShowCmd = &cobra.Command{
+	Use:   "show [node-id]",
+	Short: "Show everything known about the address of a node",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries, err := loadAddrBookEntries()
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if string(e.Addr.ID) != args[0] {
+				continue
+			}
+			bz, err := cmtjson.MarshalIndent(e, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		}
+		return fmt.Errorf("node %s is not in the address book", args[0])
+	},
+}
+
+var addrBookExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export the address book in the format of the addrbook.json file",
+	Long: `Export the address book in the format of the addrbook.json file, to stdout
+if no file is given. The file can be read by older versions, and is imported
+by a node whose address book database is empty.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, err := openAddrBookDB()
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		var out io.Writer = os.Stdout
+		if len(args) == 1 {
+			f, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		if err := pex.ExportAddrBook(db, out); err != nil {
+			return fmt.Errorf("failed to export address book: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	AddrBookCmd.AddCommand(addrBookListCmd, addrBookShowCmd, addrBookExportCmd)
+}
+
+func openAddrBookDB() (dbm.DB, error) {
+	if !cmtos.FileExists(filepath.Join(config.DBDir(), "addrbook.db")) {
+		return nil, fmt.Errorf("no address book found in %v", config.DBDir())
+	}
+	return dbm.NewDB("addrbook", dbm.BackendType(config.DBBackend), config.DBDir())
+}
+
+func loadAddrBookEntries() ([]pex.AddrBookEntry, error) {
+	db, err := openAddrBookDB()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	entries, err := pex.LoadAddrBookEntries(db, config.P2P.AddrBookStrict)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load address book: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+)
+
+// banAPIKeyEnv is the environment variable holding the API key, used if
+// --api-key is not set, so that it doesn't show in the process list.
+const banAPIKeyEnv = "CMT_RPC_API_KEY"
+
+var (
+	banRPCAddr  string
+	banAPIKey   string
+	banReason   string
+	banDuration time.Duration
+)
+
+// BanCmd manages the ban list of a running node through its unsafe RPC
+// routes, so that peers can be banned without restarting the node.
+var BanCmd = &cobra.Command{
+	Use:   "ban",
+	Short: "Manage the peers banned by a running node (requires rpc.unsafe)",
+}
+
+var banAddCmd = &cobra.Command{
+	Use:   "add [node-id|ip|cidr]",
+	Short: "Ban a node ID, an IP address or a CIDR range and disconnect the matching peers",
+	Example: `
+	cometbft ban add f9baeaa15fedf5e1ef7448dd60f46c01f1a9e9c4 --reason spam
+	cometbft ban add 10.0.0.0/8 --duration 24h`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		params := map[string]interface{}{
+			"rule":   args[0],
+			"reason": banReason,
+		}
+		if banDuration > 0 {
+			params["duration"] = banDuration.String()
+		}
+		result := new(ctypes.ResultBanPeer)
+		if err := callBanRoute(cmd.Context(), "ban_peer", params, result); err != nil {
+			return err
+		}
+		return printBanResult(result.Entry)
+	},
+}
+
+var banRemoveCmd = &cobra.Command{
+	Use:     "remove [node-id|ip|cidr]",
+	Aliases: []string{"rm"},
+	Short:   "Lift the ban of a node ID, an IP address or a CIDR range",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		params := map[string]interface{}{"rule": args[0]}
+		return callBanRoute(cmd.Context(), "unban_peer", params, new(ctypes.ResultUnbanPeer))
+	},
+}
+
+var banListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the bans in effect",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		result := new(ctypes.ResultBanList)
+		if err := callBanRoute(cmd.Context(), "ban_list", map[string]interface{}{}, result); err != nil {
+			return err
+		}
+		return printBanResult(result.Entries)
+	},
+}
+
+func init() {
+	BanCmd.PersistentFlags().StringVar(&banRPCAddr, "rpc-laddr", "tcp://localhost:26657",
+		"the node's RPC address (<host>:<port>)")
+	BanCmd.PersistentFlags().StringVar(&banAPIKey, "api-key", "",
+		"API key with the unsafe scope, if the node requires one (default $"+banAPIKeyEnv+")")
+	banAddCmd.Flags().StringVar(&banReason, "reason", "", "reason of the ban")
+	banAddCmd.Flags().DurationVar(&banDuration, "duration", 0, "duration of the ban (0 bans forever)")
+
+	BanCmd.AddCommand(banAddCmd, banRemoveCmd, banListCmd)
+}
+
+func callBanRoute(ctx context.Context, method string, params map[string]interface{}, result interface{}) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	httpClient, err := rpcclient.DefaultHTTPClient(banRPCAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create rpc client: %w", err)
+	}
+	apiKey := banAPIKey
+	if apiKey == "" {
+		apiKey = os.Getenv(banAPIKeyEnv)
+	}
+	if apiKey != "" {
+		httpClient.Transport = bearerTransport{key: apiKey, next: httpClient.Transport}
+	}
+	client, err := rpcclient.NewWithHTTPClient(banRPCAddr, httpClient)
+	if err != nil {
+		return fmt.Errorf("failed to create rpc client: %w", err)
+	}
+	if _, err := client.Call(ctx, method, params, result); err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+	return nil
+}
+
+// bearerTransport sends the API key as a bearer token with every request.
+type bearerTransport struct {
+	key  string
+	next http.RoundTripper
+}
+
+func (t bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.key)
+	return t.next.RoundTrip(req)
+}
+
+func printBanResult(v interface{}) error {
+	bz, err := cmtjson.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(bz))
+	return nil
+}
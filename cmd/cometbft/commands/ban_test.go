@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+func TestCallBanRouteAPIKey(t *testing.T) {
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		var req rpctypes.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.NoError(t, json.NewEncoder(w).Encode(rpctypes.NewRPCSuccessResponse(req.ID, ctypes.ResultBanList{})))
+	}))
+	defer server.Close()
+
+	defer func(addr, key string) { banRPCAddr, banAPIKey = addr, key }(banRPCAddr, banAPIKey)
+	banRPCAddr = server.URL
+
+	call := func() {
+		err := callBanRoute(context.Background(), "ban_list", map[string]interface{}{}, new(ctypes.ResultBanList))
+		require.NoError(t, err)
+	}
+
+	t.Setenv(banAPIKeyEnv, "")
+	call()
+	assert.Empty(t, auth)
+
+	t.Setenv(banAPIKeyEnv, "from-env")
+	call()
+	assert.Equal(t, "Bearer from-env", auth)
+
+	banAPIKey = "from-flag"
+	call()
+	assert.Equal(t, "Bearer from-flag", auth)
+}
@@ -0,0 +1,336 @@
+package debug
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cometbft/cometbft/blocksync"
+	cfg "github.com/cometbft/cometbft/config"
+	cs "github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/evidence"
+	auto "github.com/cometbft/cometbft/libs/autofile"
+	"github.com/cometbft/cometbft/libs/cli"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/mock"
+	"github.com/cometbft/cometbft/p2p/pex"
+	bcproto "github.com/cometbft/cometbft/proto/tendermint/blocksync"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	protomem "github.com/cometbft/cometbft/proto/tendermint/mempool"
+	cmtp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+	ssproto "github.com/cometbft/cometbft/proto/tendermint/statesync"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/statesync"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	replayPeer      string
+	replayChannel   string
+	replayDirection string
+	replayReactor   string
+	replayFeed      bool
+)
+
+// reactorChannels maps the name of each reactor to the message types of its
+// channels.
+var reactorChannels = map[string]map[byte]proto.Message{
+	"pex": {
+		pex.PexChannel: &cmtp2p.Message{},
+	},
+	"consensus": {
+		cs.StateChannel:       &cmtcons.Message{},
+		cs.DataChannel:        &cmtcons.Message{},
+		cs.VoteChannel:        &cmtcons.Message{},
+		cs.VoteSetBitsChannel: &cmtcons.Message{},
+	},
+	"mempool": {
+		mempool.MempoolChannel: &protomem.Message{},
+	},
+	"evidence": {
+		evidence.EvidenceChannel: &cmtproto.EvidenceList{},
+	},
+	"blocksync": {
+		blocksync.BlocksyncChannel: &bcproto.Message{},
+	},
+	"statesync": {
+		statesync.SnapshotChannel: &ssproto.Message{},
+		statesync.ChunkChannel:    &ssproto.Message{},
+	},
+}
+
+// switchReactorNames maps the name of each reactor to its name in the switch
+// of the node.
+var switchReactorNames = map[string]string{
+	"pex":       "PEX",
+	"consensus": "CONSENSUS",
+	"mempool":   "MEMPOOL",
+	"evidence":  "EVIDENCE",
+	"blocksync": "BLOCKCHAIN",
+	"statesync": "STATESYNC",
+}
+
+var p2pReplayCmd = &cobra.Command{
+	Use:   "p2p-replay [capture-file]",
+	Short: "Decode the messages captured by a node with p2p.capture enabled",
+	Long: `Decode the messages a node exchanged with its peers, as captured in the
+rotating files of p2p.capture_file when p2p.capture is enabled (the file of the
+node's home by default). Messages are decoded with the types of the channels of
+each reactor, and can be filtered by peer, channel, direction and reactor.
+
+With --feed, the messages received on the channels of --reactor are fed into
+that reactor instead, as if they came from the peers which sent them. The
+reactor is the one of the node of the home, started in isolation: with a
+throwaway node key and validator key, no peers, and no RPC nor metrics server.
+The node still uses the stores of the home and the configured application, so
+run it on a copy of the home of a stopped node. It keeps running once the
+messages are fed, so that the reactor can process them, until interrupted.`,
+	Example: `
+	cometbft debug p2p-replay --reactor consensus --direction in
+	cometbft debug p2p-replay data/p2p-capture/capture --channel 0x30
+	cometbft debug p2p-replay --home /tmp/node-copy --reactor mempool --feed`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: p2pReplayCmdHandler,
+}
+
+func init() {
+	p2pReplayCmd.Flags().StringVar(&replayPeer, "peer", "", "only show the messages exchanged with this node ID")
+	p2pReplayCmd.Flags().StringVar(&replayChannel, "channel", "", "only show the messages of this channel ID")
+	p2pReplayCmd.Flags().StringVar(&replayDirection, "direction", "", "only show the messages received (in) or sent (out)")
+	p2pReplayCmd.Flags().StringVar(&replayReactor, "reactor", "",
+		"only show the messages of this reactor ("+strings.Join(reactorNames(), ", ")+")")
+	p2pReplayCmd.Flags().BoolVar(&replayFeed, "feed", false,
+		"feed the received messages of --reactor into the reactor of the node instead of showing them")
+}
+
+func p2pReplayCmdHandler(_ *cobra.Command, args []string) error {
+	filter, err := newReplayFilter()
+	if err != nil {
+		return err
+	}
+	if replayFeed {
+		if replayReactor == "" {
+			return errors.New("--feed requires --reactor")
+		}
+		if replayPeer != "" || replayChannel != "" || replayDirection != "" {
+			return errors.New("--feed can't be combined with --peer, --channel nor --direction")
+		}
+	}
+
+	conf, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	path := conf.P2P.CaptureFile()
+	if len(args) == 1 {
+		path = args[0]
+	}
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("no capture found: %w", err)
+	}
+
+	group, err := auto.OpenGroup(path)
+	if err != nil {
+		return fmt.Errorf("failed to open capture: %w", err)
+	}
+	defer group.Close()
+	rd, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return fmt.Errorf("failed to open capture: %w", err)
+	}
+	defer rd.Close()
+
+	if replayFeed {
+		return feedCapture(conf, rd)
+	}
+
+	msgTypeByChID := make(map[byte]proto.Message)
+	for _, chs := range reactorChannels {
+		for chID, mt := range chs {
+			msgTypeByChID[chID] = mt
+		}
+	}
+
+	dec := p2p.NewCaptureDecoder(rd)
+	for {
+		m, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read capture: %w", err)
+		}
+		if !filter.match(m) {
+			continue
+		}
+
+		direction := "<-"
+		if m.Outbound {
+			direction = "->"
+		}
+		prefix := fmt.Sprintf("%s %s %s %#x", m.Time.Format(time.RFC3339Nano), direction, m.PeerID, m.ChannelID)
+		msg, err := m.Decode(msgTypeByChID)
+		if err != nil {
+			fmt.Printf("%s undecodable %d bytes: %v\n", prefix, len(m.Bytes), err)
+			continue
+		}
+		fmt.Printf("%s %T %v\n", prefix, msg, msg)
+	}
+}
+
+// loadConfig loads the config of the node of the home, like the commands
+// running the node.
+func loadConfig() (*cfg.Config, error) {
+	conf := cfg.DefaultConfig()
+	if err := viper.Unmarshal(conf); err != nil {
+		return nil, err
+	}
+	conf.SetRoot(viper.GetString(cli.HomeFlag))
+	if err := conf.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("error in config file: %w", err)
+	}
+	return conf, nil
+}
+
+// feedCapture feeds the messages read from rd which were received on the
+// channels of the reactor to the reactor of the node, run in isolation. It
+// runs until interrupted.
+func feedCapture(conf *cfg.Config, rd io.Reader) error {
+	// Run the node in isolation: no peer nor server, and a throwaway identity
+	// so that nothing is signed with the keys of the node.
+	conf.PrivValidatorListenAddr = ""
+	conf.StateSync.Enable = false
+	conf.BlockSyncMode = replayReactor == "blocksync"
+	conf.RPC.ListenAddress = ""
+	conf.RPC.PprofListenAddress = ""
+	conf.Instrumentation.Prometheus = false
+	conf.P2P.ListenAddress = "tcp://127.0.0.1:0"
+	conf.P2P.QUICListenAddress = ""
+	conf.P2P.ExternalAddress = ""
+	conf.P2P.Seeds = ""
+	conf.P2P.PersistentPeers = ""
+	conf.P2P.UnconditionalPeerIDs = ""
+	conf.P2P.SeedMode = false
+	conf.P2P.MaxNumInboundPeers = 0
+	conf.P2P.MaxNumOutboundPeers = 0
+	conf.P2P.Capture = false
+
+	n, err := node.NewNode(conf,
+		types.NewMockPV(),
+		&p2p.NodeKey{PrivKey: ed25519.GenPrivKey()},
+		proxy.DefaultClientCreator(conf.ProxyApp, conf.ABCI, conf.DBDir()),
+		node.DefaultGenesisDocProviderFunc(conf),
+		node.DefaultDBProvider,
+		node.DefaultMetricsProvider(conf.Instrumentation),
+		logger,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create node: %w", err)
+	}
+	reactor := n.Switch().Reactor(switchReactorNames[replayReactor])
+	if reactor == nil {
+		return fmt.Errorf("reactor %q is disabled by the config", replayReactor)
+	}
+	if err := n.Start(); err != nil {
+		return fmt.Errorf("failed to start node: %w", err)
+	}
+
+	count, err := p2p.ReplayCapture(p2p.NewCaptureDecoder(rd), reactor, func(id p2p.ID) p2p.Peer {
+		return mock.NewPeerWithID(id)
+	})
+	if err != nil {
+		_ = n.Stop()
+		return fmt.Errorf("failed to feed capture: %w", err)
+	}
+	logger.Info("fed the captured messages", "reactor", replayReactor, "messages", count)
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	cmtos.TrapSignal(logger, func() {
+		if err := n.Stop(); err != nil {
+			logger.Error("unable to stop the node", "error", err)
+		}
+	})
+	select {}
+}
+
+type replayFilter struct {
+	peer      p2p.ID
+	channels  map[byte]struct{} // nil for all channels
+	direction string
+}
+
+func newReplayFilter() (*replayFilter, error) {
+	f := &replayFilter{peer: p2p.ID(replayPeer)}
+
+	switch replayDirection {
+	case "", "in", "out":
+		f.direction = replayDirection
+	default:
+		return nil, fmt.Errorf("invalid direction %q, must be in or out", replayDirection)
+	}
+
+	if replayChannel != "" {
+		chID, err := strconv.ParseUint(replayChannel, 0, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel ID %q: %w", replayChannel, err)
+		}
+		f.channels = map[byte]struct{}{byte(chID): {}}
+	}
+
+	if replayReactor != "" {
+		chs, ok := reactorChannels[replayReactor]
+		if !ok {
+			return nil, fmt.Errorf("unknown reactor %q, must be one of %s",
+				replayReactor, strings.Join(reactorNames(), ", "))
+		}
+		reactorChs := make(map[byte]struct{}, len(chs))
+		for chID := range chs {
+			if _, ok := f.channels[chID]; f.channels == nil || ok {
+				reactorChs[chID] = struct{}{}
+			}
+		}
+		f.channels = reactorChs
+	}
+	return f, nil
+}
+
+func (f *replayFilter) match(m *p2p.CapturedMessage) bool {
+	if f.peer != "" && m.PeerID != f.peer {
+		return false
+	}
+	if f.channels != nil {
+		if _, ok := f.channels[m.ChannelID]; !ok {
+			return false
+		}
+	}
+	switch f.direction {
+	case "in":
+		return !m.Outbound
+	case "out":
+		return m.Outbound
+	}
+	return true
+}
+
+func reactorNames() []string {
+	names := make([]string, 0, len(reactorChannels))
+	for name := range reactorChannels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -19,6 +20,9 @@ import (
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtos "github.com/cometbft/cometbft/libs/os"
 	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	lgrpc "github.com/cometbft/cometbft/light/provider/grpc"
+	lhttp "github.com/cometbft/cometbft/light/provider/http"
 	lproxy "github.com/cometbft/cometbft/light/proxy"
 	lrpc "github.com/cometbft/cometbft/light/rpc"
 	dbs "github.com/cometbft/cometbft/light/store/db"
@@ -40,12 +44,27 @@ need a primary RPC address, a trusted hash and height and witness RPC addresses
 (if not using sequential verification). To restart the node, thereafter
 only the chainID is required.
 
+The light blocks can be fetched from the gRPC servers of the nodes (their
+grpc_laddr) rather than from their RPC servers, with --grpc-primary and
+--grpc-witnesses. The primary RPC address is still required, as the requests
+are forwarded to it.
+
 When /abci_query is called, the Merkle key path format is:
 
 	/{store name}/{key}
 
 Please verify with your application that this Merkle key format is used (true
-for applications built w/ Cosmos SDK).
+for applications built w/ Cosmos SDK). Besides simple Merkle proofs, the ICS23
+proofs of IAVL, simple and sparse Merkle trees are verified.
+
+By default, every /abci_query must be proven by the node, and fails otherwise.
+With --verified-paths, only the queries whose path starts with one of the given
+prefixes are verified, and the others are forwarded unverified.
+
+The trusted headers are pruned in the background by age, as a multiple of the
+trusting period (--prune-max-age), and by height (--prune-height-window),
+except for the checkpoints (--prune-checkpoint-interval). They can be listed
+and exported with the store subcommands once the light client is stopped.
 `,
 	RunE: runProxy,
 	Args: cobra.ExactArgs(1),
@@ -54,12 +73,14 @@ for applications built w/ Cosmos SDK).
 }
 
 var (
-	listenAddr         string
-	primaryAddr        string
-	witnessAddrsJoined string
-	chainID            string
-	home               string
-	maxOpenConnections int
+	listenAddr             string
+	primaryAddr            string
+	witnessAddrsJoined     string
+	grpcPrimaryAddr        string
+	grpcWitnessAddrsJoined string
+	chainID                string
+	home                   string
+	maxOpenConnections     int
 
 	sequential     bool
 	trustingPeriod time.Duration
@@ -67,10 +88,21 @@ var (
 	trustedHash    []byte
 	trustLevelStr  string
 
+	rotationMargin      float64
+	maxProviderFailures uint16
+
+	verifiedPathsJoined string
+
+	pruningPolicy   light.PruningPolicy
+	pruningInterval time.Duration
+	prometheusAddr  string
+
 	verbose bool
 
-	primaryKey   = []byte("primary")
-	witnessesKey = []byte("witnesses")
+	primaryKey       = []byte("primary")
+	witnessesKey     = []byte("witnesses")
+	grpcPrimaryKey   = []byte("grpc_primary")
+	grpcWitnessesKey = []byte("grpc_witnesses")
 )
 
 func init() {
@@ -80,6 +112,10 @@ func init() {
 		"connect to a CometBFT node at this address")
 	LightCmd.Flags().StringVarP(&witnessAddrsJoined, "witnesses", "w", "",
 		"CometBFT nodes to cross-check the primary node, comma-separated")
+	LightCmd.Flags().StringVar(&grpcPrimaryAddr, "grpc-primary", "",
+		"fetch the light blocks from the gRPC server of the primary node at this address, e.g. tcp://1.2.3.4:26090")
+	LightCmd.Flags().StringVar(&grpcWitnessAddrsJoined, "grpc-witnesses", "",
+		"gRPC servers of CometBFT nodes to cross-check the primary node, comma-separated, besides --witnesses")
 	LightCmd.Flags().StringVar(&home, "home-dir", os.ExpandEnv(filepath.Join("$HOME", ".cometbft-light")),
 		"specify the home directory")
 	LightCmd.Flags().IntVar(
@@ -98,6 +134,32 @@ func init() {
 	LightCmd.Flags().BoolVar(&sequential, "sequential", false,
 		"sequential verification. Verify all headers sequentially as opposed to using skipping verification",
 	)
+	LightCmd.Flags().Float64Var(&rotationMargin, "rotation-margin", 0,
+		"replace the primary with a witness whose score (latency, errors, divergences) is better by this margin, "+
+			"e.g. 0.2 for 20%. 0 only replaces the primary when it fails",
+	)
+	LightCmd.Flags().Uint16Var(&maxProviderFailures, "max-provider-failures", 0,
+		"remove a witness once this many of its requests in a row failed. 0 only removes witnesses sending invalid blocks",
+	)
+	LightCmd.Flags().StringVar(&verifiedPathsJoined, "verified-paths", "",
+		"comma-separated prefixes of the /abci_query paths to verify, e.g. /store/bank/,/store/acc/. "+
+			"The queries of other paths are returned unverified. Empty verifies all queries",
+	)
+	LightCmd.Flags().Float64Var(&pruningPolicy.MaxAge, "prune-max-age", 0,
+		"prune the trusted headers older than this many trusting periods, e.g. 2. 0 disables the pruning by age",
+	)
+	LightCmd.Flags().Int64Var(&pruningPolicy.HeightWindow, "prune-height-window", 0,
+		"keep only the trusted headers of this many latest heights. 0 disables the pruning by height",
+	)
+	LightCmd.Flags().Int64Var(&pruningPolicy.CheckpointInterval, "prune-checkpoint-interval", 0,
+		"never prune the trusted headers at heights multiple of this interval. 0 disables the checkpoints",
+	)
+	LightCmd.Flags().DurationVar(&pruningInterval, "prune-interval", time.Minute,
+		"interval between the prunings of the trusted headers",
+	)
+	LightCmd.Flags().StringVar(&prometheusAddr, "prometheus-laddr", "",
+		"address to serve Prometheus metrics on, e.g. :26660. Empty disables the metrics",
+	)
 }
 
 func runProxy(cmd *cobra.Command, args []string) error {
@@ -114,10 +176,8 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	chainID = args[0]
 	logger.Info("Creating client...", "chainID", chainID)
 
-	witnessesAddrs := []string{}
-	if witnessAddrsJoined != "" {
-		witnessesAddrs = strings.Split(witnessAddrsJoined, ",")
-	}
+	witnessesAddrs := splitAddrs(witnessAddrsJoined)
+	grpcWitnessesAddrs := splitAddrs(grpcWitnessAddrsJoined)
 
 	db, err := dbm.NewGoLevelDB("light-client-db", home)
 	if err != nil {
@@ -126,25 +186,39 @@ func runProxy(cmd *cobra.Command, args []string) error {
 
 	if primaryAddr == "" { // check to see if we can start from an existing state
 		var err error
-		primaryAddr, witnessesAddrs, err = checkForExistingProviders(db)
+		primaryAddr, witnessesAddrs, err = checkForExistingProviders(db, primaryKey, witnessesKey)
 		if err != nil {
 			return fmt.Errorf("failed to retrieve primary or witness from db: %w", err)
 		}
+		grpcPrimaryAddr, grpcWitnessesAddrs, err = checkForExistingProviders(db, grpcPrimaryKey, grpcWitnessesKey)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve gRPC primary or witness from db: %w", err)
+		}
 		if primaryAddr == "" {
 			return errors.New("no primary address was provided nor found. Please provide a primary (using -p)." +
 				" Run the command: cometbft light --help for more information")
 		}
 	} else {
-		err := saveProviders(db, primaryAddr, witnessAddrsJoined)
+		err := saveProviders(db, primaryKey, witnessesKey, primaryAddr, witnessAddrsJoined)
 		if err != nil {
 			logger.Error("Unable to save primary and or witness addresses", "err", err)
 		}
+		err = saveProviders(db, grpcPrimaryKey, grpcWitnessesKey, grpcPrimaryAddr, grpcWitnessAddrsJoined)
+		if err != nil {
+			logger.Error("Unable to save gRPC primary and or witness addresses", "err", err)
+		}
 	}
 
 	trustLevel, err := cmtmath.ParseFraction(trustLevelStr)
 	if err != nil {
 		return fmt.Errorf("can't parse trust level: %w", err)
 	}
+	if err := pruningPolicy.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid pruning flags: %w", err)
+	}
+	if pruningInterval <= 0 {
+		return errors.New("--prune-interval must be positive")
+	}
 
 	options := []light.Option{
 		light.Logger(logger),
@@ -171,10 +245,21 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	} else {
 		options = append(options, light.SkippingVerification(trustLevel))
 	}
+	if rotationMargin > 0 {
+		options = append(options, light.PrimaryRotation(rotationMargin))
+	}
+	if maxProviderFailures > 0 {
+		options = append(options, light.MaxProviderFailures(maxProviderFailures))
+	}
+
+	primary, witnesses, err := lightProviders(primaryAddr, grpcPrimaryAddr, witnessesAddrs, grpcWitnessesAddrs)
+	if err != nil {
+		return err
+	}
 
 	var c *light.Client
 	if trustedHeight > 0 && len(trustedHash) > 0 { // fresh installation
-		c, err = light.NewHTTPClient(
+		c, err = light.NewClient(
 			context.Background(),
 			chainID,
 			light.TrustOptions{
@@ -182,17 +267,17 @@ func runProxy(cmd *cobra.Command, args []string) error {
 				Height: trustedHeight,
 				Hash:   trustedHash,
 			},
-			primaryAddr,
-			witnessesAddrs,
+			primary,
+			witnesses,
 			dbs.New(db, chainID),
 			options...,
 		)
 	} else { // continue from latest state
-		c, err = light.NewHTTPClientFromTrustedStore(
+		c, err = light.NewClientFromTrustedStore(
 			chainID,
 			trustingPeriod,
-			primaryAddr,
-			witnessesAddrs,
+			primary,
+			witnesses,
 			dbs.New(db, chainID),
 			options...,
 		)
@@ -212,13 +297,39 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		cfg.WriteTimeout = config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
-	p, err := lproxy.NewProxy(c, listenAddr, primaryAddr, cfg, logger, lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()))
+	opts := []lrpc.Option{lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn())}
+	if verifiedPathsJoined != "" {
+		opts = append(opts, lrpc.VerifiedPaths(strings.Split(verifiedPathsJoined, ",")...))
+	}
+
+	p, err := lproxy.NewProxy(c, listenAddr, primaryAddr, cfg, logger, opts...)
 	if err != nil {
 		return err
 	}
 
+	// Prune the trusted store in the background, and report its size if the
+	// metrics are enabled.
+	var pruner *light.StorePruner
+	if pruningPolicy.IsEnabled() || prometheusAddr != "" {
+		metrics := light.NopMetrics()
+		if prometheusAddr != "" {
+			metrics = light.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", chainID)
+			startLightPrometheusServer(prometheusAddr, logger)
+		}
+		pruner = light.NewStorePruner(c, pruningPolicy, pruningInterval, metrics)
+		pruner.SetLogger(logger.With("module", "pruner"))
+		if err := pruner.Start(); err != nil {
+			return fmt.Errorf("failed to start pruning the trusted store: %w", err)
+		}
+	}
+
 	// Stop upon receiving SIGTERM or CTRL-C.
 	cmtos.TrapSignal(logger, func() {
+		if pruner != nil {
+			if err := pruner.Stop(); err != nil {
+				logger.Error("failed to stop pruning the trusted store", "err", err)
+			}
+		}
 		p.Listener.Close()
 	})
 
@@ -231,25 +342,85 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func checkForExistingProviders(db dbm.DB) (string, []string, error) {
-	primaryBytes, err := db.Get(primaryKey)
+// startLightPrometheusServer serves the Prometheus metrics on addr.
+func startLightPrometheusServer(addr string, logger log.Logger) {
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           promhttp.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			logger.Error("Prometheus HTTP server ListenAndServe", "err", err)
+		}
+	}()
+}
+
+// lightProviders returns the providers of the light blocks: the gRPC ones for
+// the gRPC addresses, and the HTTP ones otherwise. The gRPC primary, if any,
+// replaces the HTTP one.
+func lightProviders(
+	primaryAddr, grpcPrimaryAddr string,
+	witnessesAddrs, grpcWitnessesAddrs []string,
+) (provider.Provider, []provider.Provider, error) {
+	var (
+		primary provider.Provider
+		err     error
+	)
+	if grpcPrimaryAddr != "" {
+		primary, err = lgrpc.New(chainID, grpcPrimaryAddr)
+	} else {
+		primary, err = lhttp.New(chainID, primaryAddr)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the primary provider: %w", err)
+	}
+
+	witnesses := make([]provider.Provider, 0, len(witnessesAddrs)+len(grpcWitnessesAddrs))
+	for _, addr := range witnessesAddrs {
+		p, err := lhttp.New(chainID, addr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create the witness provider %s: %w", addr, err)
+		}
+		witnesses = append(witnesses, p)
+	}
+	for _, addr := range grpcWitnessesAddrs {
+		p, err := lgrpc.New(chainID, addr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create the gRPC witness provider %s: %w", addr, err)
+		}
+		witnesses = append(witnesses, p)
+	}
+	return primary, witnesses, nil
+}
+
+// splitAddrs splits the comma-separated addresses, none if empty.
+func splitAddrs(joined string) []string {
+	if joined == "" {
+		return []string{}
+	}
+	return strings.Split(joined, ",")
+}
+
+func checkForExistingProviders(db dbm.DB, primaryDBKey, witnessesDBKey []byte) (string, []string, error) {
+	primaryBytes, err := db.Get(primaryDBKey)
 	if err != nil {
 		return "", []string{""}, err
 	}
-	witnessesBytes, err := db.Get(witnessesKey)
+	witnessesBytes, err := db.Get(witnessesDBKey)
 	if err != nil {
 		return "", []string{""}, err
 	}
-	witnessesAddrs := strings.Split(string(witnessesBytes), ",")
+	witnessesAddrs := splitAddrs(string(witnessesBytes))
 	return string(primaryBytes), witnessesAddrs, nil
 }
 
-func saveProviders(db dbm.DB, primaryAddr, witnessesAddrs string) error {
-	err := db.Set(primaryKey, []byte(primaryAddr))
+func saveProviders(db dbm.DB, primaryDBKey, witnessesDBKey []byte, primaryAddr, witnessesAddrs string) error {
+	err := db.Set(primaryDBKey, []byte(primaryAddr))
 	if err != nil {
 		return fmt.Errorf("failed to save primary provider: %w", err)
 	}
-	err = db.Set(witnessesKey, []byte(witnessesAddrs))
+	err = db.Set(witnessesDBKey, []byte(witnessesAddrs))
 	if err != nil {
 		return fmt.Errorf("failed to save witness providers: %w", err)
 	}
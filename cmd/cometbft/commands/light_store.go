@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/light/store"
+	dbs "github.com/cometbft/cometbft/light/store/db"
+)
+
+// lightStoreCmd inspects and exports the light blocks trusted by the light
+// client. The light client must be stopped, as its database can't be opened
+// twice.
+var lightStoreCmd = &cobra.Command{
+	Use:   "store",
+	Short: "Inspect and export the trusted headers of a stopped light client",
+}
+
+var lightStoreInspectHeight int64
+
+var lightStoreInspectCmd = &cobra.Command{
+	Use:   "inspect [chainID]",
+	Short: "List the trusted headers, or show the light block at a height",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, s, err := openLightStore(args[0])
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		if lightStoreInspectHeight > 0 {
+			l, err := s.LightBlock(lightStoreInspectHeight)
+			if err != nil {
+				return fmt.Errorf("failed to load light block #%d: %w", lightStoreInspectHeight, err)
+			}
+			bz, err := cmtjson.MarshalIndent(l, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		}
+
+		heights, err := s.LightBlockHeights()
+		if err != nil {
+			return fmt.Errorf("failed to list light blocks: %w", err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "HEIGHT\tTIME\tHASH\tVALIDATORS")
+		for _, height := range heights {
+			l, err := s.LightBlock(height)
+			if err != nil {
+				return fmt.Errorf("failed to load light block #%d: %w", height, err)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%X\t%d\n",
+				l.Height, l.Time.Format(time.RFC3339), l.Hash(), l.ValidatorSet.Size())
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		fmt.Printf("%d trusted headers\n", len(heights))
+		return nil
+	},
+}
+
+var lightStoreExportCmd = &cobra.Command{
+	Use:   "export [chainID] [file]",
+	Short: "Export the trusted light blocks as JSON",
+	Long: `Export the trusted light blocks, i.e. the signed headers and their validator
+sets, as a JSON array ordered by height, to stdout if no file is given.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db, s, err := openLightStore(args[0])
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		var out io.Writer = os.Stdout
+		if len(args) == 2 {
+			f, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		if err := exportLightBlocks(s, out); err != nil {
+			return fmt.Errorf("failed to export light blocks: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	lightStoreCmd.PersistentFlags().StringVar(&home, "home-dir",
+		os.ExpandEnv(filepath.Join("$HOME", ".cometbft-light")),
+		"specify the home directory",
+	)
+	lightStoreInspectCmd.Flags().Int64Var(&lightStoreInspectHeight, "height", 0,
+		"show the light block at this height instead of listing the trusted headers",
+	)
+	lightStoreCmd.AddCommand(lightStoreInspectCmd, lightStoreExportCmd)
+	LightCmd.AddCommand(lightStoreCmd)
+}
+
+func openLightStore(chainID string) (dbm.DB, store.Store, error) {
+	if !cmtos.FileExists(filepath.Join(home, "light-client-db.db")) {
+		return nil, nil, fmt.Errorf("no light client database found in %v", home)
+	}
+	db, err := dbm.NewGoLevelDB("light-client-db", home)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't open the db: %w", err)
+	}
+	return db, dbs.New(db, chainID), nil
+}
+
+// exportLightBlocks writes the light blocks of s to out, one at a time so
+// that large stores are not loaded in memory.
+func exportLightBlocks(s store.Store, out io.Writer) error {
+	heights, err := s.LightBlockHeights()
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(out, "["); err != nil {
+		return err
+	}
+	for i, height := range heights {
+		l, err := s.LightBlock(height)
+		if err != nil {
+			return fmt.Errorf("failed to load light block #%d: %w", height, err)
+		}
+		bz, err := cmtjson.Marshal(l)
+		if err != nil {
+			return err
+		}
+		sep := ",\n"
+		if i == 0 {
+			sep = "\n"
+		}
+		if _, err := io.WriteString(out, sep); err != nil {
+			return err
+		}
+		if _, err := out.Write(bz); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(out, "\n]\n")
+	return err
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -14,8 +15,11 @@ import (
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	protocmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	"github.com/cometbft/cometbft/state/indexer"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	blockmocks "github.com/cometbft/cometbft/state/indexer/mocks"
 	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/state/txindex/kv"
 	txmocks "github.com/cometbft/cometbft/state/txindex/mocks"
 	"github.com/cometbft/cometbft/types"
 )
@@ -137,7 +141,9 @@ func TestReIndexEvent(t *testing.T) {
 		On("Height").Return(height).
 		On("LoadBlock", base).Return(nil).Once().
 		On("LoadBlock", base).Return(&types.Block{Data: types.Data{Txs: types.Txs{make(types.Tx, 1)}}}).
-		On("LoadBlock", height).Return(&types.Block{Data: types.Data{Txs: types.Txs{make(types.Tx, 1)}}})
+		On("LoadBlock", height).Return(&types.Block{Data: types.Data{Txs: types.Txs{make(types.Tx, 1)}}}).
+		// the heights loaded ahead by the workers
+		On("LoadBlock", mock.Anything).Return(&types.Block{Data: types.Data{Txs: types.Txs{make(types.Tx, 1)}}})
 
 	dtx := abcitypes.ResponseDeliverTx{}
 	abciResp := &protocmtstate.ABCIResponses{
@@ -157,7 +163,8 @@ func TestReIndexEvent(t *testing.T) {
 	mockStateStore.
 		On("LoadABCIResponses", base).Return(nil, errors.New("")).Once().
 		On("LoadABCIResponses", base).Return(abciResp, nil).
-		On("LoadABCIResponses", height).Return(abciResp, nil)
+		On("LoadABCIResponses", height).Return(abciResp, nil).
+		On("LoadABCIResponses", mock.Anything).Return(abciResp, nil)
 
 	testCases := []struct {
 		startHeight int64
@@ -180,6 +187,7 @@ func TestReIndexEvent(t *testing.T) {
 			txIndexer:    mockTxIndexer,
 			blockStore:   mockBlockStore,
 			stateStore:   mockStateStore,
+			workers:      4,
 		}
 
 		err := eventReIndex(setupReIndexEventCmd(), args)
@@ -190,3 +198,70 @@ func TestReIndexEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestReIndexEventResume(t *testing.T) {
+	const end int64 = 250
+
+	store := dbm.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+
+	loadBlock := func(h int64) *types.Block {
+		return &types.Block{
+			Header: types.Header{Height: h},
+			Data:   types.Data{Txs: types.Txs{types.Tx(fmt.Sprintf("tx-%d", h))}},
+		}
+	}
+	mockBlockStore := &mocks.BlockStore{}
+	mockBlockStore.
+		On("LoadBlock", int64(150)).Return(nil).Once().
+		On("LoadBlock", mock.Anything).Return(loadBlock)
+
+	mockStateStore := &mocks.Store{}
+	mockStateStore.On("LoadABCIResponses", mock.Anything).Return(&protocmtstate.ABCIResponses{
+		DeliverTxs: []*abcitypes.ResponseDeliverTx{{}},
+		EndBlock:   &abcitypes.ResponseEndBlock{},
+		BeginBlock: &abcitypes.ResponseBeginBlock{},
+	}, nil)
+
+	args := eventReIndexArgs{
+		startHeight:  1,
+		endHeight:    end,
+		blockIndexer: blockIndexer,
+		txIndexer:    txIndexer,
+		blockStore:   mockBlockStore,
+		stateStore:   mockStateStore,
+		workers:      4,
+	}
+
+	// the heights below the failing one are checkpointed
+	require.Error(t, eventReIndex(setupReIndexEventCmd(), args))
+	cp, err := txIndexer.Checkpoint()
+	require.NoError(t, err)
+	require.Equal(t, &indexer.Checkpoint{Start: 1, End: end, Height: 149}, cp)
+	ok, err := blockIndexer.Has(149)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = blockIndexer.Has(150)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the second run resumes from the checkpoint, and deletes it
+	mockBlockStore.Calls = nil
+	require.NoError(t, eventReIndex(setupReIndexEventCmd(), args))
+	for _, call := range mockBlockStore.Calls {
+		require.GreaterOrEqual(t, call.Arguments.Get(0).(int64), int64(150))
+	}
+	cp, err = txIndexer.Checkpoint()
+	require.NoError(t, err)
+	require.Nil(t, cp)
+
+	for _, h := range []int64{1, 150, end} {
+		ok, err := blockIndexer.Has(h)
+		require.NoError(t, err)
+		require.True(t, ok, h)
+		txr, err := txIndexer.Get(types.Tx(fmt.Sprintf("tx-%d", h)).Hash())
+		require.NoError(t, err)
+		require.NotNil(t, txr, h)
+	}
+}
@@ -29,6 +29,8 @@ func initCommands(rootCmd *cmd.RootCmd, nodeFunc nm.NodeFunc) {
 		cmd.GenNodeKeyCmd,
 		cmd.VersionCmd,
 		cmd.RollbackStateCmd,
+		cmd.BanCmd,
+		cmd.AddrBookCmd,
 		debug.DebugCmd,
 		cli.NewCompletionCmd(rootCmd, true),
 		cmd.NewRunNodeCmd(nodeFunc),
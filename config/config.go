@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -49,6 +51,7 @@ var (
 
 	defaultNodeKeyName  = "node_key.json"
 	defaultAddrBookName = "addrbook.json"
+	defaultBanListName  = "banlist.json"
 
 	defaultConfigFilePath   = filepath.Join(defaultConfigDir, defaultConfigFileName)
 	defaultGenesisJSONPath  = filepath.Join(defaultConfigDir, defaultGenesisJSONName)
@@ -57,6 +60,7 @@ var (
 
 	defaultNodeKeyPath  = filepath.Join(defaultConfigDir, defaultNodeKeyName)
 	defaultAddrBookPath = filepath.Join(defaultConfigDir, defaultAddrBookName)
+	defaultBanListPath  = filepath.Join(defaultConfigDir, defaultBanListName)
 
 	minSubscriptionBufferSize     = 100
 	defaultSubscriptionBufferSize = 200
@@ -148,6 +152,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [consensus] section: %w", err)
 	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [tx_index] section: %w", err)
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [instrumentation] section: %w", err)
 	}
@@ -352,11 +359,15 @@ type RPCConfig struct {
 	CORSAllowedHeaders []string `mapstructure:"cors_allowed_headers"`
 
 	// TCP or UNIX socket address for the gRPC server to listen on
-	// NOTE: This server only supports /broadcast_tx_commit
+	// NOTE: This server supports /broadcast_tx_commit, the read-only queries
+	// and streaming of new blocks and txs
 	GRPCListenAddress string `mapstructure:"grpc_laddr"`
 
 	// Maximum number of simultaneous connections.
 	// Does not include RPC (HTTP&WebSocket) connections. See max_open_connections
+	// Each event stream also counts as a client towards
+	// max_subscription_clients, and a connection can have at most
+	// max_subscriptions_per_client streams.
 	// If you want to accept a larger number than the default, make sure
 	// you increase your OS limits.
 	// 0 - unlimited.
@@ -365,6 +376,12 @@ type RPCConfig struct {
 	// Activate unsafe RPC commands like /dial_persistent_peers and /unsafe_flush_mempool
 	Unsafe bool `mapstructure:"unsafe"`
 
+	// API keys of the clients allowed to use the RPC (HTTP&WebSocket). If any
+	// is set, every request must carry one, as a bearer token or as the
+	// password of basic auth, and can only call the routes in the scopes of
+	// the key. Empty - no authentication.
+	APIKeys []RPCAPIKey `mapstructure:"api_keys"`
+
 	// Maximum number of simultaneous connections (including WebSocket).
 	// Does not include gRPC connections. See grpc_max_open_connections
 	// If you want to accept a larger number than the default, make sure
@@ -407,6 +424,16 @@ type RPCConfig struct {
 	// predictability in subscription behavior.
 	CloseOnSlowClient bool `mapstructure:"experimental_close_on_slow_client"`
 
+	// How long the recent block and tx events are kept in the event log, from
+	// which clients can read the events they missed with the /events route,
+	// or by subscribing after a cursor.
+	// 0 - no event log.
+	EventLogWindowSize time.Duration `mapstructure:"experimental_event_log_window_size"`
+
+	// Maximum number of events kept in the event log.
+	// 0 - only limited by the window size.
+	EventLogMaxItems int `mapstructure:"experimental_event_log_max_items"`
+
 	// How long to wait for a tx to be committed during /broadcast_tx_commit
 	// WARNING: Using a value larger than 10s will result in increasing the
 	// global HTTP write timeout, which applies to all connections and endpoints.
@@ -459,6 +486,8 @@ func DefaultRPCConfig() *RPCConfig {
 		SubscriptionBufferSize:    defaultSubscriptionBufferSize,
 		TimeoutBroadcastTxCommit:  10 * time.Second,
 		WebSocketWriteBufferSize:  defaultSubscriptionBufferSize,
+		EventLogWindowSize:        30 * time.Second,
+		EventLogMaxItems:          10000,
 
 		MaxBodyBytes:   int64(1000000), // 1MB
 		MaxHeaderBytes: 1 << 20,        // same as the net/http default
@@ -507,15 +536,36 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.TimeoutBroadcastTxCommit < 0 {
 		return errors.New("timeout_broadcast_tx_commit can't be negative")
 	}
+	if cfg.EventLogWindowSize < 0 {
+		return errors.New("experimental_event_log_window_size can't be negative")
+	}
+	if cfg.EventLogMaxItems < 0 {
+		return errors.New("experimental_event_log_max_items can't be negative")
+	}
 	if cfg.MaxBodyBytes < 0 {
 		return errors.New("max_body_bytes can't be negative")
 	}
 	if cfg.MaxHeaderBytes < 0 {
 		return errors.New("max_header_bytes can't be negative")
 	}
+	keys := make(map[string]struct{}, len(cfg.APIKeys))
+	for i, key := range cfg.APIKeys {
+		if err := key.ValidateBasic(); err != nil {
+			return fmt.Errorf("api_keys #%d: %w", i, err)
+		}
+		if _, ok := keys[key.Key]; ok {
+			return fmt.Errorf("api_keys #%d: duplicate key", i)
+		}
+		keys[key.Key] = struct{}{}
+	}
 	return nil
 }
 
+// IsAuthEnabled returns true if the RPC requires API keys.
+func (cfg *RPCConfig) IsAuthEnabled() bool {
+	return len(cfg.APIKeys) != 0
+}
+
 // IsCorsEnabled returns true if cross-origin resource sharing is enabled.
 func (cfg *RPCConfig) IsCorsEnabled() bool {
 	return len(cfg.CORSAllowedOrigins) != 0
@@ -541,9 +591,63 @@ func (cfg RPCConfig) IsTLSEnabled() bool {
 	return cfg.TLSCertFile != "" && cfg.TLSKeyFile != ""
 }
 
+// RPCAPIKey is an API key of an RPC client, with the routes it can call and
+// its limits.
+type RPCAPIKey struct {
+	// The key, sent by the client
+	Key string `mapstructure:"key"`
+
+	// Scopes of the routes the key can call: "read", "broadcast" (txs and
+	// evidence) and "unsafe" (only with unsafe = true).
+	Scopes []string `mapstructure:"scopes"`
+
+	// Number of calls per second the key can make, with bursts of up to
+	// rate_burst calls.
+	// 0 - unlimited.
+	RateLimit float64 `mapstructure:"rate_limit"`
+	RateBurst int     `mapstructure:"rate_burst"`
+
+	// Maximum number of requests in a JSON-RPC batch.
+	// 0 - unlimited.
+	MaxBatchSize int `mapstructure:"max_batch_size"`
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (key RPCAPIKey) ValidateBasic() error {
+	if key.Key == "" {
+		return errors.New("key can't be empty")
+	}
+	if len(key.Scopes) == 0 {
+		return errors.New("scopes can't be empty")
+	}
+	for _, scope := range key.Scopes {
+		switch scope {
+		case "read", "broadcast", "unsafe":
+		default:
+			return fmt.Errorf("unknown scope %q", scope)
+		}
+	}
+	if key.RateLimit < 0 {
+		return errors.New("rate_limit can't be negative")
+	}
+	if key.RateBurst < 0 {
+		return errors.New("rate_burst can't be negative")
+	}
+	if key.MaxBatchSize < 0 {
+		return errors.New("max_batch_size can't be negative")
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // P2PConfig
 
+// MaxPexAddrRecordTTL is the maximum validity of a signed address record.
+// Peers reject the records expiring later, so that a leaked record can't be
+// replayed forever.
+const MaxPexAddrRecordTTL = 7 * 24 * time.Hour
+
 // P2PConfig defines the configuration options for the CometBFT peer-to-peer networking layer
 type P2PConfig struct { //nolint: maligned
 	RootDir string `mapstructure:"home"`
@@ -551,6 +655,12 @@ type P2PConfig struct { //nolint: maligned
 	// Address to listen for incoming connections
 	ListenAddress string `mapstructure:"laddr"`
 
+	// Address to listen for incoming QUIC connections (UDP), e.g.
+	// "quic://0.0.0.0:26656". Leave empty to disable QUIC. When enabled,
+	// peers whose address uses the "quic://" scheme are dialed over QUIC and
+	// all others over TCP, so both kinds of peers can be mixed.
+	QUICListenAddress string `mapstructure:"quic_laddr"`
+
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
@@ -564,13 +674,19 @@ type P2PConfig struct { //nolint: maligned
 	// UPNP port forwarding
 	UPNP bool `mapstructure:"upnp"`
 
-	// Path to address book
+	// Path to the address book file of older versions. The address book is
+	// saved in the addrbook database, which is seeded with this file on first
+	// start
 	AddrBook string `mapstructure:"addr_book_file"`
 
 	// Set true for strict address routability rules
 	// Set false for private or local networks
 	AddrBookStrict bool `mapstructure:"addr_book_strict"`
 
+	// Path to the list of banned node IDs and IP ranges, managed at runtime
+	// through the unsafe ban_peer/unban_peer RPC routes
+	BanList string `mapstructure:"ban_list_file"`
+
 	// Maximum number of inbound peers
 	MaxNumInboundPeers int `mapstructure:"max_num_inbound_peers"`
 
@@ -595,6 +711,13 @@ type P2PConfig struct { //nolint: maligned
 	// Rate at which packets can be received, in bytes/second
 	RecvRate int64 `mapstructure:"recv_rate"`
 
+	// Per-channel caps on the rate at which packets can be sent, in
+	// bytes/second, keyed by channel ID (e.g. "0x30" for the mempool). They
+	// apply on top of send_rate. Channels without an entry are not capped.
+	// There are no per-channel caps on receiving, as the channels of a
+	// connection share its reader.
+	ChannelSendRates map[string]int64 `mapstructure:"channel_send_rates"`
+
 	// Set true to enable the peer-exchange reactor
 	PexReactor bool `mapstructure:"pex"`
 
@@ -604,6 +727,16 @@ type P2PConfig struct { //nolint: maligned
 	// Does not work if the peer-exchange reactor is disabled.
 	SeedMode bool `mapstructure:"seed_mode"`
 
+	// Only accept gossiped addresses signed by the node they advertise. By
+	// default, bare addresses sent by nodes predating signed address records
+	// are accepted too. Enable once the network has upgraded to protect the
+	// address book from spoofed addresses.
+	PexRequireSignedAddrs bool `mapstructure:"pex_require_signed_addrs"`
+
+	// How long the address record signed by this node and gossiped by the
+	// peer-exchange reactor remains valid. At most MaxPexAddrRecordTTL.
+	PexAddrRecordTTL time.Duration `mapstructure:"pex_addr_record_ttl"`
+
 	// Comma separated list of peer IDs to keep private (will not be gossiped to
 	// other peers)
 	PrivatePeerIDs string `mapstructure:"private_peer_ids"`
@@ -615,6 +748,13 @@ type P2PConfig struct { //nolint: maligned
 	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"`
 	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
 
+	// Record the messages exchanged with peers to capture_file, for debugging
+	// with "cometbft debug p2p-replay". The files are rotated, and the oldest
+	// ones removed once they total capture_max_size bytes (0 for no limit).
+	Capture        bool   `mapstructure:"capture"`
+	CapturePath    string `mapstructure:"capture_file"`
+	CaptureMaxSize int64  `mapstructure:"capture_max_size"`
+
 	// Testing params.
 	// Force dial to fail
 	TestDialFail bool `mapstructure:"test_dial_fail"`
@@ -631,6 +771,7 @@ func DefaultP2PConfig() *P2PConfig {
 		UPNP:                         false,
 		AddrBook:                     defaultAddrBookPath,
 		AddrBookStrict:               true,
+		BanList:                      defaultBanListPath,
 		MaxNumInboundPeers:           40,
 		MaxNumOutboundPeers:          10,
 		PersistentPeersMaxDialPeriod: 0 * time.Second,
@@ -640,9 +781,14 @@ func DefaultP2PConfig() *P2PConfig {
 		RecvRate:                     5120000, // 5 mB/s
 		PexReactor:                   true,
 		SeedMode:                     false,
+		PexRequireSignedAddrs:        false,
+		PexAddrRecordTTL:             24 * time.Hour,
 		AllowDuplicateIP:             false,
 		HandshakeTimeout:             20 * time.Second,
 		DialTimeout:                  3 * time.Second,
+		Capture:                      false,
+		CapturePath:                  filepath.Join(defaultDataDir, "p2p-capture", "capture"),
+		CaptureMaxSize:               1024 * 1024 * 1024, // 1 GB
 		TestDialFail:                 false,
 		TestFuzz:                     false,
 		TestFuzzConfig:               DefaultFuzzConnConfig(),
@@ -663,6 +809,16 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// BanListFile returns the full path to the ban list
+func (cfg *P2PConfig) BanListFile() string {
+	return rootify(cfg.BanList, cfg.RootDir)
+}
+
+// CaptureFile returns the full path to the head of the message capture files
+func (cfg *P2PConfig) CaptureFile() string {
+	return rootify(cfg.CapturePath, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
@@ -687,6 +843,21 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if _, err := ParseChannelRates(cfg.ChannelSendRates); err != nil {
+		return fmt.Errorf("channel_send_rates: %w", err)
+	}
+	if cfg.PexAddrRecordTTL <= 0 {
+		return errors.New("pex_addr_record_ttl must be positive")
+	}
+	if cfg.PexAddrRecordTTL > MaxPexAddrRecordTTL {
+		return fmt.Errorf("pex_addr_record_ttl can't be greater than %v", MaxPexAddrRecordTTL)
+	}
+	if cfg.CaptureMaxSize < 0 {
+		return errors.New("capture_max_size can't be negative")
+	}
+	if cfg.QUICListenAddress != "" && !strings.HasPrefix(cfg.QUICListenAddress, "quic://") {
+		return errors.New("quic_laddr must start with quic://")
+	}
 	return nil
 }
 
@@ -699,6 +870,23 @@ type FuzzConnConfig struct {
 	ProbSleep    float64
 }
 
+// ParseChannelRates converts per-channel rates keyed by channel ID, in decimal
+// or 0x-prefixed hexadecimal, to rates keyed by channel byte.
+func ParseChannelRates(rates map[string]int64) (map[byte]int64, error) {
+	parsed := make(map[byte]int64, len(rates))
+	for key, rate := range rates {
+		chID, err := strconv.ParseUint(key, 0, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel ID %q: %w", key, err)
+		}
+		if rate < 0 {
+			return nil, fmt.Errorf("rate of channel %s can't be negative", key)
+		}
+		parsed[byte(chID)] = rate
+	}
+	return parsed, nil
+}
+
 // DefaultFuzzConnConfig returns the default config.
 func DefaultFuzzConnConfig() *FuzzConnConfig {
 	return &FuzzConnConfig{
@@ -781,6 +969,30 @@ type MempoolConfig struct {
 	// Deprecated: Only used by priority mempool, which will be removed in the
 	// next major release.
 	TTLNumBlocks int64 `mapstructure:"ttl-num-blocks"`
+
+	// PeerRateLimit is the sustained number of transaction tokens per second
+	// accepted from a single peer before CheckTx is called. A transaction
+	// costs one token per size class it exceeds plus one (see TxSizeClasses).
+	// Zero disables per-peer rate limiting.
+	PeerRateLimit float64 `mapstructure:"peer_rate_limit"`
+	// PeerRateBurst is the maximum number of tokens a single peer may spend
+	// at once.
+	PeerRateBurst int `mapstructure:"peer_rate_burst"`
+	// RPCRateLimit is the sustained number of transaction tokens per second
+	// accepted from a single RPC client (identified by its remote host).
+	// Zero disables per-client rate limiting.
+	RPCRateLimit float64 `mapstructure:"rpc_rate_limit"`
+	// RPCRateBurst is the maximum number of tokens a single RPC client may
+	// spend at once.
+	RPCRateBurst int `mapstructure:"rpc_rate_burst"`
+	// MaxTxsPerSender caps the number of transactions that a single peer or
+	// RPC client may have in the mempool at the same time. Zero means no cap.
+	MaxTxsPerSender int `mapstructure:"max_txs_per_sender"`
+	// TxSizeClasses is an ascending list of transaction sizes, in bytes, that
+	// split transactions into classes for rate limiting. A transaction in
+	// class i (i.e. larger than TxSizeClasses[i-1] bytes) costs i+1 tokens.
+	// An empty list makes every transaction cost one token.
+	TxSizeClasses []int `mapstructure:"tx_size_classes"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the CometBFT mempool
@@ -833,6 +1045,29 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return errors.New("max_tx_bytes can't be negative")
 	}
+	if cfg.PeerRateLimit < 0 {
+		return errors.New("peer_rate_limit can't be negative")
+	}
+	if cfg.PeerRateLimit > 0 && cfg.PeerRateBurst <= 0 {
+		return errors.New("peer_rate_burst must be positive when peer_rate_limit is set")
+	}
+	if cfg.RPCRateLimit < 0 {
+		return errors.New("rpc_rate_limit can't be negative")
+	}
+	if cfg.RPCRateLimit > 0 && cfg.RPCRateBurst <= 0 {
+		return errors.New("rpc_rate_burst must be positive when rpc_rate_limit is set")
+	}
+	if cfg.MaxTxsPerSender < 0 {
+		return errors.New("max_txs_per_sender can't be negative")
+	}
+	for i, size := range cfg.TxSizeClasses {
+		if size <= 0 {
+			return fmt.Errorf("tx_size_classes[%d] must be positive", i)
+		}
+		if i > 0 && size <= cfg.TxSizeClasses[i-1] {
+			return errors.New("tx_size_classes must be strictly ascending")
+		}
+	}
 	return nil
 }
 
@@ -844,6 +1079,7 @@ type StateSyncConfig struct {
 	Enable              bool          `mapstructure:"enable"`
 	TempDir             string        `mapstructure:"temp_dir"`
 	RPCServers          []string      `mapstructure:"rpc_servers"`
+	GRPCServers         []string      `mapstructure:"grpc_servers"`
 	TrustPeriod         time.Duration `mapstructure:"trust_period"`
 	TrustHeight         int64         `mapstructure:"trust_height"`
 	TrustHash           string        `mapstructure:"trust_hash"`
@@ -893,6 +1129,16 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 			}
 		}
 
+		if len(cfg.GRPCServers) != 0 && len(cfg.GRPCServers) != len(cfg.RPCServers) {
+			return errors.New("grpc_servers must have as many entries as rpc_servers")
+		}
+
+		for _, server := range cfg.GRPCServers {
+			if len(server) == 0 {
+				return errors.New("found empty grpc_servers entry")
+			}
+		}
+
 		if cfg.DiscoveryTime != 0 && cfg.DiscoveryTime < 5*time.Second {
 			return errors.New("discovery time must be 0s or greater than five seconds")
 		}
@@ -1171,18 +1417,71 @@ type TxIndexConfig struct {
 	//   2) "kv" (default) - the simplest possible indexer,
 	//      backed by key-value storage (defaults to levelDB; see DBBackend).
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the indexer services backed by an embedded SQLite
+	//      database, in the data directory (see DBPath). Requires a binary
+	//      built with cgo (make build COMETBFT_BUILD_OPTIONS=sqlite).
 	Indexer string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// If non-zero, the "kv" indexer deletes, in the background, the
+	// transactions and block events of the heights more than retain_blocks
+	// below the latest height, independently of the blocks the node retains.
+	// The searches for these heights then fail.
+	// 0 - keep everything.
+	RetainBlocks int64 `mapstructure:"retain_blocks"`
+
+	// How often to prune the indexer, when retain_blocks is set.
+	PruneInterval time.Duration `mapstructure:"prune_interval"`
+
+	// The attributes which the "kv" indexer also indexes in the order of
+	// their values, for the range conditions on them to only scan the values
+	// within the range, and for tx_search and block_search to order their
+	// results by them. Each is "<event type>.<attribute key>:<type>", where
+	// the type is one of "int", "decimal" and "time".
+	RangeIndexes []string `mapstructure:"range_indexes"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
 func DefaultTxIndexConfig() *TxIndexConfig {
 	return &TxIndexConfig{
-		Indexer: "kv",
+		Indexer:       "kv",
+		RetainBlocks:  0,
+		PruneInterval: time.Minute,
+	}
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	if cfg.RetainBlocks < 0 {
+		return errors.New("retain_blocks can't be negative")
+	}
+	if cfg.RetainBlocks > 0 {
+		if cfg.Indexer != "kv" {
+			return fmt.Errorf("retain_blocks is not supported by the %q indexer", cfg.Indexer)
+		}
+		if cfg.PruneInterval <= 0 {
+			return errors.New("prune_interval must be positive")
+		}
 	}
+	if len(cfg.RangeIndexes) > 0 && cfg.Indexer != "kv" {
+		return fmt.Errorf("range_indexes is not supported by the %q indexer", cfg.Indexer)
+	}
+	for _, spec := range cfg.RangeIndexes {
+		i := strings.LastIndex(spec, ":")
+		if i < 0 || !strings.Contains(spec[:i], ".") {
+			return fmt.Errorf("range_indexes: expected <event type>.<attribute key>:<type>, got %q", spec)
+		}
+		switch spec[i+1:] {
+		case "int", "decimal", "time":
+		default:
+			return fmt.Errorf("range_indexes: unknown type %q in %q, expected int, decimal or time", spec[i+1:], spec)
+		}
+	}
+	return nil
 }
 
 // TestTxIndexConfig returns a default configuration for the transaction indexer.
@@ -74,6 +74,8 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		"MaxSubscriptionClients",
 		"MaxSubscriptionsPerClient",
 		"TimeoutBroadcastTxCommit",
+		"EventLogWindowSize",
+		"EventLogMaxItems",
 		"MaxBodyBytes",
 		"MaxHeaderBytes",
 	}
@@ -85,6 +87,29 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestRPCConfigValidateAPIKeys(t *testing.T) {
+	cfg := TestRPCConfig()
+	cfg.APIKeys = []RPCAPIKey{
+		{Key: "a", Scopes: []string{"read"}},
+		{Key: "b", Scopes: []string{"read", "broadcast", "unsafe"}, RateLimit: 10, RateBurst: 5, MaxBatchSize: 10},
+	}
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.True(t, cfg.IsAuthEnabled())
+
+	for _, key := range []RPCAPIKey{
+		{Key: "", Scopes: []string{"read"}},
+		{Key: "c"},
+		{Key: "c", Scopes: []string{"write"}},
+		{Key: "c", Scopes: []string{"read"}, RateLimit: -1},
+		{Key: "c", Scopes: []string{"read"}, RateBurst: -1},
+		{Key: "c", Scopes: []string{"read"}, MaxBatchSize: -1},
+		{Key: "a", Scopes: []string{"read"}}, // duplicate
+	} {
+		cfg.APIKeys = append(cfg.APIKeys[:2:2], key)
+		assert.Error(t, cfg.ValidateBasic(), "%+v", key)
+	}
+}
+
 func TestP2PConfigValidateBasic(t *testing.T) {
 	cfg := TestP2PConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -96,6 +121,7 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		"MaxPacketMsgPayloadSize",
 		"SendRate",
 		"RecvRate",
+		"CaptureMaxSize",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -103,6 +129,25 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.ChannelSendRates = map[string]int64{"0x30": 1000, "32": 2000}
+	assert.NoError(t, cfg.ValidateBasic())
+	rates, err := ParseChannelRates(cfg.ChannelSendRates)
+	require.NoError(t, err)
+	assert.Equal(t, map[byte]int64{0x30: 1000, 0x20: 2000}, rates)
+
+	cfg.ChannelSendRates = map[string]int64{"0x300": 1000}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ChannelSendRates = map[string]int64{"0x30": -1}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ChannelSendRates = nil
+
+	cfg.PexAddrRecordTTL = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PexAddrRecordTTL = MaxPexAddrRecordTTL
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.PexAddrRecordTTL = MaxPexAddrRecordTTL + time.Second
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -114,6 +159,7 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"MaxTxsPerSender",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -121,11 +167,35 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.PeerRateLimit = 10
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PeerRateBurst = 20
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.TxSizeClasses = []int{1024, 512}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.TxSizeClasses = []int{512, 1024}
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Enable = true
+	cfg.RPCServers = []string{"tcp://127.0.0.1:26657", "tcp://127.0.0.2:26657"}
+	cfg.TrustHeight = 1
+	cfg.TrustHash = "0BA2"
+	require.NoError(t, cfg.ValidateBasic())
+
+	// the gRPC servers are those of the RPC servers
+	cfg.GRPCServers = []string{"tcp://127.0.0.1:26090"}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.GRPCServers = []string{"tcp://127.0.0.1:26090", ""}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.GRPCServers = []string{"tcp://127.0.0.1:26090", "tcp://127.0.0.2:26090"}
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestBlockSyncConfigValidateBasic(t *testing.T) {
@@ -182,6 +252,42 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := TestTxIndexConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.RetainBlocks = 100
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.PruneInterval = 0
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestTxIndexConfig()
+	cfg.RetainBlocks = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	// only the kv indexer can be pruned
+	cfg = TestTxIndexConfig()
+	cfg.Indexer = "psql"
+	cfg.RetainBlocks = 100
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestTxIndexConfig()
+	cfg.RangeIndexes = []string{"transfer.amount:decimal", "transfer.time:time"}
+	assert.NoError(t, cfg.ValidateBasic())
+
+	for _, spec := range []string{"amount:int", "transfer.amount", "transfer.amount:float"} {
+		cfg.RangeIndexes = []string{spec}
+		assert.Error(t, cfg.ValidateBasic(), spec)
+	}
+
+	// only the kv indexer has range indexes
+	cfg = TestTxIndexConfig()
+	cfg.Indexer = "psql"
+	cfg.RangeIndexes = []string{"transfer.amount:int"}
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -0,0 +1,743 @@
+package consensus
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+/*
+The simulation harness runs the consensus State of several validators in a
+single goroutine, without reactors nor wall-clock timers:
+
+  - every State gets a simTicker, which schedules its timeouts on the
+    virtual clock of the simulation;
+  - the proposals, block parts and votes a validator produces are broadcast
+    to the others with a latency drawn from a seeded source;
+  - honest validators relay the messages they receive the first time, and
+    keep the messages of their current height to hand them again to their
+    State whenever it moves on, which stands for the gossip of the reactor;
+  - byzantine validators misbehave according to the faults of the scenario.
+
+Events are processed in virtual time order, so a scenario always produces the
+same trace. After every event the harness checks that no two honest
+validators committed different blocks at the same height (safety), and it
+fails if the honest validators do not all reach the target height within the
+virtual time budget (liveness). On failure, the scenario is minimized and its
+trace printed, so that it can be replayed with runSimulation.
+*/
+
+const (
+	simChainID           = "sim-chain"
+	defaultSimMaxTime    = time.Minute
+	defaultSimMinLatency = time.Millisecond
+	defaultSimMaxLatency = 10 * time.Millisecond
+)
+
+var simGenesisTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// simScenario describes a simulation. Printed with %+v, it can be pasted in a
+// test to replay the simulation.
+type simScenario struct {
+	Seed       int64
+	Validators int
+	Heights    int64         // every honest validator must commit this many blocks
+	MaxTime    time.Duration // virtual time budget, defaultSimMaxTime if 0
+	MinLatency time.Duration // defaultSimMinLatency if 0
+	MaxLatency time.Duration // defaultSimMaxLatency if 0
+	Faults     []simFault
+	// Forks are the hashes of blocks deemed committed, by height, by a
+	// validator outside of the simulation. Committing any other block at
+	// these heights violates safety.
+	Forks map[int64]string
+}
+
+type simFaultKind int
+
+const (
+	// simEquivocate sends a conflicting vote to the targets.
+	simEquivocate simFaultKind = iota + 1
+	// simWithhold does not send the messages to the targets.
+	simWithhold
+	// simDelay delays the messages to the targets by Delay.
+	simDelay
+)
+
+func (k simFaultKind) String() string {
+	switch k {
+	case simEquivocate:
+		return "simEquivocate"
+	case simWithhold:
+		return "simWithhold"
+	case simDelay:
+		return "simDelay"
+	}
+	return fmt.Sprintf("simFaultKind(%d)", int(k))
+}
+
+// simMsgKinds is a set of message kinds.
+type simMsgKinds uint8
+
+const (
+	simProposal simMsgKinds = 1 << iota // proposals and block parts
+	simPrevote
+	simPrecommit
+
+	simVotes = simPrevote | simPrecommit
+)
+
+func (m simMsgKinds) String() string {
+	var names []string
+	for kind, name := range []string{"simProposal", "simPrevote", "simPrecommit"} {
+		if m&(1<<kind) != 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "0"
+	}
+	return strings.Join(names, "|")
+}
+
+func simMsgKindOf(msg Message) simMsgKinds {
+	if vm, ok := msg.(*VoteMessage); ok {
+		if vm.Vote.Type == cmtproto.PrecommitType {
+			return simPrecommit
+		}
+		return simPrevote
+	}
+	return simProposal
+}
+
+// simFault is the misbehavior of a byzantine validator.
+type simFault struct {
+	Node int
+	Kind simFaultKind
+	// Msgs are the messages affected, all of them if 0. Equivocation only
+	// applies to votes.
+	Msgs simMsgKinds
+	// Targets are the validators affected, all the others if nil. For
+	// equivocation, they receive the conflicting votes, and default to the
+	// validators of odd index.
+	Targets []int
+	Delay   time.Duration // for simDelay
+}
+
+func (f simFault) applies(msg Message, to int) bool {
+	kinds := f.Msgs
+	if f.Kind == simEquivocate {
+		if kinds == 0 {
+			kinds = simVotes
+		}
+		kinds &= simVotes
+	}
+	if kinds != 0 && kinds&simMsgKindOf(msg) == 0 {
+		return false
+	}
+	if f.Targets == nil {
+		return f.Kind != simEquivocate || to%2 == 1
+	}
+	for _, target := range f.Targets {
+		if target == to {
+			return true
+		}
+	}
+	return false
+}
+
+//-------------------------------------------------------------------------
+
+// simTicker is a TimeoutTicker scheduling timeouts on the virtual clock of a
+// simulation. Like timeoutTicker, it only keeps the timeout with the
+// greatest height/round/step.
+type simTicker struct {
+	sim  *simulation
+	node int
+	ti   timeoutInfo
+	gen  uint64 // incremented when ti is replaced
+}
+
+var _ TimeoutTicker = (*simTicker)(nil)
+
+func (t *simTicker) Start() error                   { return nil }
+func (t *simTicker) Stop() error                    { return nil }
+func (t *simTicker) Chan() <-chan timeoutInfo       { return nil }
+func (t *simTicker) SetLogger(log.Logger)           {}
+func (t *simTicker) ScheduleTimeout(ti timeoutInfo) { t.schedule(ti) }
+
+func (t *simTicker) schedule(ti timeoutInfo) {
+	if ti.Height < t.ti.Height ||
+		(ti.Height == t.ti.Height && ti.Round < t.ti.Round) ||
+		(ti.Height == t.ti.Height && ti.Round == t.ti.Round && t.ti.Step > 0 && ti.Step <= t.ti.Step) {
+		return
+	}
+	// The timeout of the new height step is computed from wall-clock times,
+	// replace it with the commit timeout to stay deterministic.
+	if ti.Step == cstypes.RoundStepNewHeight {
+		ti.Duration = 0
+		if ti.Height > 1 {
+			ti.Duration = t.sim.config.TimeoutCommit
+		}
+	}
+	if ti.Duration < 0 {
+		ti.Duration = 0
+	}
+	t.ti = ti
+	t.gen++
+	t.sim.push(&simEvent{at: t.sim.now + ti.Duration, node: t.node, timeout: &ti, gen: t.gen})
+}
+
+//-------------------------------------------------------------------------
+
+// simEvent is either the delivery of a message to a node or a timeout.
+type simEvent struct {
+	at   time.Duration
+	seq  uint64
+	node int
+
+	from     int
+	msgBytes []byte // wrapped consensus message
+
+	timeout *timeoutInfo
+	gen     uint64
+}
+
+type simEventHeap []*simEvent
+
+func (h simEventHeap) Len() int { return len(h) }
+func (h simEventHeap) Less(i, j int) bool {
+	if h[i].at == h[j].at {
+		return h[i].seq < h[j].seq
+	}
+	return h[i].at < h[j].at
+}
+func (h simEventHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *simEventHeap) Push(x interface{}) { *h = append(*h, x.(*simEvent)) }
+func (h *simEventHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	ev := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return ev
+}
+
+// simEvidencePool records the conflicting votes reported by a State.
+type simEvidencePool struct {
+	reported map[string]struct{}
+}
+
+func (p *simEvidencePool) ReportConflictingVotes(voteA, voteB *types.Vote) {
+	key := fmt.Sprintf("%X/%d/%d/%v", voteA.ValidatorAddress, voteA.Height, voteA.Round, voteA.Type)
+	p.reported[key] = struct{}{}
+}
+
+type simProgress struct {
+	height   int64
+	round    int32
+	step     cstypes.RoundStepType
+	hasParts bool
+}
+
+type simInboxMsg struct {
+	from     int
+	msgBytes []byte
+}
+
+type simNode struct {
+	index     int
+	id        p2p.ID
+	cs        *State
+	privVal   types.MockPV
+	ticker    *simTicker
+	evpool    *simEvidencePool
+	byzantine bool
+
+	inbox    []simInboxMsg       // messages of the current and later heights, by arrival
+	seen     map[string]struct{} // messages in inbox
+	progress simProgress
+	checked  int64 // last height checked for safety
+}
+
+type simulation struct {
+	scenario simScenario
+	config   *cfg.ConsensusConfig
+	rng      *rand.Rand
+
+	now    time.Duration
+	seq    uint64
+	events simEventHeap
+
+	nodes   []*simNode
+	faults  map[int][]simFault
+	decided map[int64][]byte // hash of the block committed at each height
+	trace   []string
+}
+
+// newSimulation sets up the validators of the scenario.
+func newSimulation(t testing.TB, s simScenario) *simulation {
+	t.Helper()
+	if s.MaxTime == 0 {
+		s.MaxTime = defaultSimMaxTime
+	}
+	if s.MinLatency == 0 {
+		s.MinLatency = defaultSimMinLatency
+	}
+	if s.MaxLatency == 0 {
+		s.MaxLatency = defaultSimMaxLatency
+	}
+	require.GreaterOrEqual(t, s.MaxLatency, s.MinLatency)
+
+	sim := &simulation{
+		scenario: s,
+		config:   cfg.TestConsensusConfig(),
+		rng:      rand.New(rand.NewSource(s.Seed)), //nolint:gosec
+		faults:   make(map[int][]simFault),
+		decided:  make(map[int64][]byte),
+	}
+	for _, f := range s.Faults {
+		require.True(t, f.Node >= 0 && f.Node < s.Validators, "fault on unknown validator %d", f.Node)
+		sim.faults[f.Node] = append(sim.faults[f.Node], f)
+	}
+	for h, hash := range s.Forks {
+		sim.decided[h] = []byte(hash)
+	}
+
+	privVals := make([]types.MockPV, s.Validators)
+	genDoc := &types.GenesisDoc{
+		ChainID:         simChainID,
+		GenesisTime:     simGenesisTime,
+		ConsensusParams: types.DefaultConsensusParams(),
+	}
+	for i := range privVals {
+		privKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("sim/%d/%d", s.Seed, i)))
+		privVals[i] = types.NewMockPVWithParams(privKey, false, false)
+		genDoc.Validators = append(genDoc.Validators, types.GenesisValidator{
+			PubKey: privKey.PubKey(),
+			Power:  10,
+			Name:   fmt.Sprintf("validator%d", i),
+		})
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+
+	for i := range privVals {
+		sim.nodes = append(sim.nodes, sim.newNode(t, i, genDoc, privVals[i]))
+	}
+	return sim
+}
+
+func (sim *simulation) newNode(t testing.TB, i int, genDoc *types.GenesisDoc, privVal types.MockPV) *simNode {
+	logger := log.TestingLogger().With("validator", i)
+
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	state.Version.Consensus.App = kvstore.ProtocolVersion
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	require.NoError(t, stateStore.Save(state))
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()), proxy.NopMetrics())
+	proxyApp.SetLogger(logger.With("module", "proxy"))
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() {
+		if err := proxyApp.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(logger.With("module", "events"))
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	node := &simNode{
+		index:     i,
+		id:        p2p.ID(fmt.Sprintf("validator%d", i)),
+		privVal:   privVal,
+		evpool:    &simEvidencePool{reported: make(map[string]struct{})},
+		byzantine: len(sim.faults[i]) > 0,
+		seen:      make(map[string]struct{}),
+	}
+	node.ticker = &simTicker{sim: sim, node: i}
+
+	mempool := emptyMempool{}
+	blockExec := sm.NewBlockExecutor(stateStore, logger, proxyApp.Consensus(), mempool, sm.EmptyEvidencePool{})
+	node.cs = NewState(sim.config, state.Copy(), blockExec, blockStore, mempool, node.evpool)
+	node.cs.SetLogger(logger)
+	node.cs.SetEventBus(eventBus)
+	node.cs.SetPrivValidator(privVal)
+	node.cs.SetTimeoutTicker(node.ticker)
+	return node
+}
+
+// run runs the simulation until every honest validator committed
+// scenario.Heights blocks, or an invariant is violated.
+func (sim *simulation) run() error {
+	for _, node := range sim.nodes {
+		node.cs.scheduleRound0(node.cs.GetRoundState())
+	}
+
+	for !sim.done() {
+		if len(sim.events) == 0 {
+			return fmt.Errorf("liveness violated: no more events, heights %v", sim.heights())
+		}
+		ev := heap.Pop(&sim.events).(*simEvent)
+		if ev.at > sim.scenario.MaxTime {
+			return fmt.Errorf("liveness violated: heights %v after %v", sim.heights(), sim.scenario.MaxTime)
+		}
+		sim.now = ev.at
+
+		node := sim.nodes[ev.node]
+		if ev.timeout != nil {
+			if ev.gen != node.ticker.gen {
+				continue // replaced by a later timeout
+			}
+			sim.tracef(node, "timeout %v", ev.timeout)
+			node.cs.handleTimeout(*ev.timeout, *node.cs.GetRoundState())
+		} else if !sim.deliver(node, ev.from, ev.msgBytes) {
+			continue
+		}
+		sim.settle(node)
+
+		if err := sim.checkSafety(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sim *simulation) done() bool {
+	for _, node := range sim.nodes {
+		if !node.byzantine && node.cs.blockStore.Height() < sim.scenario.Heights {
+			return false
+		}
+	}
+	return true
+}
+
+func (sim *simulation) heights() []int64 {
+	heights := make([]int64, len(sim.nodes))
+	for i, node := range sim.nodes {
+		heights[i] = node.cs.blockStore.Height()
+	}
+	return heights
+}
+
+// deliver hands a message sent by another validator to the node, unless it
+// already received it. Honest nodes relay it to the others.
+func (sim *simulation) deliver(node *simNode, from int, msgBytes []byte) bool {
+	key := string(msgBytes)
+	if _, ok := node.seen[key]; ok {
+		return false
+	}
+	msg := sim.decode(msgBytes)
+	if msgHeight(msg) < node.cs.Height {
+		return false
+	}
+	sim.tracef(node, "recv %s from %d", describeSimMsg(msg), from)
+
+	node.seen[key] = struct{}{}
+	node.inbox = append(node.inbox, simInboxMsg{from, msgBytes})
+	if !node.byzantine {
+		for _, other := range sim.nodes {
+			if other != node && other.index != from {
+				sim.send(node, other, msgBytes, 0)
+			}
+		}
+	}
+	node.cs.handleMsg(msgInfo{msg, sim.nodes[from].id})
+	return true
+}
+
+// settle processes the messages the node produced, and hands its State the
+// messages of the current height again each time it moves on, until it
+// stops making progress.
+func (sim *simulation) settle(node *simNode) {
+	for {
+		sim.flush(node)
+
+		rs := node.cs.GetRoundState()
+		progress := simProgress{rs.Height, rs.Round, rs.Step, rs.ProposalBlockParts != nil}
+		if progress == node.progress {
+			return
+		}
+		if progress.height != node.progress.height {
+			sim.pruneInbox(node, progress.height)
+		}
+		node.progress = progress
+
+		for _, m := range node.inbox {
+			msg := sim.decode(m.msgBytes)
+			if msgHeight(msg) == progress.height {
+				node.cs.handleMsg(msgInfo{msg, sim.nodes[m.from].id})
+			}
+		}
+	}
+}
+
+// flush processes the proposals, block parts and votes the node produced,
+// and broadcasts them.
+func (sim *simulation) flush(node *simNode) {
+	for {
+		select {
+		case <-node.cs.statsMsgQueue:
+			continue
+		case mi := <-node.cs.internalMsgQueue:
+			sim.tracef(node, "own %s", describeSimMsg(mi.Msg))
+			node.cs.handleMsg(mi)
+			sim.broadcast(node, mi.Msg)
+		default:
+			return
+		}
+	}
+}
+
+func (sim *simulation) pruneInbox(node *simNode, height int64) {
+	inbox := node.inbox[:0]
+	for _, m := range node.inbox {
+		if msgHeight(sim.decode(m.msgBytes)) >= height {
+			inbox = append(inbox, m)
+		} else {
+			delete(node.seen, string(m.msgBytes))
+		}
+	}
+	node.inbox = inbox
+}
+
+// broadcast sends a message of the node to all the others, applying the
+// faults of the node.
+func (sim *simulation) broadcast(node *simNode, msg Message) {
+	msgBytes := sim.encode(msg)
+	var conflicting []byte
+	for _, other := range sim.nodes {
+		if other == node {
+			continue
+		}
+		bz, delay := msgBytes, time.Duration(0)
+		for _, f := range sim.faults[node.index] {
+			if !f.applies(msg, other.index) {
+				continue
+			}
+			switch f.Kind {
+			case simEquivocate:
+				if conflicting == nil {
+					conflicting = sim.encode(sim.conflictingVote(node, msg.(*VoteMessage).Vote))
+				}
+				bz = conflicting
+			case simWithhold:
+				bz = nil
+			case simDelay:
+				delay += f.Delay
+			}
+		}
+		if bz == nil {
+			sim.tracef(node, "withhold %s from %d", describeSimMsg(msg), other.index)
+			continue
+		}
+		if conflicting != nil && bytes.Equal(bz, conflicting) {
+			sim.tracef(node, "equivocate to %d", other.index)
+		}
+		sim.send(node, other, bz, delay)
+	}
+}
+
+// conflictingVote returns a vote of the node for another block than vote,
+// signed by the node.
+func (sim *simulation) conflictingVote(node *simNode, vote *types.Vote) *VoteMessage {
+	conflicting := vote.Copy()
+	if vote.BlockID.IsZero() {
+		conflicting.BlockID = types.BlockID{
+			Hash:          tmhash.Sum([]byte("equivocation")),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("equivocation parts"))},
+		}
+	} else {
+		conflicting.BlockID = types.BlockID{}
+	}
+	v := conflicting.ToProto()
+	if err := node.privVal.SignVote(simChainID, v); err != nil {
+		panic(err)
+	}
+	conflicting.Signature = v.Signature
+	return &VoteMessage{conflicting}
+}
+
+func (sim *simulation) send(from, to *simNode, msgBytes []byte, delay time.Duration) {
+	latency := sim.scenario.MinLatency
+	if spread := sim.scenario.MaxLatency - sim.scenario.MinLatency; spread > 0 {
+		latency += time.Duration(sim.rng.Int63n(int64(spread) + 1))
+	}
+	sim.push(&simEvent{at: sim.now + latency + delay, node: to.index, from: from.index, msgBytes: msgBytes})
+}
+
+func (sim *simulation) push(ev *simEvent) {
+	sim.seq++
+	ev.seq = sim.seq
+	heap.Push(&sim.events, ev)
+}
+
+// checkSafety checks the blocks the node committed since the last check
+// against the ones committed by the other honest validators.
+func (sim *simulation) checkSafety(node *simNode) error {
+	if node.byzantine {
+		return nil
+	}
+	for h := node.checked + 1; h <= node.cs.blockStore.Height(); h++ {
+		hash := node.cs.blockStore.LoadBlockMeta(h).BlockID.Hash
+		decided, ok := sim.decided[h]
+		if !ok {
+			sim.decided[h] = hash
+			sim.tracef(node, "commit %d", h)
+		} else if !bytes.Equal(decided, hash) {
+			return fmt.Errorf("safety violated: validator %d committed %X at height %d, another validator %X",
+				node.index, hash, h, decided)
+		}
+		node.checked = h
+	}
+	return nil
+}
+
+// reported returns the number of distinct conflicting votes reported by the
+// honest validators.
+func (sim *simulation) reported() int {
+	n := 0
+	for _, node := range sim.nodes {
+		if !node.byzantine {
+			n += len(node.evpool.reported)
+		}
+	}
+	return n
+}
+
+func (sim *simulation) encode(msg Message) []byte {
+	pb, err := MsgToProto(msg)
+	if err != nil {
+		panic(err)
+	}
+	bz, err := proto.Marshal(pb.(p2p.Wrapper).Wrap())
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (sim *simulation) decode(msgBytes []byte) Message {
+	pb := &cmtcons.Message{}
+	if err := proto.Unmarshal(msgBytes, pb); err != nil {
+		panic(err)
+	}
+	inner, err := pb.Unwrap()
+	if err != nil {
+		panic(err)
+	}
+	msg, err := MsgFromProto(inner)
+	if err != nil {
+		panic(err)
+	}
+	return msg
+}
+
+func (sim *simulation) tracef(node *simNode, format string, args ...interface{}) {
+	sim.trace = append(sim.trace, fmt.Sprintf("%10v validator%d %s", sim.now, node.index, fmt.Sprintf(format, args...)))
+}
+
+func msgHeight(msg Message) int64 {
+	switch msg := msg.(type) {
+	case *ProposalMessage:
+		return msg.Proposal.Height
+	case *BlockPartMessage:
+		return msg.Height
+	case *VoteMessage:
+		return msg.Vote.Height
+	}
+	panic(fmt.Sprintf("unexpected message %T", msg))
+}
+
+// describeSimMsg describes a message without the parts depending on the wall
+// clock, like block hashes.
+func describeSimMsg(msg Message) string {
+	switch msg := msg.(type) {
+	case *ProposalMessage:
+		return fmt.Sprintf("Proposal %d/%d pol=%d", msg.Proposal.Height, msg.Proposal.Round, msg.Proposal.POLRound)
+	case *BlockPartMessage:
+		return fmt.Sprintf("BlockPart %d/%d #%d", msg.Height, msg.Round, msg.Part.Index)
+	case *VoteMessage:
+		target := "block"
+		if msg.Vote.BlockID.IsZero() {
+			target = "nil"
+		}
+		return fmt.Sprintf("%v %d/%d by %d for %s",
+			msg.Vote.Type, msg.Vote.Height, msg.Vote.Round, msg.Vote.ValidatorIndex, target)
+	}
+	return fmt.Sprintf("%T", msg)
+}
+
+//-------------------------------------------------------------------------
+
+// runSimulation runs the scenario. If an invariant is violated, it fails the
+// test with the minimal scenario still violating an invariant, and its
+// trace.
+func runSimulation(t testing.TB, s simScenario) *simulation {
+	t.Helper()
+	sim := newSimulation(t, s)
+	err := sim.run()
+	if err == nil {
+		return sim
+	}
+
+	minimal := minimizeScenario(s, func(s simScenario) bool {
+		return newSimulation(t, s).run() != nil
+	})
+	sim = newSimulation(t, minimal)
+	err = sim.run()
+	t.Fatalf("%v\nminimal scenario: %+v\ntrace:\n%s", err, minimal, strings.Join(sim.trace, "\n"))
+	return nil
+}
+
+// minimizeScenario returns a scenario with as few faults and heights as
+// possible for which fails still returns true, given that it does for s.
+func minimizeScenario(s simScenario, fails func(simScenario) bool) simScenario {
+	for i := 0; i < len(s.Faults); {
+		candidate := s
+		candidate.Faults = append(append([]simFault{}, s.Faults[:i]...), s.Faults[i+1:]...)
+		if fails(candidate) {
+			s = candidate
+		} else {
+			i++
+		}
+	}
+	for h := int64(1); h < s.Heights; h++ {
+		candidate := s
+		candidate.Heights = h
+		if fails(candidate) {
+			return candidate
+		}
+	}
+	return s
+}
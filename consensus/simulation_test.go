@@ -0,0 +1,150 @@
+package consensus
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulationHonest(t *testing.T) {
+	for seed := int64(1); seed <= 3; seed++ {
+		runSimulation(t, simScenario{Seed: seed, Validators: 4, Heights: 5})
+	}
+}
+
+func TestSimulationIsDeterministic(t *testing.T) {
+	s := simScenario{
+		Seed:       7,
+		Validators: 4,
+		Heights:    3,
+		Faults:     []simFault{{Node: 1, Kind: simDelay, Msgs: simVotes, Delay: 30 * time.Millisecond}},
+	}
+	sim1 := runSimulation(t, s)
+	sim2 := runSimulation(t, s)
+	assert.Equal(t, sim1.trace, sim2.trace)
+
+	s.Seed = 8
+	sim3 := runSimulation(t, s)
+	assert.NotEqual(t, sim1.trace, sim3.trace)
+}
+
+func TestSimulationEquivocation(t *testing.T) {
+	sim := runSimulation(t, simScenario{
+		Seed:       1,
+		Validators: 4,
+		Heights:    4,
+		Faults:     []simFault{{Node: 0, Kind: simEquivocate}},
+	})
+	// honest validators relay both votes, so they all see the equivocation
+	assert.Positive(t, sim.reported())
+}
+
+func TestSimulationWithholding(t *testing.T) {
+	// validator 2 never sends its proposals nor precommits, so the rounds it
+	// proposes time out
+	sim := runSimulation(t, simScenario{
+		Seed:       2,
+		Validators: 4,
+		Heights:    6,
+		Faults:     []simFault{{Node: 2, Kind: simWithhold, Msgs: simProposal | simPrecommit}},
+	})
+	withheld := 0
+	for _, line := range sim.trace {
+		if strings.Contains(line, "withhold Proposal") {
+			withheld++
+		}
+	}
+	assert.Positive(t, withheld)
+}
+
+func TestSimulationDelayedVotes(t *testing.T) {
+	runSimulation(t, simScenario{
+		Seed:       3,
+		Validators: 7,
+		Heights:    4,
+		Faults: []simFault{
+			{Node: 5, Kind: simDelay, Msgs: simVotes, Delay: time.Second},
+			{Node: 6, Kind: simDelay, Msgs: simProposal, Targets: []int{0, 1}, Delay: 200 * time.Millisecond},
+		},
+	})
+}
+
+func TestSimulationDetectsLivenessViolation(t *testing.T) {
+	// with half of the voting power withholding everything, no block can be
+	// committed
+	sim := newSimulation(t, simScenario{
+		Seed:       1,
+		Validators: 4,
+		Heights:    1,
+		MaxTime:    5 * time.Second,
+		Faults: []simFault{
+			{Node: 0, Kind: simWithhold},
+			{Node: 1, Kind: simWithhold},
+		},
+	})
+	err := sim.run()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "liveness violated")
+}
+
+// simFatalRecorder records the failure of a simulation instead of failing the
+// test.
+type simFatalRecorder struct {
+	testing.TB
+	failure string
+}
+
+func (r *simFatalRecorder) Fatalf(format string, args ...interface{}) {
+	r.failure = fmt.Sprintf(format, args...)
+}
+
+func TestSimulationDetectsSafetyViolation(t *testing.T) {
+	// another validator committed a different block at height 2, so two
+	// blocks are committed at that height
+	r := &simFatalRecorder{TB: t}
+	sim := runSimulation(r, simScenario{
+		Seed:       1,
+		Validators: 4,
+		Heights:    4,
+		Faults:     []simFault{{Node: 3, Kind: simDelay, Msgs: simVotes, Delay: 20 * time.Millisecond}},
+		Forks:      map[int64]string{2: "fork"},
+	})
+	require.Nil(t, sim)
+	assert.Contains(t, r.failure, "safety violated")
+	assert.Contains(t, r.failure, "at height 2")
+	// the delay isn't needed to violate safety, so it is minimized away
+	assert.Contains(t, r.failure, "minimal scenario: {Seed:1 Validators:4")
+	assert.Contains(t, r.failure, "Faults:[] Forks:map[2:fork]}")
+	assert.Contains(t, r.failure, "trace:\n")
+	assert.Contains(t, r.failure, "commit 1")
+}
+
+func TestMinimizeScenario(t *testing.T) {
+	s := simScenario{
+		Seed:       1,
+		Validators: 4,
+		Heights:    10,
+		Faults: []simFault{
+			{Node: 0, Kind: simDelay, Delay: time.Second},
+			{Node: 1, Kind: simEquivocate},
+			{Node: 2, Kind: simWithhold},
+		},
+	}
+	// fails from height 3 on, as long as validator 1 equivocates
+	fails := func(s simScenario) bool {
+		for _, f := range s.Faults {
+			if f.Node == 1 && f.Kind == simEquivocate {
+				return s.Heights >= 3
+			}
+		}
+		return false
+	}
+	minimal := minimizeScenario(s, fails)
+	assert.Equal(t, []simFault{{Node: 1, Kind: simEquivocate}}, minimal.Faults)
+	assert.EqualValues(t, 3, minimal.Heights)
+	assert.Equal(t, s.Seed, minimal.Seed)
+}
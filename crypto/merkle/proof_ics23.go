@@ -0,0 +1,125 @@
+package merkle
+
+import (
+	"fmt"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+const (
+	// ProofOpICS23IAVL is the type of the ICS23 proofs of IAVL trees, e.g. of
+	// the stores of a Cosmos SDK application.
+	ProofOpICS23IAVL = "ics23:iavl"
+	// ProofOpICS23Simple is the type of the ICS23 proofs of the simple Merkle
+	// trees of this package, e.g. of the roots of the stores of a Cosmos SDK
+	// multistore.
+	ProofOpICS23Simple = "ics23:simple"
+	// ProofOpICS23SMT is the type of the ICS23 proofs of sparse Merkle trees.
+	ProofOpICS23SMT = "ics23:smt"
+)
+
+// ICS23Op takes the value of a key, or no value, as argument and produces the
+// root hash of a tree, proving the existence of the key with the value, or
+// its absence, with an ICS23 commitment proof. The tree is described by the
+// ICS23 spec of the type of the operator.
+//
+// The operators of several trees are chained, e.g. an "ics23:iavl" operator
+// proving a key in a store followed by an "ics23:simple" operator proving the
+// root of the store in a multistore.
+type ICS23Op struct {
+	// Encoded in ProofOp.Type.
+	typ  string
+	spec *ics23.ProofSpec
+
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data.
+	Proof *ics23.CommitmentProof
+}
+
+var _ ProofOperator = ICS23Op{}
+
+// NewICS23Op returns the operator of type typ, one of the ProofOpICS23 types,
+// proving the key with proof.
+func NewICS23Op(typ string, key []byte, proof *ics23.CommitmentProof) (ICS23Op, error) {
+	spec, err := ics23Spec(typ)
+	if err != nil {
+		return ICS23Op{}, err
+	}
+	return ICS23Op{
+		typ:   typ,
+		spec:  spec,
+		key:   key,
+		Proof: proof,
+	}, nil
+}
+
+// ICS23OpDecoder decodes the operators of the ProofOpICS23 types.
+func ICS23OpDecoder(pop cmtcrypto.ProofOp) (ProofOperator, error) {
+	proof := &ics23.CommitmentProof{}
+	if err := proof.Unmarshal(pop.Data); err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into CommitmentProof: %w", err)
+	}
+	return NewICS23Op(pop.Type, pop.Key, proof)
+}
+
+func ics23Spec(typ string) (*ics23.ProofSpec, error) {
+	switch typ {
+	case ProofOpICS23IAVL:
+		return ics23.IavlSpec, nil
+	case ProofOpICS23Simple:
+		return ics23.TendermintSpec, nil
+	case ProofOpICS23SMT:
+		return ics23.SmtSpec, nil
+	default:
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want one of %v, %v or %v",
+			typ, ProofOpICS23IAVL, ProofOpICS23Simple, ProofOpICS23SMT)
+	}
+}
+
+func (op ICS23Op) ProofOp() cmtcrypto.ProofOp {
+	bz, err := op.Proof.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return cmtcrypto.ProofOp{
+		Type: op.typ,
+		Key:  op.key,
+		Data: bz,
+	}
+}
+
+func (op ICS23Op) String() string {
+	return fmt.Sprintf("ICS23Op{%v %v}", op.typ, op.GetKey())
+}
+
+// Run verifies the existence of the key with the value args[0], or its
+// absence if args is empty, and returns the root hash of the tree.
+func (op ICS23Op) Run(args [][]byte) ([][]byte, error) {
+	root, err := op.Proof.Calculate()
+	if err != nil {
+		return nil, fmt.Errorf("could not calculate root for proof: %w", err)
+	}
+
+	switch len(args) {
+	case 0:
+		if !ics23.VerifyNonMembership(op.spec, root, op.Proof, op.key) {
+			return nil, fmt.Errorf("proof did not verify absence of key %X", op.key)
+		}
+	case 1:
+		if !ics23.VerifyMembership(op.spec, root, op.Proof, op.key, args[0]) {
+			return nil, fmt.Errorf("proof did not verify existence of key %X with given value %X", op.key, args[0])
+		}
+	default:
+		return nil, fmt.Errorf("expected 0 or 1 args, got %v", len(args))
+	}
+
+	return [][]byte{root}, nil
+}
+
+func (op ICS23Op) GetKey() []byte {
+	return op.key
+}
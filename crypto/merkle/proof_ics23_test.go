@@ -0,0 +1,143 @@
+package merkle
+
+import (
+	"bytes"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+// kvLeaf returns the leaf of the key/value pair in a simple Merkle tree, as
+// described by ics23.TendermintSpec.
+func kvLeaf(key, value []byte) []byte {
+	var b bytes.Buffer
+	if err := encodeByteSlice(&b, key); err != nil {
+		panic(err)
+	}
+	if err := encodeByteSlice(&b, tmhash.Sum(value)); err != nil {
+		panic(err)
+	}
+	return b.Bytes()
+}
+
+// ics23Tree returns the root of the simple Merkle tree of the two key/value
+// pairs, the left key first, and the ICS23 existence proofs of the keys.
+func ics23Tree(leftKey, leftValue, rightKey, rightValue []byte) (root []byte, left, right *ics23.ExistenceProof) {
+	leftLeaf, rightLeaf := kvLeaf(leftKey, leftValue), kvLeaf(rightKey, rightValue)
+	root = HashFromByteSlices([][]byte{leftLeaf, rightLeaf})
+
+	left = &ics23.ExistenceProof{
+		Key:   leftKey,
+		Value: leftValue,
+		Leaf:  ics23.TendermintSpec.LeafSpec,
+		Path: []*ics23.InnerOp{{
+			Hash:   ics23.HashOp_SHA256,
+			Prefix: innerPrefix,
+			Suffix: leafHash(rightLeaf),
+		}},
+	}
+	right = &ics23.ExistenceProof{
+		Key:   rightKey,
+		Value: rightValue,
+		Leaf:  ics23.TendermintSpec.LeafSpec,
+		Path: []*ics23.InnerOp{{
+			Hash:   ics23.HashOp_SHA256,
+			Prefix: append(append([]byte{}, innerPrefix...), leafHash(leftLeaf)...),
+		}},
+	}
+	return root, left, right
+}
+
+func existenceProof(ep *ics23.ExistenceProof) *ics23.CommitmentProof {
+	return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: ep}}
+}
+
+func nonExistenceProof(key []byte, left, right *ics23.ExistenceProof) *ics23.CommitmentProof {
+	return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{
+		Nonexist: &ics23.NonExistenceProof{Key: key, Left: left, Right: right},
+	}}
+}
+
+func TestICS23OpRun(t *testing.T) {
+	root, a, c := ics23Tree([]byte("a"), []byte("1"), []byte("c"), []byte("3"))
+
+	// existence
+	op, err := NewICS23Op(ProofOpICS23Simple, []byte("a"), existenceProof(a))
+	require.NoError(t, err)
+	output, err := op.Run([][]byte{[]byte("1")})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{root}, output)
+
+	_, err = op.Run([][]byte{[]byte("2")})
+	assert.Error(t, err, "wrong value")
+	_, err = op.Run(nil)
+	assert.Error(t, err, "existence proof of an absence")
+	_, err = op.Run([][]byte{[]byte("1"), []byte("1")})
+	assert.Error(t, err, "too many args")
+
+	op, err = NewICS23Op(ProofOpICS23Simple, []byte("c"), existenceProof(a))
+	require.NoError(t, err)
+	_, err = op.Run([][]byte{[]byte("1")})
+	assert.Error(t, err, "proof of another key")
+
+	// absence
+	op, err = NewICS23Op(ProofOpICS23Simple, []byte("b"), nonExistenceProof([]byte("b"), a, c))
+	require.NoError(t, err)
+	output, err = op.Run(nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{root}, output)
+
+	_, err = op.Run([][]byte{[]byte("2")})
+	assert.Error(t, err, "absence proof of an existence")
+
+	op, err = NewICS23Op(ProofOpICS23Simple, []byte("d"), nonExistenceProof([]byte("d"), a, c))
+	require.NoError(t, err)
+	_, err = op.Run(nil)
+	assert.Error(t, err, "key out of the range of the neighbors")
+
+	// the spec of the type must match the tree
+	op, err = NewICS23Op(ProofOpICS23IAVL, []byte("a"), existenceProof(a))
+	require.NoError(t, err)
+	_, err = op.Run([][]byte{[]byte("1")})
+	assert.Error(t, err, "simple tree with the IAVL spec")
+
+	_, err = NewICS23Op("ics23:unknown", []byte("a"), existenceProof(a))
+	assert.Error(t, err)
+}
+
+func TestICS23OpProofRuntime(t *testing.T) {
+	// a key in a store, and the root of the store in a multistore
+	storeRoot, a, c := ics23Tree([]byte("a"), []byte("1"), []byte("c"), []byte("3"))
+	root, bank, _ := ics23Tree([]byte("bank"), storeRoot, []byte("staking"), []byte("root"))
+
+	prt := DefaultProofRuntime()
+	for _, typ := range []string{ProofOpICS23IAVL, ProofOpICS23Simple, ProofOpICS23SMT} {
+		prt.RegisterOpDecoder(typ, ICS23OpDecoder)
+	}
+
+	proofOps := func(key string, proof *ics23.CommitmentProof) *cmtcrypto.ProofOps {
+		storeOp, err := NewICS23Op(ProofOpICS23Simple, []byte(key), proof)
+		require.NoError(t, err)
+		multistoreOp, err := NewICS23Op(ProofOpICS23Simple, []byte("bank"), existenceProof(bank))
+		require.NoError(t, err)
+		return &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{storeOp.ProofOp(), multistoreOp.ProofOp()}}
+	}
+	keyPath := func(key string) string {
+		return KeyPath{}.AppendKey([]byte("bank"), KeyEncodingURL).AppendKey([]byte(key), KeyEncodingURL).String()
+	}
+
+	ops := proofOps("a", existenceProof(a))
+	require.NoError(t, prt.VerifyValue(ops, root, keyPath("a"), []byte("1")))
+	assert.Error(t, prt.VerifyValue(ops, root, keyPath("a"), []byte("2")))
+	assert.Error(t, prt.VerifyValue(ops, root, keyPath("c"), []byte("1")))
+	assert.Error(t, prt.VerifyValue(ops, storeRoot, keyPath("a"), []byte("1")))
+
+	ops = proofOps("b", nonExistenceProof([]byte("b"), a, c))
+	require.NoError(t, prt.VerifyAbsence(ops, root, keyPath("b")))
+	assert.Error(t, prt.VerifyValue(ops, root, keyPath("b"), []byte("2")))
+}
@@ -0,0 +1,207 @@
+// Package eventlog keeps a bounded log of recent events, so that clients can
+// read the events they missed, e.g. while disconnected, after a cursor.
+package eventlog
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// ErrCursorExpired is returned when the items after a cursor are not all in
+// the log anymore, which leaves a gap between the cursor and the oldest item.
+var ErrCursorExpired = errors.New("cursor has expired")
+
+// Cursor identifies an item of a Log. Cursors of a log increase
+// monotonically. The zero Cursor is before all the items.
+//
+// A cursor is only valid for the log it was returned by: a log created anew,
+// e.g. after a restart, treats the cursors of the previous one as expired.
+type Cursor struct {
+	epoch int64 // creation time of the log
+	seq   uint64
+}
+
+// IsZero returns true for the zero Cursor.
+func (c Cursor) IsZero() bool {
+	return c == Cursor{}
+}
+
+// String returns the cursor in the form parsed by ParseCursor.
+func (c Cursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%x-%x", c.epoch, c.seq)
+}
+
+// ParseCursor parses a cursor returned by Cursor.String. The empty string is
+// the zero Cursor.
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+	epoch, seq, ok := strings.Cut(s, "-")
+	if !ok {
+		return Cursor{}, fmt.Errorf("invalid cursor %q", s)
+	}
+	e, err := strconv.ParseInt(epoch, 16, 64)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor %q: %w", s, err)
+	}
+	n, err := strconv.ParseUint(seq, 16, 64)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor %q: %w", s, err)
+	}
+	return Cursor{epoch: e, seq: n}, nil
+}
+
+// Item is an event of the log.
+type Item struct {
+	Cursor Cursor
+	Time   time.Time // when the item was added
+	Data   interface{}
+	Events map[string][]string
+}
+
+// Log is a bounded log of events. Items are dropped once older than the
+// window size, or once there are more than the maximum number of items.
+// It is safe for concurrent use.
+type Log struct {
+	windowSize time.Duration
+	maxItems   int
+
+	mtx     cmtsync.RWMutex
+	epoch   int64
+	lastSeq uint64
+	items   []*Item       // oldest first
+	changed chan struct{} // closed, and replaced, when an item is added
+
+	now func() time.Time
+}
+
+// New returns an empty Log keeping the items of the last windowSize, up to
+// maxItems of them. A maxItems of 0 means no limit.
+func New(windowSize time.Duration, maxItems int) (*Log, error) {
+	if windowSize <= 0 {
+		return nil, errors.New("window size must be positive")
+	}
+	if maxItems < 0 {
+		return nil, errors.New("max items can't be negative")
+	}
+	return &Log{
+		windowSize: windowSize,
+		maxItems:   maxItems,
+		epoch:      time.Now().UnixNano(),
+		changed:    make(chan struct{}),
+		now:        time.Now,
+	}, nil
+}
+
+// Add appends an item with the given data and events to the log, and
+// returns its cursor.
+func (l *Log) Add(data interface{}, events map[string][]string) Cursor {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	l.lastSeq++
+	item := &Item{
+		Cursor: Cursor{epoch: l.epoch, seq: l.lastSeq},
+		Time:   now,
+		Data:   data,
+		Events: events,
+	}
+	l.items = append(l.items, item)
+	l.prune(now)
+
+	close(l.changed)
+	l.changed = make(chan struct{})
+	return item.Cursor
+}
+
+// prune drops the items which are out of the window, or in excess.
+func (l *Log) prune(now time.Time) {
+	i := 0
+	for i < len(l.items) && now.Sub(l.items[i].Time) > l.windowSize {
+		i++
+	}
+	if l.maxItems > 0 && len(l.items)-i > l.maxItems {
+		i = len(l.items) - l.maxItems
+	}
+	if i > 0 {
+		// copy, so that the dropped items can be collected
+		l.items = append([]*Item(nil), l.items[i:]...)
+	}
+}
+
+// Changed returns a channel which is closed once an item is added. To wait
+// for new items without missing any, get the channel before scanning.
+func (l *Log) Changed() <-chan struct{} {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	return l.changed
+}
+
+// Newest returns the cursor of the newest item, which may have been dropped
+// already. It is the zero Cursor if no item was ever added.
+func (l *Log) Newest() Cursor {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	if l.lastSeq == 0 {
+		return Cursor{}
+	}
+	return Cursor{epoch: l.epoch, seq: l.lastSeq}
+}
+
+// Scan returns, oldest first, up to maxItems items after the cursor whose
+// events match the query, with the cursor to scan after next time, and
+// whether there are more items to scan.
+//
+// It returns an error wrapping ErrCursorExpired if items after the cursor
+// were dropped, or the cursor is from another log. The zero Cursor scans
+// from the oldest item.
+func (l *Log) Scan(after Cursor, query cmtpubsub.Query, maxItems int) (items []*Item, next Cursor, more bool, err error) {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	next = after
+	start := 0
+	if !after.IsZero() {
+		switch {
+		case after.epoch != l.epoch:
+			return nil, after, false, fmt.Errorf("%w: cursor is from another log", ErrCursorExpired)
+		case after.seq > l.lastSeq:
+			return nil, after, false, fmt.Errorf("cursor %v is after the newest item", after)
+		}
+		oldest := l.lastSeq + 1
+		if len(l.items) > 0 {
+			oldest = l.items[0].Cursor.seq
+		}
+		if after.seq+1 < oldest {
+			return nil, after, false, fmt.Errorf("%w: oldest available cursor is %v",
+				ErrCursorExpired, Cursor{epoch: l.epoch, seq: oldest - 1})
+		}
+		start = int(after.seq + 1 - oldest)
+	}
+
+	for _, item := range l.items[start:] {
+		if len(items) == maxItems {
+			return items, next, true, nil
+		}
+		match, err := query.Matches(item.Events)
+		if err != nil {
+			return nil, after, false, err
+		}
+		if match {
+			items = append(items, item)
+		}
+		next = item.Cursor
+	}
+	return items, next, false, nil
+}
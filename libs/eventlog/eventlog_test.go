@@ -0,0 +1,145 @@
+package eventlog
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+)
+
+func newTestLog(t *testing.T, windowSize time.Duration, maxItems int) (*Log, *time.Time) {
+	l, err := New(windowSize, maxItems)
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func eventsOf(typ string) map[string][]string {
+	return map[string][]string{"tm.event": {typ}}
+}
+
+func TestCursor(t *testing.T) {
+	l, _ := newTestLog(t, time.Minute, 0)
+	c1 := l.Add(1, nil)
+	c2 := l.Add(2, nil)
+	assert.NotEqual(t, c1, c2)
+	assert.Equal(t, c2, l.Newest())
+
+	parsed, err := ParseCursor(c2.String())
+	require.NoError(t, err)
+	assert.Equal(t, c2, parsed)
+
+	zero, err := ParseCursor("")
+	require.NoError(t, err)
+	assert.True(t, zero.IsZero())
+
+	for _, s := range []string{"1", "x-1", "1-y"} {
+		_, err := ParseCursor(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestScan(t *testing.T) {
+	l, _ := newTestLog(t, time.Minute, 0)
+	var cursors []Cursor
+	for i, typ := range []string{"NewBlock", "Tx", "Tx", "NewBlock", "Tx"} {
+		cursors = append(cursors, l.Add(i, eventsOf(typ)))
+	}
+
+	items, next, more, err := l.Scan(Cursor{}, query.Empty{}, 10)
+	require.NoError(t, err)
+	assert.Len(t, items, 5)
+	assert.Equal(t, cursors[4], next)
+	assert.False(t, more)
+
+	// paging
+	items, next, more, err = l.Scan(cursors[0], query.Empty{}, 2)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, 1, items[0].Data)
+	assert.Equal(t, 2, items[1].Data)
+	assert.Equal(t, cursors[2], next)
+	assert.True(t, more)
+
+	// filtering: next moves past the items which do not match
+	q := query.MustParse("tm.event = 'NewBlock'")
+	items, next, more, err = l.Scan(cursors[0], q, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, 3, items[0].Data)
+	assert.Equal(t, cursors[4], next)
+	assert.False(t, more)
+
+	// nothing after the newest
+	items, next, more, err = l.Scan(cursors[4], query.Empty{}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	assert.Equal(t, cursors[4], next)
+	assert.False(t, more)
+}
+
+func TestScanExpiredCursor(t *testing.T) {
+	l, now := newTestLog(t, time.Minute, 3)
+	var cursors []Cursor
+	for i := 0; i < 5; i++ {
+		cursors = append(cursors, l.Add(i, nil))
+	}
+
+	// only the 3 newest items are kept
+	_, _, _, err := l.Scan(cursors[0], query.Empty{}, 10)
+	assert.True(t, errors.Is(err, ErrCursorExpired), err)
+	items, _, _, err := l.Scan(cursors[1], query.Empty{}, 10)
+	require.NoError(t, err)
+	assert.Len(t, items, 3)
+	items, _, _, err = l.Scan(Cursor{}, query.Empty{}, 10)
+	require.NoError(t, err)
+	assert.Len(t, items, 3)
+
+	// items out of the window are dropped
+	*now = now.Add(2 * time.Minute)
+	c := l.Add(5, nil)
+	_, _, _, err = l.Scan(cursors[3], query.Empty{}, 10)
+	assert.True(t, errors.Is(err, ErrCursorExpired), err)
+	// no item after the newest dropped one is missing
+	items, _, _, err = l.Scan(cursors[4], query.Empty{}, 10)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+	items, _, _, err = l.Scan(Cursor{}, query.Empty{}, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, c, items[0].Cursor)
+
+	// cursors of another log
+	other, _ := newTestLog(t, time.Minute, 0)
+	other.epoch = l.epoch + 1
+	oc := other.Add(0, nil)
+	_, _, _, err = l.Scan(oc, query.Empty{}, 10)
+	assert.True(t, errors.Is(err, ErrCursorExpired), err)
+
+	// cursors after the newest item
+	_, _, _, err = other.Scan(Cursor{epoch: other.epoch, seq: 5}, query.Empty{}, 10)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrCursorExpired))
+}
+
+func TestChanged(t *testing.T) {
+	l, _ := newTestLog(t, time.Minute, 0)
+	changed := l.Changed()
+	select {
+	case <-changed:
+		t.Fatal("changed without items")
+	default:
+	}
+	l.Add(0, nil)
+	select {
+	case <-changed:
+	default:
+		t.Fatal("not changed after an item was added")
+	}
+	assert.NotEqual(t, changed, l.Changed())
+}
@@ -84,6 +84,31 @@ func TestParser(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"account.owner = 'Ivan' OR account.owner = 'Igor'", true},
+		{"account.owner = 'Ivan' OR account.owner = 'Igor' AND tx.height > 5", true},
+		{"account.owner = 'Ivan' OR", false},
+		{"OR account.owner = 'Ivan'", false},
+		{"account.owner = 'Ivan' ORaccount.owner = 'Igor'", false},
+		{"NOT account.owner = 'Ivan'", true},
+		{"NOT NOT account.owner = 'Ivan'", true},
+		{"NOT(account.owner = 'Ivan')", true},
+		{"NOT", false},
+		{"tx.height > 5 AND NOT slashing EXISTS", true},
+		{"(account.owner = 'Ivan')", true},
+		{"( account.owner = 'Ivan' OR account.owner = 'Igor' ) AND tx.height > 5", true},
+		{"((account.owner = 'Ivan' OR account.owner = 'Igor') AND tx.height > 5) OR slashing EXISTS", true},
+		{"(account.owner = 'Ivan'", false},
+		{"account.owner = 'Ivan')", false},
+		{"()", false},
+		{"account.owner IN ('Ivan', 'Igor')", true},
+		{"account.owner IN('Ivan','Igor')", true},
+		{"account.balance IN (100, 200.5, DATE 2013-05-03, TIME 2013-05-03T14:45:00Z)", true},
+		{"account.owner IN ('Ivan')", true},
+		{"account.owner IN ()", false},
+		{"account.owner IN ('Ivan',)", false},
+		{"account.owner IN 'Ivan'", false},
+		{"NOT account.owner IN ('Ivan', 'Igor') AND tx.height > 5", true},
 	}
 
 	for _, c := range cases {
@@ -6,6 +6,10 @@
 // More: https://github.com/PhilippeSigaud/Pegged/wiki/PEG-Basics
 //
 // It has a support for numbers (integer and floating point), dates and times.
+// Conditions can be combined with AND, OR, NOT and parentheses, and compared to
+// a list of values with IN:
+//
+//	abci.invoice.owner IN ('Ivan', 'Vlad') AND NOT abci.invoice.paid EXISTS
 package query
 
 import (
@@ -25,6 +29,7 @@ var (
 type Query struct {
 	str    string
 	parser *QueryParser
+	expr   *Expr
 }
 
 // Condition represents a single condition within a query and consists of composite key
@@ -35,6 +40,37 @@ type Condition struct {
 	Operand      interface{}
 }
 
+// ExprOp is the kind of an expression, i.e. a condition, or the way it
+// combines other expressions.
+type ExprOp uint8
+
+const (
+	// ExprCondition is a single condition.
+	ExprCondition ExprOp = iota
+	// ExprAnd is true if all its arguments are ("AND").
+	ExprAnd
+	// ExprOr is true if any of its arguments is ("OR", "IN").
+	ExprOr
+	// ExprNot is true if its single argument is not ("NOT").
+	ExprNot
+)
+
+// Expr is the syntax tree of a query, e.g. for
+//
+//	tx.height > 5 AND (account.owner = 'Ivan' OR NOT account.owner EXISTS)
+//
+// an ExprAnd of the condition "tx.height > 5" and of an ExprOr of a
+// condition and of an ExprNot. The IN operator is an ExprOr of the equality
+// conditions, e.g. "account.owner IN ('Ivan', 'Igor')" is
+// "account.owner = 'Ivan' OR account.owner = 'Igor'".
+type Expr struct {
+	Op ExprOp
+	// Condition is the condition of an ExprCondition.
+	Condition Condition
+	// Args are the operands of ExprAnd, ExprOr and ExprNot.
+	Args []*Expr
+}
+
 // New parses the given string and returns a query or error if the string is
 // invalid.
 func New(s string) (*Query, error) {
@@ -45,7 +81,13 @@ func New(s string) (*Query, error) {
 	if err := p.Parse(); err != nil {
 		return nil, err
 	}
-	return &Query{str: s, parser: p}, nil
+	q := &Query{str: s, parser: p}
+	expr, err := q.parseExpr(p.AST())
+	if err != nil {
+		return nil, err
+	}
+	q.expr = expr
+	return q, nil
 }
 
 // MustParse turns the given string into a query or panics; for tests or others
@@ -91,106 +133,211 @@ const (
 	TimeLayout = time.RFC3339
 )
 
+// Expr returns the syntax tree of the query.
+func (q *Query) Expr() *Expr {
+	return q.expr
+}
+
 // Conditions returns a list of conditions. It returns an error if there is any
-// error with the provided grammar in the Query.
+// error with the provided grammar in the Query, or if the query is not a
+// conjunction of conditions, i.e. if it uses OR, NOT or IN: see Expr.
 func (q *Query) Conditions() ([]Condition, error) {
+	conditions, ok := q.expr.Conjunction()
+	if !ok {
+		return nil, fmt.Errorf("query %q is not a conjunction of conditions (it uses OR, NOT or IN)", q.str)
+	}
+	return conditions, nil
+}
+
+// Conjunction returns the conditions of e, and true, if e is a condition or
+// a conjunction of conditions. Otherwise, it returns false.
+func (e *Expr) Conjunction() ([]Condition, bool) {
+	switch e.Op {
+	case ExprCondition:
+		return []Condition{e.Condition}, true
+	case ExprAnd:
+		conditions := make([]Condition, 0, len(e.Args))
+		for _, arg := range e.Args {
+			if arg.Op != ExprCondition {
+				return nil, false
+			}
+			conditions = append(conditions, arg.Condition)
+		}
+		return conditions, true
+	default:
+		return nil, false
+	}
+}
+
+// parseExpr returns the expression of the syntax tree of the parser,
+// starting at node.
+func (q *Query) parseExpr(node *node32) (*Expr, error) {
+	switch node.pegRule {
+	case rulee, rulegroup:
+		return q.parseExpr(childNode(node, ruledisjunction))
+
+	case ruledisjunction, ruleconjunction:
+		op, argRule := ExprOr, ruleconjunction
+		if node.pegRule == ruleconjunction {
+			op, argRule = ExprAnd, ruleterm
+		}
+		var args []*Expr
+		for n := node.up; n != nil; n = n.next {
+			if n.pegRule != argRule {
+				continue
+			}
+			arg, err := q.parseExpr(n)
+			if err != nil {
+				return nil, err
+			}
+			if arg.Op == op {
+				// e.g. "a AND (b AND c)" is "a AND b AND c"
+				args = append(args, arg.Args...)
+				continue
+			}
+			args = append(args, arg)
+		}
+		if len(args) == 1 {
+			return args[0], nil
+		}
+		return &Expr{Op: op, Args: args}, nil
+
+	case ruleterm:
+		arg := node.up
+		if arg.pegRule != rulenot {
+			return q.parseExpr(arg)
+		}
+		expr, err := q.parseExpr(arg.next)
+		if err != nil {
+			return nil, err
+		}
+		return &Expr{Op: ExprNot, Args: []*Expr{expr}}, nil
+
+	case rulecondition:
+		return q.parseCondition(node)
+
+	default:
+		return nil, fmt.Errorf("unexpected %s in the syntax tree of the query", rul3s[node.pegRule])
+	}
+}
+
+// parseCondition returns the expression of a condition of the syntax tree,
+// i.e. an ExprCondition, or the ExprOr of the conditions of the IN operator.
+func (q *Query) parseCondition(node *node32) (*Expr, error) {
 	var (
 		eventAttr string
 		op        Operator
+		operands  []*Expr
 	)
-
-	conditions := make([]Condition, 0)
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for _, token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
+	for n := node.up; n != nil; n = n.next {
+		switch n.pegRule {
 		case ruletag:
-			eventAttr = buffer[begin:end]
-
+			eventAttr = q.text(n)
 		case rulele:
 			op = OpLessEqual
-
 		case rulege:
 			op = OpGreaterEqual
-
 		case rulel:
 			op = OpLess
-
 		case ruleg:
 			op = OpGreater
-
-		case ruleequal:
+		case ruleequal, rulein:
 			op = OpEqual
-
 		case rulecontains:
 			op = OpContains
-
 		case ruleexists:
-			op = OpExists
-			conditions = append(conditions, Condition{eventAttr, op, nil})
-
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-			conditions = append(conditions, Condition{eventAttr, op, valueWithoutSingleQuotes})
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return nil, err
-				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return nil, err
-				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
-			}
-
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
+			return &Expr{Condition: Condition{eventAttr, OpExists, nil}}, nil
+		case ruleoperand, rulevalue, rulenumber, ruletime, ruledate:
+			operand, err := q.parseOperand(n)
 			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
 				return nil, err
 			}
+			operands = append(operands, &Expr{Condition: Condition{eventAttr, op, operand}})
+		}
+	}
+	switch len(operands) {
+	case 0:
+		return nil, fmt.Errorf("condition %q has no operand", q.text(node))
+	case 1:
+		return operands[0], nil
+	default:
+		return &Expr{Op: ExprOr, Args: operands}, nil
+	}
+}
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
-
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
+// parseOperand returns the value of an operand of the syntax tree.
+func (q *Query) parseOperand(node *node32) (interface{}, error) {
+	if node.pegRule == ruleoperand {
+		node = node.up
+	}
+	text := q.text(node)
+	switch node.pegRule {
+	case rulevalue:
+		// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
+		return text[1 : len(text)-1], nil
+
+	case rulenumber:
+		if strings.ContainsAny(text, ".") { // if it looks like a floating-point number
+			value, err := strconv.ParseFloat(text, 64)
 			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
+				return nil, fmt.Errorf(
+					"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
+					err, text,
 				)
-				return nil, err
 			}
+			return value, nil
+		}
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
+				err, text,
+			)
+		}
+		return value, nil
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
+	case ruletime:
+		value, err := time.Parse(TimeLayout, text)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
+				err, text,
+			)
+		}
+		return value, nil
+
+	case ruledate:
+		value, err := time.Parse(DateLayout, text)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
+				err, text,
+			)
 		}
+		return value, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected %s operand in the syntax tree of the query", rul3s[node.pegRule])
 	}
+}
 
-	return conditions, nil
+// text returns the text of a node of the syntax tree, which is the text
+// captured by the node, if any, e.g. without the "DATE " of a date.
+func (q *Query) text(node *node32) string {
+	if n := childNode(node, rulePegText); n != nil {
+		node = n
+	}
+	return string(q.parser.buffer[node.begin:node.end])
+}
+
+// childNode returns the first child of node with the given rule, or nil.
+func childNode(node *node32, rule pegRule) *node32 {
+	for n := node.up; n != nil; n = n.next {
+		if n.pegRule == rule {
+			return n
+		}
+	}
+	return nil
 }
 
 // Matches returns true if the query matches against any event in the given set
@@ -204,161 +351,59 @@ func (q *Query) Matches(events map[string][]string) (bool, error) {
 	if len(events) == 0 {
 		return false, nil
 	}
+	return q.expr.Matches(events)
+}
 
-	var (
-		eventAttr string
-		op        Operator
-	)
-
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order:
-
-	// tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for _, token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
-
-		case rulege:
-			op = OpGreaterEqual
-
-		case rulel:
-			op = OpLess
-
-		case ruleg:
-			op = OpGreater
-
-		case ruleequal:
-			op = OpEqual
-
-		case rulecontains:
-			op = OpContains
-		case ruleexists:
-			op = OpExists
-			if strings.Contains(eventAttr, ".") {
-				// Searching for a full "type.attribute" event.
-				_, ok := events[eventAttr]
-				if !ok {
-					return false, nil
-				}
-			} else {
-				foundEvent := false
-
-			loop:
-				for compositeKey := range events {
-					if strings.Index(compositeKey, eventAttr) == 0 {
-						foundEvent = true
-						break loop
-					}
-				}
-				if !foundEvent {
-					return false, nil
-				}
-			}
-
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-
-			// see if the triplet (event attribute, operator, operand) matches any event
-			// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
-			match, err := match(eventAttr, op, reflect.ValueOf(valueWithoutSingleQuotes), events)
-			if err != nil {
-				return false, err
-			}
-
-			if !match {
-				return false, nil
-			}
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			}
-
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
-
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
+// Matches returns true if the expression matches against the given set of
+// events, as Query.Matches. The arguments of ExprAnd and ExprOr are
+// evaluated in order, and only until the result is known.
+func (e *Expr) Matches(events map[string][]string) (bool, error) {
+	switch e.Op {
+	case ExprAnd:
+		for _, arg := range e.Args {
+			if ok, err := arg.Matches(events); err != nil || !ok {
 				return false, err
 			}
+		}
+		return true, nil
 
-			if !match {
-				return false, nil
+	case ExprOr:
+		for _, arg := range e.Args {
+			if ok, err := arg.Matches(events); err != nil || ok {
+				return ok, err
 			}
+		}
+		return false, nil
 
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
+	case ExprNot:
+		ok, err := e.Args[0].Matches(events)
+		return !ok, err
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
-
-			if !match {
-				return false, nil
-			}
+	default:
+		c := e.Condition
+		if c.Op == OpExists {
+			return exists(c.CompositeKey, events), nil
 		}
+		// see if the triplet (event attribute, operator, operand) matches any event
+		// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
+		return match(c.CompositeKey, c.Op, reflect.ValueOf(c.Operand), events)
 	}
+}
 
-	return true, nil
+// exists returns true if there is an event for the attribute, or, if it is
+// only an event type (without a "."), an event of that type.
+func exists(eventAttr string, events map[string][]string) bool {
+	if strings.Contains(eventAttr, ".") {
+		// Searching for a full "type.attribute" event.
+		_, ok := events[eventAttr]
+		return ok
+	}
+	for compositeKey := range events {
+		if strings.Index(compositeKey, eventAttr) == 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // match returns true if the given triplet (attribute, operator, operand) matches
@@ -19,7 +19,12 @@ type pegRule uint8
 const (
 	ruleUnknown pegRule = iota
 	rulee
+	ruledisjunction
+	ruleconjunction
+	ruleterm
+	rulegroup
 	rulecondition
+	ruleoperand
 	ruletag
 	rulevalue
 	rulenumber
@@ -30,9 +35,12 @@ const (
 	rulemonth
 	ruleday
 	ruleand
+	ruleor
+	rulenot
 	ruleequal
 	rulecontains
 	ruleexists
+	rulein
 	rulele
 	rulege
 	rulel
@@ -43,7 +51,12 @@ const (
 var rul3s = [...]string{
 	"Unknown",
 	"e",
+	"disjunction",
+	"conjunction",
+	"term",
+	"group",
 	"condition",
+	"operand",
 	"tag",
 	"value",
 	"number",
@@ -54,9 +67,12 @@ var rul3s = [...]string{
 	"month",
 	"day",
 	"and",
+	"or",
+	"not",
 	"equal",
 	"contains",
 	"exists",
+	"in",
 	"le",
 	"ge",
 	"l",
@@ -176,7 +192,7 @@ func (t *tokens32) Tokens() []token32 {
 type QueryParser struct {
 	Buffer string
 	buffer []rune
-	rules  [21]func() bool
+	rules  [29]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -356,7 +372,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 e <- <('"' condition (' '+ and ' '+ condition)* '"' !.)> */
+		/* 0 e <- <('"' disjunction '"' !.)> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
@@ -365,1270 +381,1688 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 					goto l0
 				}
 				position++
-				if !_rules[rulecondition]() {
+				if !_rules[ruledisjunction]() {
 					goto l0
 				}
-			l2:
+				if buffer[position] != rune('"') {
+					goto l0
+				}
+				position++
 				{
-					position3, tokenIndex3 := position, tokenIndex
+					position2, tokenIndex2 := position, tokenIndex
+					if !matchDot() {
+						goto l2
+					}
+					goto l0
+				l2:
+					position, tokenIndex = position2, tokenIndex2
+				}
+				add(rulee, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 disjunction <- <(conjunction (' '+ or ' '+ conjunction)*)> */
+		func() bool {
+			position3, tokenIndex3 := position, tokenIndex
+			{
+				position4 := position
+				if !_rules[ruleconjunction]() {
+					goto l3
+				}
+			l5:
+				{
+					position6, tokenIndex6 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l6
 					}
 					position++
-				l4:
+				l7:
 					{
-						position5, tokenIndex5 := position, tokenIndex
+						position8, tokenIndex8 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l5
+							goto l8
 						}
 						position++
-						goto l4
-					l5:
-						position, tokenIndex = position5, tokenIndex5
+						goto l7
+					l8:
+						position, tokenIndex = position8, tokenIndex8
 					}
 					{
-						position6 := position
+						position9 := position
 						{
-							position7, tokenIndex7 := position, tokenIndex
-							if buffer[position] != rune('a') {
-								goto l8
+							position10, tokenIndex10 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l11
 							}
 							position++
-							goto l7
-						l8:
-							position, tokenIndex = position7, tokenIndex7
-							if buffer[position] != rune('A') {
-								goto l3
+							goto l10
+						l11:
+							position, tokenIndex = position10, tokenIndex10
+							if buffer[position] != rune('O') {
+								goto l6
 							}
 							position++
 						}
-					l7:
+					l10:
 						{
-							position9, tokenIndex9 := position, tokenIndex
-							if buffer[position] != rune('n') {
-								goto l10
+							position12, tokenIndex12 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l13
 							}
 							position++
-							goto l9
-						l10:
-							position, tokenIndex = position9, tokenIndex9
-							if buffer[position] != rune('N') {
-								goto l3
-							}
-							position++
-						}
-					l9:
-						{
-							position11, tokenIndex11 := position, tokenIndex
-							if buffer[position] != rune('d') {
-								goto l12
-							}
-							position++
-							goto l11
-						l12:
-							position, tokenIndex = position11, tokenIndex11
-							if buffer[position] != rune('D') {
-								goto l3
+							goto l12
+						l13:
+							position, tokenIndex = position12, tokenIndex12
+							if buffer[position] != rune('R') {
+								goto l6
 							}
 							position++
 						}
-					l11:
-						add(ruleand, position6)
+					l12:
+						add(ruleor, position9)
 					}
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l6
 					}
 					position++
-				l13:
+				l14:
 					{
-						position14, tokenIndex14 := position, tokenIndex
+						position15, tokenIndex15 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l14
+							goto l15
 						}
 						position++
-						goto l13
-					l14:
-						position, tokenIndex = position14, tokenIndex14
+						goto l14
+					l15:
+						position, tokenIndex = position15, tokenIndex15
 					}
-					if !_rules[rulecondition]() {
-						goto l3
+					if !_rules[ruleconjunction]() {
+						goto l6
 					}
-					goto l2
-				l3:
-					position, tokenIndex = position3, tokenIndex3
+					goto l5
+				l6:
+					position, tokenIndex = position6, tokenIndex6
 				}
-				if buffer[position] != rune('"') {
-					goto l0
-				}
-				position++
-				{
-					position15, tokenIndex15 := position, tokenIndex
-					if !matchDot() {
-						goto l15
-					}
-					goto l0
-				l15:
-					position, tokenIndex = position15, tokenIndex15
-				}
-				add(rulee, position1)
+				add(ruledisjunction, position4)
 			}
 			return true
-		l0:
-			position, tokenIndex = position0, tokenIndex0
+		l3:
+			position, tokenIndex = position3, tokenIndex3
 			return false
 		},
-		/* 1 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		/* 2 conjunction <- <(term (' '+ and ' '+ term)*)> */
 		func() bool {
 			position16, tokenIndex16 := position, tokenIndex
 			{
 				position17 := position
+				if !_rules[ruleterm]() {
+					goto l16
+				}
+			l18:
 				{
-					position18 := position
+					position19, tokenIndex19 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l19
+					}
+					position++
+				l20:
 					{
-						position19 := position
+						position21, tokenIndex21 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l21
+						}
+						position++
+						goto l20
+					l21:
+						position, tokenIndex = position21, tokenIndex21
+					}
+					{
+						position22 := position
 						{
-							position22, tokenIndex22 := position, tokenIndex
-							{
-								switch buffer[position] {
-								case '<':
-									if buffer[position] != rune('<') {
-										goto l22
-									}
-									position++
-								case '>':
-									if buffer[position] != rune('>') {
-										goto l22
-									}
-									position++
-								case '=':
-									if buffer[position] != rune('=') {
-										goto l22
-									}
-									position++
-								case '\'':
-									if buffer[position] != rune('\'') {
-										goto l22
-									}
-									position++
-								case '"':
-									if buffer[position] != rune('"') {
-										goto l22
-									}
-									position++
-								case ')':
-									if buffer[position] != rune(')') {
-										goto l22
-									}
-									position++
-								case '(':
-									if buffer[position] != rune('(') {
-										goto l22
-									}
-									position++
-								case '\\':
-									if buffer[position] != rune('\\') {
-										goto l22
-									}
-									position++
-								case '\r':
-									if buffer[position] != rune('\r') {
-										goto l22
-									}
-									position++
-								case '\n':
-									if buffer[position] != rune('\n') {
-										goto l22
-									}
-									position++
-								case '\t':
-									if buffer[position] != rune('\t') {
-										goto l22
-									}
-									position++
-								default:
-									if buffer[position] != rune(' ') {
-										goto l22
-									}
-									position++
-								}
+							position23, tokenIndex23 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l24
 							}
-
-							goto l16
-						l22:
-							position, tokenIndex = position22, tokenIndex22
+							position++
+							goto l23
+						l24:
+							position, tokenIndex = position23, tokenIndex23
+							if buffer[position] != rune('A') {
+								goto l19
+							}
+							position++
 						}
-						if !matchDot() {
-							goto l16
+					l23:
+						{
+							position25, tokenIndex25 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l26
+							}
+							position++
+							goto l25
+						l26:
+							position, tokenIndex = position25, tokenIndex25
+							if buffer[position] != rune('N') {
+								goto l19
+							}
+							position++
 						}
-					l20:
+					l25:
 						{
-							position21, tokenIndex21 := position, tokenIndex
-							{
-								position24, tokenIndex24 := position, tokenIndex
-								{
-									switch buffer[position] {
-									case '<':
-										if buffer[position] != rune('<') {
-											goto l24
-										}
-										position++
-									case '>':
-										if buffer[position] != rune('>') {
-											goto l24
-										}
-										position++
-									case '=':
-										if buffer[position] != rune('=') {
-											goto l24
-										}
-										position++
-									case '\'':
-										if buffer[position] != rune('\'') {
-											goto l24
-										}
-										position++
-									case '"':
-										if buffer[position] != rune('"') {
-											goto l24
-										}
-										position++
-									case ')':
-										if buffer[position] != rune(')') {
-											goto l24
-										}
-										position++
-									case '(':
-										if buffer[position] != rune('(') {
-											goto l24
-										}
-										position++
-									case '\\':
-										if buffer[position] != rune('\\') {
-											goto l24
-										}
-										position++
-									case '\r':
-										if buffer[position] != rune('\r') {
-											goto l24
-										}
-										position++
-									case '\n':
-										if buffer[position] != rune('\n') {
-											goto l24
-										}
-										position++
-									case '\t':
-										if buffer[position] != rune('\t') {
-											goto l24
-										}
-										position++
-									default:
-										if buffer[position] != rune(' ') {
-											goto l24
-										}
-										position++
-									}
-								}
-
-								goto l21
-							l24:
-								position, tokenIndex = position24, tokenIndex24
+							position27, tokenIndex27 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l28
 							}
-							if !matchDot() {
-								goto l21
+							position++
+							goto l27
+						l28:
+							position, tokenIndex = position27, tokenIndex27
+							if buffer[position] != rune('D') {
+								goto l19
 							}
-							goto l20
-						l21:
-							position, tokenIndex = position21, tokenIndex21
+							position++
 						}
-						add(rulePegText, position19)
+					l27:
+						add(ruleand, position22)
 					}
-					add(ruletag, position18)
-				}
-			l26:
-				{
-					position27, tokenIndex27 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l27
+						goto l19
 					}
 					position++
-					goto l26
-				l27:
-					position, tokenIndex = position27, tokenIndex27
-				}
-				{
-					position28, tokenIndex28 := position, tokenIndex
+				l29:
 					{
-						position30 := position
-						if buffer[position] != rune('<') {
-							goto l29
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l29
+						position30, tokenIndex30 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l30
 						}
 						position++
-						add(rulele, position30)
+						goto l29
+					l30:
+						position, tokenIndex = position30, tokenIndex30
+					}
+					if !_rules[ruleterm]() {
+						goto l19
 					}
-				l31:
+					goto l18
+				l19:
+					position, tokenIndex = position19, tokenIndex19
+				}
+				add(ruleconjunction, position17)
+			}
+			return true
+		l16:
+			position, tokenIndex = position16, tokenIndex16
+			return false
+		},
+		/* 3 term <- <((not ' '* group) / (not ' '+ term) / group / condition)> */
+		func() bool {
+			position31, tokenIndex31 := position, tokenIndex
+			{
+				position32 := position
+				{
+					position33, tokenIndex33 := position, tokenIndex
+					if !_rules[rulenot]() {
+						goto l34
+					}
+				l35:
 					{
-						position32, tokenIndex32 := position, tokenIndex
+						position36, tokenIndex36 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l32
+							goto l36
 						}
 						position++
-						goto l31
-					l32:
-						position, tokenIndex = position32, tokenIndex32
+						goto l35
+					l36:
+						position, tokenIndex = position36, tokenIndex36
 					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l29
-							}
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l29
-							}
-						default:
-							if !_rules[rulenumber]() {
-								goto l29
-							}
-						}
+					if !_rules[rulegroup]() {
+						goto l34
 					}
-
-					goto l28
-				l29:
-					position, tokenIndex = position28, tokenIndex28
-					{
-						position35 := position
-						if buffer[position] != rune('>') {
-							goto l34
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l34
-						}
-						position++
-						add(rulege, position35)
+					goto l33
+				l34:
+					position, tokenIndex = position33, tokenIndex33
+					if !_rules[rulenot]() {
+						goto l37
 					}
-				l36:
+					if buffer[position] != rune(' ') {
+						goto l37
+					}
+					position++
+				l38:
 					{
-						position37, tokenIndex37 := position, tokenIndex
+						position39, tokenIndex39 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l37
+							goto l39
 						}
 						position++
-						goto l36
-					l37:
-						position, tokenIndex = position37, tokenIndex37
+						goto l38
+					l39:
+						position, tokenIndex = position39, tokenIndex39
 					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l34
-							}
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l34
-							}
-						default:
-							if !_rules[rulenumber]() {
-								goto l34
-							}
-						}
+					if !_rules[ruleterm]() {
+						goto l37
 					}
-
-					goto l28
-				l34:
-					position, tokenIndex = position28, tokenIndex28
+					goto l33
+				l37:
+					position, tokenIndex = position33, tokenIndex33
+					if !_rules[rulegroup]() {
+						goto l40
+					}
+					goto l33
+				l40:
+					position, tokenIndex = position33, tokenIndex33
 					{
-						switch buffer[position] {
-						case 'E', 'e':
+						position41 := position
+						{
+							position42 := position
 							{
-								position40 := position
-								{
-									position41, tokenIndex41 := position, tokenIndex
-									if buffer[position] != rune('e') {
-										goto l42
-									}
-									position++
-									goto l41
-								l42:
-									position, tokenIndex = position41, tokenIndex41
-									if buffer[position] != rune('E') {
-										goto l16
-									}
-									position++
-								}
-							l41:
-								{
-									position43, tokenIndex43 := position, tokenIndex
-									if buffer[position] != rune('x') {
-										goto l44
-									}
-									position++
-									goto l43
-								l44:
-									position, tokenIndex = position43, tokenIndex43
-									if buffer[position] != rune('X') {
-										goto l16
-									}
-									position++
-								}
-							l43:
+								position43 := position
 								{
-									position45, tokenIndex45 := position, tokenIndex
-									if buffer[position] != rune('i') {
-										goto l46
+									position46, tokenIndex46 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '<':
+											if buffer[position] != rune('<') {
+												goto l46
+											}
+											position++
+										case '>':
+											if buffer[position] != rune('>') {
+												goto l46
+											}
+											position++
+										case '=':
+											if buffer[position] != rune('=') {
+												goto l46
+											}
+											position++
+										case '\'':
+											if buffer[position] != rune('\'') {
+												goto l46
+											}
+											position++
+										case '"':
+											if buffer[position] != rune('"') {
+												goto l46
+											}
+											position++
+										case ')':
+											if buffer[position] != rune(')') {
+												goto l46
+											}
+											position++
+										case '(':
+											if buffer[position] != rune('(') {
+												goto l46
+											}
+											position++
+										case '\\':
+											if buffer[position] != rune('\\') {
+												goto l46
+											}
+											position++
+										case '\r':
+											if buffer[position] != rune('\r') {
+												goto l46
+											}
+											position++
+										case '\n':
+											if buffer[position] != rune('\n') {
+												goto l46
+											}
+											position++
+										case '\t':
+											if buffer[position] != rune('\t') {
+												goto l46
+											}
+											position++
+										default:
+											if buffer[position] != rune(' ') {
+												goto l46
+											}
+											position++
+										}
 									}
-									position++
-									goto l45
+
+									goto l31
 								l46:
-									position, tokenIndex = position45, tokenIndex45
-									if buffer[position] != rune('I') {
-										goto l16
-									}
-									position++
+									position, tokenIndex = position46, tokenIndex46
 								}
-							l45:
-								{
-									position47, tokenIndex47 := position, tokenIndex
-									if buffer[position] != rune('s') {
-										goto l48
-									}
-									position++
-									goto l47
-								l48:
-									position, tokenIndex = position47, tokenIndex47
-									if buffer[position] != rune('S') {
-										goto l16
-									}
-									position++
+								if !matchDot() {
+									goto l31
 								}
-							l47:
+							l44:
 								{
-									position49, tokenIndex49 := position, tokenIndex
-									if buffer[position] != rune('t') {
-										goto l50
-									}
-									position++
-									goto l49
-								l50:
-									position, tokenIndex = position49, tokenIndex49
-									if buffer[position] != rune('T') {
-										goto l16
-									}
-									position++
-								}
-							l49:
-								{
-									position51, tokenIndex51 := position, tokenIndex
-									if buffer[position] != rune('s') {
-										goto l52
+									position45, tokenIndex45 := position, tokenIndex
+									{
+										position48, tokenIndex48 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '<':
+												if buffer[position] != rune('<') {
+													goto l48
+												}
+												position++
+											case '>':
+												if buffer[position] != rune('>') {
+													goto l48
+												}
+												position++
+											case '=':
+												if buffer[position] != rune('=') {
+													goto l48
+												}
+												position++
+											case '\'':
+												if buffer[position] != rune('\'') {
+													goto l48
+												}
+												position++
+											case '"':
+												if buffer[position] != rune('"') {
+													goto l48
+												}
+												position++
+											case ')':
+												if buffer[position] != rune(')') {
+													goto l48
+												}
+												position++
+											case '(':
+												if buffer[position] != rune('(') {
+													goto l48
+												}
+												position++
+											case '\\':
+												if buffer[position] != rune('\\') {
+													goto l48
+												}
+												position++
+											case '\r':
+												if buffer[position] != rune('\r') {
+													goto l48
+												}
+												position++
+											case '\n':
+												if buffer[position] != rune('\n') {
+													goto l48
+												}
+												position++
+											case '\t':
+												if buffer[position] != rune('\t') {
+													goto l48
+												}
+												position++
+											default:
+												if buffer[position] != rune(' ') {
+													goto l48
+												}
+												position++
+											}
+										}
+
+										goto l45
+									l48:
+										position, tokenIndex = position48, tokenIndex48
 									}
-									position++
-									goto l51
-								l52:
-									position, tokenIndex = position51, tokenIndex51
-									if buffer[position] != rune('S') {
-										goto l16
+									if !matchDot() {
+										goto l45
 									}
-									position++
+									goto l44
+								l45:
+									position, tokenIndex = position45, tokenIndex45
 								}
-							l51:
-								add(ruleexists, position40)
+								add(rulePegText, position43)
+							}
+							add(ruletag, position42)
+						}
+					l50:
+						{
+							position51, tokenIndex51 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l51
 							}
-						case '=':
+							position++
+							goto l50
+						l51:
+							position, tokenIndex = position51, tokenIndex51
+						}
+						{
+							position52, tokenIndex52 := position, tokenIndex
 							{
-								position53 := position
+								position54 := position
+								if buffer[position] != rune('<') {
+									goto l53
+								}
+								position++
 								if buffer[position] != rune('=') {
-									goto l16
+									goto l53
 								}
 								position++
-								add(ruleequal, position53)
+								add(rulele, position54)
 							}
-						l54:
+						l55:
 							{
-								position55, tokenIndex55 := position, tokenIndex
+								position56, tokenIndex56 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l55
+									goto l56
 								}
 								position++
-								goto l54
-							l55:
-								position, tokenIndex = position55, tokenIndex55
+								goto l55
+							l56:
+								position, tokenIndex = position56, tokenIndex56
 							}
 							{
 								switch buffer[position] {
-								case '\'':
-									if !_rules[rulevalue]() {
-										goto l16
-									}
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l53
 									}
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l53
 									}
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l53
 									}
 								}
 							}
 
-						case '>':
+							goto l52
+						l53:
+							position, tokenIndex = position52, tokenIndex52
 							{
-								position57 := position
+								position59 := position
 								if buffer[position] != rune('>') {
-									goto l16
-								}
-								position++
-								add(ruleg, position57)
-							}
-						l58:
-							{
-								position59, tokenIndex59 := position, tokenIndex
-								if buffer[position] != rune(' ') {
-									goto l59
+									goto l58
 								}
 								position++
-								goto l58
-							l59:
-								position, tokenIndex = position59, tokenIndex59
-							}
-							{
-								switch buffer[position] {
-								case 'D', 'd':
-									if !_rules[ruledate]() {
-										goto l16
-									}
-								case 'T', 't':
-									if !_rules[ruletime]() {
-										goto l16
-									}
-								default:
-									if !_rules[rulenumber]() {
-										goto l16
-									}
-								}
-							}
-
-						case '<':
-							{
-								position61 := position
-								if buffer[position] != rune('<') {
-									goto l16
+								if buffer[position] != rune('=') {
+									goto l58
 								}
 								position++
-								add(rulel, position61)
+								add(rulege, position59)
 							}
-						l62:
+						l60:
 							{
-								position63, tokenIndex63 := position, tokenIndex
+								position61, tokenIndex61 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l63
+									goto l61
 								}
 								position++
-								goto l62
-							l63:
-								position, tokenIndex = position63, tokenIndex63
+								goto l60
+							l61:
+								position, tokenIndex = position61, tokenIndex61
 							}
 							{
 								switch buffer[position] {
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l58
 									}
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l58
 									}
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l58
 									}
 								}
 							}
 
-						default:
+							goto l52
+						l58:
+							position, tokenIndex = position52, tokenIndex52
 							{
-								position65 := position
-								{
-									position66, tokenIndex66 := position, tokenIndex
-									if buffer[position] != rune('c') {
-										goto l67
-									}
-									position++
-									goto l66
-								l67:
-									position, tokenIndex = position66, tokenIndex66
-									if buffer[position] != rune('C') {
-										goto l16
+								switch buffer[position] {
+								case 'I', 'i':
+									{
+										position64 := position
+										{
+											position65, tokenIndex65 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l66
+											}
+											position++
+											goto l65
+										l66:
+											position, tokenIndex = position65, tokenIndex65
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l65:
+										{
+											position67, tokenIndex67 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l68
+											}
+											position++
+											goto l67
+										l68:
+											position, tokenIndex = position67, tokenIndex67
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l67:
+										add(rulein, position64)
 									}
-									position++
-								}
-							l66:
-								{
-									position68, tokenIndex68 := position, tokenIndex
-									if buffer[position] != rune('o') {
+								l69:
+									{
+										position70, tokenIndex70 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l70
+										}
+										position++
 										goto l69
+									l70:
+										position, tokenIndex = position70, tokenIndex70
 									}
-									position++
-									goto l68
-								l69:
-									position, tokenIndex = position68, tokenIndex68
-									if buffer[position] != rune('O') {
-										goto l16
+									if buffer[position] != rune('(') {
+										goto l31
 									}
 									position++
-								}
-							l68:
-								{
-									position70, tokenIndex70 := position, tokenIndex
-									if buffer[position] != rune('n') {
+								l71:
+									{
+										position72, tokenIndex72 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l72
+										}
+										position++
 										goto l71
+									l72:
+										position, tokenIndex = position72, tokenIndex72
 									}
-									position++
-									goto l70
-								l71:
-									position, tokenIndex = position70, tokenIndex70
-									if buffer[position] != rune('N') {
-										goto l16
+									if !_rules[ruleoperand]() {
+										goto l31
 									}
-									position++
-								}
-							l70:
-								{
-									position72, tokenIndex72 := position, tokenIndex
-									if buffer[position] != rune('t') {
+								l73:
+									{
+										position74, tokenIndex74 := position, tokenIndex
+									l75:
+										{
+											position76, tokenIndex76 := position, tokenIndex
+											if buffer[position] != rune(' ') {
+												goto l76
+											}
+											position++
+											goto l75
+										l76:
+											position, tokenIndex = position76, tokenIndex76
+										}
+										if buffer[position] != rune(',') {
+											goto l74
+										}
+										position++
+									l77:
+										{
+											position78, tokenIndex78 := position, tokenIndex
+											if buffer[position] != rune(' ') {
+												goto l78
+											}
+											position++
+											goto l77
+										l78:
+											position, tokenIndex = position78, tokenIndex78
+										}
+										if !_rules[ruleoperand]() {
+											goto l74
+										}
 										goto l73
+									l74:
+										position, tokenIndex = position74, tokenIndex74
 									}
-									position++
-									goto l72
-								l73:
-									position, tokenIndex = position72, tokenIndex72
-									if buffer[position] != rune('T') {
-										goto l16
+								l79:
+									{
+										position80, tokenIndex80 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l80
+										}
+										position++
+										goto l79
+									l80:
+										position, tokenIndex = position80, tokenIndex80
 									}
-									position++
-								}
-							l72:
-								{
-									position74, tokenIndex74 := position, tokenIndex
-									if buffer[position] != rune('a') {
-										goto l75
+									if buffer[position] != rune(')') {
+										goto l31
 									}
 									position++
-									goto l74
-								l75:
-									position, tokenIndex = position74, tokenIndex74
-									if buffer[position] != rune('A') {
-										goto l16
+								case 'E', 'e':
+									{
+										position81 := position
+										{
+											position82, tokenIndex82 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l83
+											}
+											position++
+											goto l82
+										l83:
+											position, tokenIndex = position82, tokenIndex82
+											if buffer[position] != rune('E') {
+												goto l31
+											}
+											position++
+										}
+									l82:
+										{
+											position84, tokenIndex84 := position, tokenIndex
+											if buffer[position] != rune('x') {
+												goto l85
+											}
+											position++
+											goto l84
+										l85:
+											position, tokenIndex = position84, tokenIndex84
+											if buffer[position] != rune('X') {
+												goto l31
+											}
+											position++
+										}
+									l84:
+										{
+											position86, tokenIndex86 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l87
+											}
+											position++
+											goto l86
+										l87:
+											position, tokenIndex = position86, tokenIndex86
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l86:
+										{
+											position88, tokenIndex88 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l89
+											}
+											position++
+											goto l88
+										l89:
+											position, tokenIndex = position88, tokenIndex88
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l88:
+										{
+											position90, tokenIndex90 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l91
+											}
+											position++
+											goto l90
+										l91:
+											position, tokenIndex = position90, tokenIndex90
+											if buffer[position] != rune('T') {
+												goto l31
+											}
+											position++
+										}
+									l90:
+										{
+											position92, tokenIndex92 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l93
+											}
+											position++
+											goto l92
+										l93:
+											position, tokenIndex = position92, tokenIndex92
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l92:
+										add(ruleexists, position81)
 									}
-									position++
-								}
-							l74:
-								{
-									position76, tokenIndex76 := position, tokenIndex
-									if buffer[position] != rune('i') {
-										goto l77
+								case '=':
+									{
+										position94 := position
+										if buffer[position] != rune('=') {
+											goto l31
+										}
+										position++
+										add(ruleequal, position94)
 									}
-									position++
-									goto l76
-								l77:
-									position, tokenIndex = position76, tokenIndex76
-									if buffer[position] != rune('I') {
-										goto l16
+								l95:
+									{
+										position96, tokenIndex96 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l96
+										}
+										position++
+										goto l95
+									l96:
+										position, tokenIndex = position96, tokenIndex96
 									}
-									position++
-								}
-							l76:
-								{
-									position78, tokenIndex78 := position, tokenIndex
-									if buffer[position] != rune('n') {
-										goto l79
+									{
+										switch buffer[position] {
+										case '\'':
+											if !_rules[rulevalue]() {
+												goto l31
+											}
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-									goto l78
-								l79:
-									position, tokenIndex = position78, tokenIndex78
-									if buffer[position] != rune('N') {
-										goto l16
+
+								case '>':
+									{
+										position98 := position
+										if buffer[position] != rune('>') {
+											goto l31
+										}
+										position++
+										add(ruleg, position98)
 									}
-									position++
-								}
-							l78:
-								{
-									position80, tokenIndex80 := position, tokenIndex
-									if buffer[position] != rune('s') {
-										goto l81
+								l99:
+									{
+										position100, tokenIndex100 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l100
+										}
+										position++
+										goto l99
+									l100:
+										position, tokenIndex = position100, tokenIndex100
 									}
-									position++
-									goto l80
-								l81:
-									position, tokenIndex = position80, tokenIndex80
-									if buffer[position] != rune('S') {
-										goto l16
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
+									}
+
+								case '<':
+									{
+										position102 := position
+										if buffer[position] != rune('<') {
+											goto l31
+										}
+										position++
+										add(rulel, position102)
+									}
+								l103:
+									{
+										position104, tokenIndex104 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l104
+										}
+										position++
+										goto l103
+									l104:
+										position, tokenIndex = position104, tokenIndex104
+									}
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
+									}
+
+								default:
+									{
+										position106 := position
+										{
+											position107, tokenIndex107 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l108
+											}
+											position++
+											goto l107
+										l108:
+											position, tokenIndex = position107, tokenIndex107
+											if buffer[position] != rune('C') {
+												goto l31
+											}
+											position++
+										}
+									l107:
+										{
+											position109, tokenIndex109 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l110
+											}
+											position++
+											goto l109
+										l110:
+											position, tokenIndex = position109, tokenIndex109
+											if buffer[position] != rune('O') {
+												goto l31
+											}
+											position++
+										}
+									l109:
+										{
+											position111, tokenIndex111 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l112
+											}
+											position++
+											goto l111
+										l112:
+											position, tokenIndex = position111, tokenIndex111
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l111:
+										{
+											position113, tokenIndex113 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l114
+											}
+											position++
+											goto l113
+										l114:
+											position, tokenIndex = position113, tokenIndex113
+											if buffer[position] != rune('T') {
+												goto l31
+											}
+											position++
+										}
+									l113:
+										{
+											position115, tokenIndex115 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l116
+											}
+											position++
+											goto l115
+										l116:
+											position, tokenIndex = position115, tokenIndex115
+											if buffer[position] != rune('A') {
+												goto l31
+											}
+											position++
+										}
+									l115:
+										{
+											position117, tokenIndex117 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l118
+											}
+											position++
+											goto l117
+										l118:
+											position, tokenIndex = position117, tokenIndex117
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l117:
+										{
+											position119, tokenIndex119 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l120
+											}
+											position++
+											goto l119
+										l120:
+											position, tokenIndex = position119, tokenIndex119
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l119:
+										{
+											position121, tokenIndex121 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l122
+											}
+											position++
+											goto l121
+										l122:
+											position, tokenIndex = position121, tokenIndex121
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l121:
+										add(rulecontains, position106)
+									}
+								l123:
+									{
+										position124, tokenIndex124 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l124
+										}
+										position++
+										goto l123
+									l124:
+										position, tokenIndex = position124, tokenIndex124
+									}
+									if !_rules[rulevalue]() {
+										goto l31
 									}
-									position++
-								}
-							l80:
-								add(rulecontains, position65)
-							}
-						l82:
-							{
-								position83, tokenIndex83 := position, tokenIndex
-								if buffer[position] != rune(' ') {
-									goto l83
 								}
-								position++
-								goto l82
-							l83:
-								position, tokenIndex = position83, tokenIndex83
-							}
-							if !_rules[rulevalue]() {
-								goto l16
 							}
+
 						}
+					l52:
+						add(rulecondition, position41)
 					}
-
 				}
-			l28:
-				add(rulecondition, position17)
+			l33:
+				add(ruleterm, position32)
 			}
 			return true
-		l16:
-			position, tokenIndex = position16, tokenIndex16
+		l31:
+			position, tokenIndex = position31, tokenIndex31
 			return false
 		},
-		/* 2 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
+		/* 4 group <- <('(' ' '* disjunction ' '* ')')> */
+		func() bool {
+			position125, tokenIndex125 := position, tokenIndex
+			{
+				position126 := position
+				if buffer[position] != rune('(') {
+					goto l125
+				}
+				position++
+			l127:
+				{
+					position128, tokenIndex128 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l128
+					}
+					position++
+					goto l127
+				l128:
+					position, tokenIndex = position128, tokenIndex128
+				}
+				if !_rules[ruledisjunction]() {
+					goto l125
+				}
+			l129:
+				{
+					position130, tokenIndex130 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l130
+					}
+					position++
+					goto l129
+				l130:
+					position, tokenIndex = position130, tokenIndex130
+				}
+				if buffer[position] != rune(')') {
+					goto l125
+				}
+				position++
+				add(rulegroup, position126)
+			}
+			return true
+		l125:
+			position, tokenIndex = position125, tokenIndex125
+			return false
+		},
+		/* 5 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('I' | 'i') (in ' '* '(' ' '* operand (' '* ',' ' '* operand)* ' '* ')')) | (&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		nil,
+		/* 6 operand <- <((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))> */
+		func() bool {
+			position132, tokenIndex132 := position, tokenIndex
+			{
+				position133 := position
+				{
+					switch buffer[position] {
+					case '\'':
+						if !_rules[rulevalue]() {
+							goto l132
+						}
+					case 'D', 'd':
+						if !_rules[ruledate]() {
+							goto l132
+						}
+					case 'T', 't':
+						if !_rules[ruletime]() {
+							goto l132
+						}
+					default:
+						if !_rules[rulenumber]() {
+							goto l132
+						}
+					}
+				}
+
+				add(ruleoperand, position133)
+			}
+			return true
+		l132:
+			position, tokenIndex = position132, tokenIndex132
+			return false
+		},
+		/* 7 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
 		nil,
-		/* 3 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
+		/* 8 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
 		func() bool {
-			position85, tokenIndex85 := position, tokenIndex
+			position136, tokenIndex136 := position, tokenIndex
 			{
-				position86 := position
+				position137 := position
 				{
-					position87 := position
+					position138 := position
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l136
 					}
 					position++
-				l88:
+				l139:
 					{
-						position89, tokenIndex89 := position, tokenIndex
+						position140, tokenIndex140 := position, tokenIndex
 						{
-							position90, tokenIndex90 := position, tokenIndex
+							position141, tokenIndex141 := position, tokenIndex
 							{
-								position91, tokenIndex91 := position, tokenIndex
+								position142, tokenIndex142 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l92
+									goto l143
 								}
 								position++
-								goto l91
-							l92:
-								position, tokenIndex = position91, tokenIndex91
+								goto l142
+							l143:
+								position, tokenIndex = position142, tokenIndex142
 								if buffer[position] != rune('\'') {
-									goto l90
+									goto l141
 								}
 								position++
 							}
-						l91:
-							goto l89
-						l90:
-							position, tokenIndex = position90, tokenIndex90
+						l142:
+							goto l140
+						l141:
+							position, tokenIndex = position141, tokenIndex141
 						}
 						if !matchDot() {
-							goto l89
+							goto l140
 						}
-						goto l88
-					l89:
-						position, tokenIndex = position89, tokenIndex89
+						goto l139
+					l140:
+						position, tokenIndex = position140, tokenIndex140
 					}
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l136
 					}
 					position++
-					add(rulePegText, position87)
+					add(rulePegText, position138)
 				}
-				add(rulevalue, position86)
+				add(rulevalue, position137)
 			}
 			return true
-		l85:
-			position, tokenIndex = position85, tokenIndex85
+		l136:
+			position, tokenIndex = position136, tokenIndex136
 			return false
 		},
-		/* 4 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
+		/* 9 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
 		func() bool {
-			position93, tokenIndex93 := position, tokenIndex
+			position144, tokenIndex144 := position, tokenIndex
 			{
-				position94 := position
+				position145 := position
 				{
-					position95 := position
+					position146 := position
 					{
-						position96, tokenIndex96 := position, tokenIndex
+						position147, tokenIndex147 := position, tokenIndex
 						if buffer[position] != rune('0') {
-							goto l97
+							goto l148
 						}
 						position++
-						goto l96
-					l97:
-						position, tokenIndex = position96, tokenIndex96
+						goto l147
+					l148:
+						position, tokenIndex = position147, tokenIndex147
 						if c := buffer[position]; c < rune('1') || c > rune('9') {
-							goto l93
+							goto l144
 						}
 						position++
-					l98:
+					l149:
 						{
-							position99, tokenIndex99 := position, tokenIndex
+							position150, tokenIndex150 := position, tokenIndex
 							if !_rules[ruledigit]() {
-								goto l99
+								goto l150
 							}
-							goto l98
-						l99:
-							position, tokenIndex = position99, tokenIndex99
+							goto l149
+						l150:
+							position, tokenIndex = position150, tokenIndex150
 						}
 						{
-							position100, tokenIndex100 := position, tokenIndex
+							position151, tokenIndex151 := position, tokenIndex
 							if buffer[position] != rune('.') {
-								goto l100
+								goto l151
 							}
 							position++
-						l102:
+						l153:
 							{
-								position103, tokenIndex103 := position, tokenIndex
+								position154, tokenIndex154 := position, tokenIndex
 								if !_rules[ruledigit]() {
-									goto l103
+									goto l154
 								}
-								goto l102
-							l103:
-								position, tokenIndex = position103, tokenIndex103
+								goto l153
+							l154:
+								position, tokenIndex = position154, tokenIndex154
 							}
-							goto l101
-						l100:
-							position, tokenIndex = position100, tokenIndex100
+							goto l152
+						l151:
+							position, tokenIndex = position151, tokenIndex151
 						}
-					l101:
+					l152:
 					}
-				l96:
-					add(rulePegText, position95)
+				l147:
+					add(rulePegText, position146)
 				}
-				add(rulenumber, position94)
+				add(rulenumber, position145)
 			}
 			return true
-		l93:
-			position, tokenIndex = position93, tokenIndex93
+		l144:
+			position, tokenIndex = position144, tokenIndex144
 			return false
 		},
-		/* 5 digit <- <[0-9]> */
+		/* 10 digit <- <[0-9]> */
 		func() bool {
-			position104, tokenIndex104 := position, tokenIndex
+			position155, tokenIndex155 := position, tokenIndex
 			{
-				position105 := position
+				position156 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l104
+					goto l155
 				}
 				position++
-				add(ruledigit, position105)
+				add(ruledigit, position156)
 			}
 			return true
-		l104:
-			position, tokenIndex = position104, tokenIndex104
+		l155:
+			position, tokenIndex = position155, tokenIndex155
 			return false
 		},
-		/* 6 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
+		/* 11 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
 		func() bool {
-			position106, tokenIndex106 := position, tokenIndex
+			position157, tokenIndex157 := position, tokenIndex
 			{
-				position107 := position
+				position158 := position
 				{
-					position108, tokenIndex108 := position, tokenIndex
+					position159, tokenIndex159 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l109
+						goto l160
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex = position108, tokenIndex108
+					goto l159
+				l160:
+					position, tokenIndex = position159, tokenIndex159
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l157
 					}
 					position++
 				}
-			l108:
+			l159:
 				{
-					position110, tokenIndex110 := position, tokenIndex
+					position161, tokenIndex161 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l111
+						goto l162
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex = position110, tokenIndex110
+					goto l161
+				l162:
+					position, tokenIndex = position161, tokenIndex161
 					if buffer[position] != rune('I') {
-						goto l106
+						goto l157
 					}
 					position++
 				}
-			l110:
+			l161:
 				{
-					position112, tokenIndex112 := position, tokenIndex
+					position163, tokenIndex163 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l113
+						goto l164
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex = position112, tokenIndex112
+					goto l163
+				l164:
+					position, tokenIndex = position163, tokenIndex163
 					if buffer[position] != rune('M') {
-						goto l106
+						goto l157
 					}
 					position++
 				}
-			l112:
+			l163:
 				{
-					position114, tokenIndex114 := position, tokenIndex
+					position165, tokenIndex165 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l115
+						goto l166
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex = position114, tokenIndex114
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
 					if buffer[position] != rune('E') {
-						goto l106
+						goto l157
 					}
 					position++
 				}
-			l114:
+			l165:
 				if buffer[position] != rune(' ') {
-					goto l106
+					goto l157
 				}
 				position++
 				{
-					position116 := position
+					position167 := position
 					if !_rules[ruleyear]() {
-						goto l106
+						goto l157
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l157
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l106
+						goto l157
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l157
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l106
+						goto l157
 					}
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l157
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l157
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l157
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l157
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l157
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l157
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l157
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l157
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l157
 					}
 					{
-						position117, tokenIndex117 := position, tokenIndex
+						position168, tokenIndex168 := position, tokenIndex
 						{
-							position119, tokenIndex119 := position, tokenIndex
+							position170, tokenIndex170 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l120
+								goto l171
 							}
 							position++
-							goto l119
-						l120:
-							position, tokenIndex = position119, tokenIndex119
+							goto l170
+						l171:
+							position, tokenIndex = position170, tokenIndex170
 							if buffer[position] != rune('+') {
-								goto l118
+								goto l169
 							}
 							position++
 						}
-					l119:
+					l170:
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l169
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l169
 						}
 						if buffer[position] != rune(':') {
-							goto l118
+							goto l169
 						}
 						position++
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l169
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l169
 						}
-						goto l117
-					l118:
-						position, tokenIndex = position117, tokenIndex117
+						goto l168
+					l169:
+						position, tokenIndex = position168, tokenIndex168
 						if buffer[position] != rune('Z') {
-							goto l106
+							goto l157
 						}
 						position++
 					}
-				l117:
-					add(rulePegText, position116)
+				l168:
+					add(rulePegText, position167)
 				}
-				add(ruletime, position107)
+				add(ruletime, position158)
 			}
 			return true
-		l106:
-			position, tokenIndex = position106, tokenIndex106
+		l157:
+			position, tokenIndex = position157, tokenIndex157
 			return false
 		},
-		/* 7 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
+		/* 12 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
 		func() bool {
-			position121, tokenIndex121 := position, tokenIndex
+			position172, tokenIndex172 := position, tokenIndex
 			{
-				position122 := position
+				position173 := position
 				{
-					position123, tokenIndex123 := position, tokenIndex
+					position174, tokenIndex174 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l124
+						goto l175
 					}
 					position++
-					goto l123
-				l124:
-					position, tokenIndex = position123, tokenIndex123
+					goto l174
+				l175:
+					position, tokenIndex = position174, tokenIndex174
 					if buffer[position] != rune('D') {
-						goto l121
+						goto l172
 					}
 					position++
 				}
-			l123:
+			l174:
 				{
-					position125, tokenIndex125 := position, tokenIndex
+					position176, tokenIndex176 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l126
+						goto l177
 					}
 					position++
-					goto l125
-				l126:
-					position, tokenIndex = position125, tokenIndex125
+					goto l176
+				l177:
+					position, tokenIndex = position176, tokenIndex176
 					if buffer[position] != rune('A') {
-						goto l121
+						goto l172
 					}
 					position++
 				}
-			l125:
+			l176:
 				{
-					position127, tokenIndex127 := position, tokenIndex
+					position178, tokenIndex178 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l128
+						goto l179
 					}
 					position++
-					goto l127
-				l128:
-					position, tokenIndex = position127, tokenIndex127
+					goto l178
+				l179:
+					position, tokenIndex = position178, tokenIndex178
 					if buffer[position] != rune('T') {
-						goto l121
+						goto l172
 					}
 					position++
 				}
-			l127:
+			l178:
 				{
-					position129, tokenIndex129 := position, tokenIndex
+					position180, tokenIndex180 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l130
+						goto l181
 					}
 					position++
-					goto l129
-				l130:
-					position, tokenIndex = position129, tokenIndex129
+					goto l180
+				l181:
+					position, tokenIndex = position180, tokenIndex180
 					if buffer[position] != rune('E') {
-						goto l121
+						goto l172
 					}
 					position++
 				}
-			l129:
+			l180:
 				if buffer[position] != rune(' ') {
-					goto l121
+					goto l172
 				}
 				position++
 				{
-					position131 := position
+					position182 := position
 					if !_rules[ruleyear]() {
-						goto l121
+						goto l172
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l172
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l121
+						goto l172
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l172
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l121
+						goto l172
 					}
-					add(rulePegText, position131)
+					add(rulePegText, position182)
 				}
-				add(ruledate, position122)
+				add(ruledate, position173)
 			}
 			return true
-		l121:
-			position, tokenIndex = position121, tokenIndex121
+		l172:
+			position, tokenIndex = position172, tokenIndex172
 			return false
 		},
-		/* 8 year <- <(('1' / '2') digit digit digit)> */
+		/* 13 year <- <(('1' / '2') digit digit digit)> */
 		func() bool {
-			position132, tokenIndex132 := position, tokenIndex
+			position183, tokenIndex183 := position, tokenIndex
 			{
-				position133 := position
+				position184 := position
 				{
-					position134, tokenIndex134 := position, tokenIndex
+					position185, tokenIndex185 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l135
+						goto l186
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex = position134, tokenIndex134
+					goto l185
+				l186:
+					position, tokenIndex = position185, tokenIndex185
 					if buffer[position] != rune('2') {
-						goto l132
+						goto l183
 					}
 					position++
 				}
-			l134:
+			l185:
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l183
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l183
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l183
 				}
-				add(ruleyear, position133)
+				add(ruleyear, position184)
 			}
 			return true
-		l132:
-			position, tokenIndex = position132, tokenIndex132
+		l183:
+			position, tokenIndex = position183, tokenIndex183
 			return false
 		},
-		/* 9 month <- <(('0' / '1') digit)> */
+		/* 14 month <- <(('0' / '1') digit)> */
 		func() bool {
-			position136, tokenIndex136 := position, tokenIndex
+			position187, tokenIndex187 := position, tokenIndex
 			{
-				position137 := position
+				position188 := position
 				{
-					position138, tokenIndex138 := position, tokenIndex
+					position189, tokenIndex189 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l139
+						goto l190
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex = position138, tokenIndex138
+					goto l189
+				l190:
+					position, tokenIndex = position189, tokenIndex189
 					if buffer[position] != rune('1') {
-						goto l136
+						goto l187
 					}
 					position++
 				}
-			l138:
+			l189:
 				if !_rules[ruledigit]() {
-					goto l136
+					goto l187
 				}
-				add(rulemonth, position137)
+				add(rulemonth, position188)
 			}
 			return true
-		l136:
-			position, tokenIndex = position136, tokenIndex136
+		l187:
+			position, tokenIndex = position187, tokenIndex187
 			return false
 		},
-		/* 10 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
+		/* 15 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
 		func() bool {
-			position140, tokenIndex140 := position, tokenIndex
+			position191, tokenIndex191 := position, tokenIndex
 			{
-				position141 := position
+				position192 := position
 				{
 					switch buffer[position] {
 					case '3':
 						if buffer[position] != rune('3') {
-							goto l140
+							goto l191
 						}
 						position++
 					case '2':
 						if buffer[position] != rune('2') {
-							goto l140
+							goto l191
 						}
 						position++
 					case '1':
 						if buffer[position] != rune('1') {
-							goto l140
+							goto l191
 						}
 						position++
 					default:
 						if buffer[position] != rune('0') {
-							goto l140
+							goto l191
 						}
 						position++
 					}
 				}
 
 				if !_rules[ruledigit]() {
-					goto l140
+					goto l191
+				}
+				add(ruleday, position192)
+			}
+			return true
+		l191:
+			position, tokenIndex = position191, tokenIndex191
+			return false
+		},
+		/* 16 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		nil,
+		/* 17 or <- <(('o' / 'O') ('r' / 'R'))> */
+		nil,
+		/* 18 not <- <(('n' / 'N') ('o' / 'O') ('t' / 'T'))> */
+		func() bool {
+			position196, tokenIndex196 := position, tokenIndex
+			{
+				position197 := position
+				{
+					position198, tokenIndex198 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l199
+					}
+					position++
+					goto l198
+				l199:
+					position, tokenIndex = position198, tokenIndex198
+					if buffer[position] != rune('N') {
+						goto l196
+					}
+					position++
+				}
+			l198:
+				{
+					position200, tokenIndex200 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l201
+					}
+					position++
+					goto l200
+				l201:
+					position, tokenIndex = position200, tokenIndex200
+					if buffer[position] != rune('O') {
+						goto l196
+					}
+					position++
+				}
+			l200:
+				{
+					position202, tokenIndex202 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l203
+					}
+					position++
+					goto l202
+				l203:
+					position, tokenIndex = position202, tokenIndex202
+					if buffer[position] != rune('T') {
+						goto l196
+					}
+					position++
 				}
-				add(ruleday, position141)
+			l202:
+				add(rulenot, position197)
 			}
 			return true
-		l140:
-			position, tokenIndex = position140, tokenIndex140
+		l196:
+			position, tokenIndex = position196, tokenIndex196
 			return false
 		},
-		/* 11 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		/* 19 equal <- <'='> */
 		nil,
-		/* 12 equal <- <'='> */
+		/* 20 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
 		nil,
-		/* 13 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
+		/* 21 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
 		nil,
-		/* 14 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
+		/* 22 in <- <(('i' / 'I') ('n' / 'N'))> */
 		nil,
-		/* 15 le <- <('<' '=')> */
+		/* 23 le <- <('<' '=')> */
 		nil,
-		/* 16 ge <- <('>' '=')> */
+		/* 24 ge <- <('>' '=')> */
 		nil,
-		/* 17 l <- <'<'> */
+		/* 25 l <- <'<'> */
 		nil,
-		/* 18 g <- <'>'> */
+		/* 26 g <- <'>'> */
 		nil,
 		nil,
 	}
@@ -150,6 +150,48 @@ func TestMatches(t *testing.T) {
 			false,
 			false,
 		},
+		{
+			"transfer.recipient = 'A' OR transfer.recipient = 'B'",
+			map[string][]string{"transfer.recipient": {"B"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"transfer.recipient = 'A' OR transfer.recipient = 'B'",
+			map[string][]string{"transfer.recipient": {"C"}},
+			false,
+			false,
+			false,
+		},
+		{"transfer.recipient IN ('A', 'B')", map[string][]string{"transfer.recipient": {"A"}}, false, true, false},
+		{"transfer.recipient IN ('A', 'B')", map[string][]string{"transfer.recipient": {"C"}}, false, false, false},
+		{"tx.gas IN (7, 8)", map[string][]string{"tx.gas": {"8"}}, false, true, false},
+		{"NOT transfer.recipient = 'A'", map[string][]string{"transfer.recipient": {"B"}}, false, true, false},
+		{"NOT transfer.recipient = 'A'", map[string][]string{"transfer.recipient": {"A"}}, false, false, false},
+		{"NOT slash EXISTS", map[string][]string{"transfer.recipient": {"A"}}, false, true, false},
+		{
+			// AND binds tighter than OR
+			"transfer.recipient = 'A' OR transfer.recipient = 'B' AND tx.gas > 10",
+			map[string][]string{"transfer.recipient": {"A"}, "tx.gas": {"8"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"(transfer.recipient = 'A' OR transfer.recipient = 'B') AND tx.gas > 10",
+			map[string][]string{"transfer.recipient": {"A"}, "tx.gas": {"8"}},
+			false,
+			false,
+			false,
+		},
+		{
+			"NOT (transfer.recipient = 'A' OR tx.gas > 10) AND transfer.recipient EXISTS",
+			map[string][]string{"transfer.recipient": {"B"}, "tx.gas": {"8"}},
+			false,
+			true,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -220,3 +262,75 @@ func TestConditions(t *testing.T) {
 		assert.Equal(t, tc.conditions, c)
 	}
 }
+
+func TestExpr(t *testing.T) {
+	cond := func(key string, op query.Operator, operand interface{}) *query.Expr {
+		return &query.Expr{Condition: query.Condition{CompositeKey: key, Op: op, Operand: operand}}
+	}
+
+	testCases := []struct {
+		s    string
+		expr *query.Expr
+	}{
+		{"tx.gas > 7", cond("tx.gas", query.OpGreater, int64(7))},
+		{"(tx.gas > 7)", cond("tx.gas", query.OpGreater, int64(7))},
+		{
+			"a.b = 'x' OR a.b = 'y' AND tx.gas > 7",
+			&query.Expr{Op: query.ExprOr, Args: []*query.Expr{
+				cond("a.b", query.OpEqual, "x"),
+				{Op: query.ExprAnd, Args: []*query.Expr{
+					cond("a.b", query.OpEqual, "y"),
+					cond("tx.gas", query.OpGreater, int64(7)),
+				}},
+			}},
+		},
+		{
+			"NOT (a.b = 'x' OR a.c EXISTS) AND tx.gas > 7",
+			&query.Expr{Op: query.ExprAnd, Args: []*query.Expr{
+				{Op: query.ExprNot, Args: []*query.Expr{
+					{Op: query.ExprOr, Args: []*query.Expr{
+						cond("a.b", query.OpEqual, "x"),
+						cond("a.c", query.OpExists, nil),
+					}},
+				}},
+				cond("tx.gas", query.OpGreater, int64(7)),
+			}},
+		},
+		{
+			"a.b IN ('x', 7, 1.5)",
+			&query.Expr{Op: query.ExprOr, Args: []*query.Expr{
+				cond("a.b", query.OpEqual, "x"),
+				cond("a.b", query.OpEqual, int64(7)),
+				cond("a.b", query.OpEqual, 1.5),
+			}},
+		},
+		{"a.b IN ('x')", cond("a.b", query.OpEqual, "x")},
+		{
+			"a.b = 'x' OR a.b IN ('y', 'z')",
+			&query.Expr{Op: query.ExprOr, Args: []*query.Expr{
+				cond("a.b", query.OpEqual, "x"),
+				cond("a.b", query.OpEqual, "y"),
+				cond("a.b", query.OpEqual, "z"),
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		q, err := query.New(tc.s)
+		require.NoError(t, err, tc.s)
+		assert.Equal(t, tc.expr, q.Expr(), tc.s)
+	}
+
+	// only the conjunctions of conditions have a list of conditions
+	for _, s := range []string{"a.b = 'x' OR a.b = 'y'", "NOT a.b = 'x'", "a.b IN ('x', 'y')", "a.b = 'x' AND NOT a.c EXISTS"} {
+		_, err := query.MustParse(s).Conditions()
+		assert.Error(t, err, s)
+	}
+	c, err := query.MustParse("(a.b = 'x' AND a.c EXISTS) AND (a.d < 5)").Conditions()
+	require.NoError(t, err)
+	assert.Equal(t, []query.Condition{
+		{CompositeKey: "a.b", Op: query.OpEqual, Operand: "x"},
+		{CompositeKey: "a.c", Op: query.OpExists},
+		{CompositeKey: "a.d", Op: query.OpLess, Operand: int64(5)},
+	}, c)
+}
@@ -125,6 +125,28 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// PrimaryRotation option makes the light client replace its primary with a
+// witness whose score is better by margin, e.g. 0.2 for 20%, before verifying
+// a new light block. The providers are scored by the latency and the errors
+// of their responses, and the divergences of their headers from the
+// primary's (see Client.ProviderHealth). Default: 0, the primary is only
+// replaced when it fails.
+func PrimaryRotation(margin float64) Option {
+	return func(c *Client) {
+		c.rotationMargin = margin
+	}
+}
+
+// MaxProviderFailures option makes the light client remove a witness once
+// max of its requests in a row failed, other than because it doesn't have
+// the light block (yet). At least a witness is kept. Default: 0, witnesses
+// are only removed when they send invalid light blocks.
+func MaxProviderFailures(max uint16) Option {
+	return func(c *Client) {
+		c.maxProviderFailures = max
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -145,6 +167,15 @@ type Client struct {
 	primary provider.Provider
 	// Providers used to "witness" new headers.
 	witnesses []provider.Provider
+	// See PrimaryRotation option
+	rotationMargin float64
+	// See MaxProviderFailures option
+	maxProviderFailures uint16
+
+	// Mutex for locking during changes of the scores of the providers
+	scoresMutex cmtsync.Mutex
+	// Scores of the providers, tracked from their responses.
+	scores map[provider.Provider]*providerScore
 
 	// Where trusted light blocks are stored.
 	trustedStore store.Store
@@ -227,6 +258,7 @@ func NewClientFromTrustedStore(
 		maxBlockLag:      defaultMaxBlockLag,
 		primary:          primary,
 		witnesses:        witnesses,
+		scores:           make(map[provider.Provider]*providerScore),
 		trustedStore:     trustedStore,
 		pruningSize:      defaultPruningSize,
 		confirmationFn:   func(action string) bool { return true },
@@ -444,6 +476,7 @@ func (c *Client) Update(ctx context.Context, now time.Time) (*types.LightBlock,
 		return nil, nil
 	}
 
+	c.rotateProviders()
 	latestBlock, err := c.lightBlockFromPrimary(ctx, 0)
 	if err != nil {
 		return nil, err
@@ -484,6 +517,8 @@ func (c *Client) VerifyLightBlockAtHeight(ctx context.Context, height int64, now
 		return h, nil
 	}
 
+	c.rotateProviders()
+
 	// Request the light block from primary
 	l, err := c.lightBlockFromPrimary(ctx, height)
 	if err != nil {
@@ -542,6 +577,8 @@ func (c *Client) VerifyHeader(ctx context.Context, newHeader *types.Header, now
 		return nil
 	}
 
+	c.rotateProviders()
+
 	// Request the header and the vals.
 	l, err = c.lightBlockFromPrimary(ctx, newHeader.Height)
 	if err != nil {
@@ -748,7 +785,7 @@ func (c *Client) verifySkipping(
 			if depth == len(blockCache)-1 {
 				pivotHeight := verifiedBlock.Height + (blockCache[depth].Height-verifiedBlock.
 					Height)*verifySkippingNumerator/verifySkippingDenominator
-				interimBlock, providerErr := source.LightBlock(ctx, pivotHeight)
+				interimBlock, providerErr := c.lightBlockFrom(ctx, source, pivotHeight)
 				switch providerErr {
 				case nil:
 					blockCache = append(blockCache, interimBlock)
@@ -989,7 +1026,7 @@ func (c *Client) backwards(
 //     any other error, the primary is permanently dropped and is replaced by a witness.
 func (c *Client) lightBlockFromPrimary(ctx context.Context, height int64) (*types.LightBlock, error) {
 	c.providerMutex.Lock()
-	l, err := c.primary.LightBlock(ctx, height)
+	l, err := c.lightBlockFrom(ctx, c.primary, height)
 	c.providerMutex.Unlock()
 
 	switch err {
@@ -1066,7 +1103,7 @@ func (c *Client) findNewPrimary(ctx context.Context, height int64, remove bool)
 		go func(witnessIndex int, witnessResponsesC chan witnessResponse) {
 			defer wg.Done()
 
-			lb, err := c.witnesses[witnessIndex].LightBlock(subctx, height)
+			lb, err := c.lightBlockFrom(subctx, c.witnesses[witnessIndex], height)
 			witnessResponsesC <- witnessResponse{lb, witnessIndex, err}
 		}(index, witnessResponsesC)
 	}
@@ -1154,6 +1191,7 @@ func (c *Client) compareFirstHeaderWithWitnesses(ctx context.Context, h *types.S
 		case nil:
 			continue
 		case errConflictingHeaders:
+			c.recordDivergence(c.witnesses[e.WitnessIndex])
 			c.logger.Error(fmt.Sprintf(`Witness #%d has a different header. Please check primary is correct
 and remove witness. Otherwise, use the different primary`, e.WitnessIndex), "witness", c.witnesses[e.WitnessIndex])
 			return err
@@ -778,6 +778,84 @@ func TestClientReplacesPrimaryWithWitnessIfPrimaryIsUnavailable(t *testing.T) {
 	assert.Equal(t, 2, len(c.Witnesses()))
 }
 
+// slowProvider delays the light blocks of a provider.
+type slowProvider struct {
+	provider.Provider
+	delay time.Duration
+}
+
+func (p *slowProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	time.Sleep(p.delay)
+	return p.Provider.LightBlock(ctx, height)
+}
+
+func TestClientRotatesPrimaryToBetterWitness(t *testing.T) {
+	slowNode := &slowProvider{Provider: fullNode, delay: 100 * time.Millisecond}
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		slowNode,
+		[]provider.Provider{fullNode},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.PrimaryRotation(0.2),
+	)
+	require.NoError(t, err)
+
+	// the providers are only compared once they responded a few times
+	_, err = c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	_, err = c.VerifyLightBlockAtHeight(ctx, 3, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, slowNode, c.Primary())
+
+	_, err = c.Update(ctx, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, fullNode, c.Primary())
+	assert.Equal(t, []provider.Provider{slowNode}, c.Witnesses())
+
+	health := c.ProviderHealth()
+	require.Len(t, health, 2)
+	assert.True(t, health[0].Primary)
+	assert.Equal(t, fullNode, health[0].Provider)
+	assert.False(t, health[1].Primary)
+	assert.Equal(t, slowNode, health[1].Provider)
+	assert.Greater(t, health[0].Score, health[1].Score)
+	assert.GreaterOrEqual(t, health[1].Latency, 100*time.Millisecond)
+	assert.Zero(t, health[1].Errors)
+}
+
+func TestClientRemovesWitnessAfterRepeatedFailures(t *testing.T) {
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		trustOptions,
+		fullNode,
+		[]provider.Provider{deadNode, fullNode},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.MaxProviderFailures(2),
+	)
+	require.NoError(t, err)
+
+	// the dead witness failed once when the client was created, and once
+	// more when it cross-checked the light block
+	_, err = c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, c.Witnesses(), 2)
+
+	health := c.ProviderHealth()
+	require.Len(t, health, 3)
+	assert.EqualValues(t, 2, health[1].Errors)
+	assert.Equal(t, provider.ErrNoResponse, health[1].LastError)
+	assert.InDelta(t, 0.36, health[1].ErrorRate, 1e-9)
+
+	_, err = c.VerifyLightBlockAtHeight(ctx, 3, bTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []provider.Provider{fullNode}, c.Witnesses())
+}
+
 func TestClient_BackwardsVerification(t *testing.T) {
 	{
 		trustHeader, _ := largeFullNode.LightBlock(ctx, 6)
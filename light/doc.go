@@ -91,6 +91,13 @@ Example usage:
 
 Check out other examples in example_test.go
 
+The client scores the primary and the witnesses from their responses: the
+latency, the errors and the headers diverging from the primary's. See
+Client.ProviderHealth. With the PrimaryRotation option, the primary is
+replaced with a witness scoring better before verifying a new header, and
+with the MaxProviderFailures option, the witnesses failing repeatedly are
+removed.
+
 ## 2. Pure functions to verify a new header (see verifier.go)
 
 Verify function verifies a new header against some trusted header. See
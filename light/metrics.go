@@ -0,0 +1,29 @@
+package light
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "light"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of light blocks in the trusted store.
+	StoreSize metrics.Gauge
+
+	// Height of the oldest light block in the trusted store.
+	StoreFirstHeight metrics.Gauge
+
+	// Height of the latest light block in the trusted store.
+	StoreLastHeight metrics.Gauge
+
+	// Number of light blocks pruned from the trusted store by the pruning
+	// policy.
+	StorePrunedBlocks metrics.Counter
+}
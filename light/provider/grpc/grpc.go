@@ -0,0 +1,231 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/light/provider"
+	coregrpc "github.com/cometbft/cometbft/rpc/grpc"
+	"github.com/cometbft/cometbft/types"
+)
+
+// readAhead is the number of light blocks streamed when the light blocks are
+// requested sequentially, e.g. by the sequential verification.
+const readAhead = 100
+
+// grpc provider uses the LightBlockAPI of a full node to obtain the light
+// blocks, in a single request per light block rather than one for the signed
+// header and one per page of validators.
+type grpc struct {
+	chainID string
+	addr    string
+	client  coregrpc.LightBlockAPIClient
+
+	// protects lastHeight and buffer, and isn't held during the requests
+	mtx cmtsync.Mutex
+	// height of the last light block returned
+	lastHeight int64
+	// light blocks streamed ahead of the requests, in ascending order
+	buffer []*types.LightBlock
+}
+
+// New creates a gRPC provider, connecting to the gRPC server of a full node,
+// i.e. the rpc.grpc_laddr of its config, at addr (e.g. "tcp://1.2.3.4:26090").
+func New(chainID, addr string) (provider.Provider, error) {
+	//nolint:staticcheck,nolintlint // SA1019 Existing use of deprecated but supported dial option.
+	conn, err := ggrpc.Dial(addr, ggrpc.WithInsecure(), ggrpc.WithContextDialer(dialerFunc))
+	if err != nil {
+		return nil, err
+	}
+
+	p := NewWithClient(chainID, coregrpc.NewLightBlockAPIClient(conn)).(*grpc)
+	p.addr = addr
+	return p, nil
+}
+
+// NewWithClient allows you to provide a custom client.
+func NewWithClient(chainID string, client coregrpc.LightBlockAPIClient) provider.Provider {
+	return &grpc{
+		chainID: chainID,
+		client:  client,
+	}
+}
+
+func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
+	return cmtnet.Connect(addr)
+}
+
+// ChainID returns a chainID this provider was configured with.
+func (p *grpc) ChainID() string {
+	return p.chainID
+}
+
+func (p *grpc) String() string {
+	return fmt.Sprintf("grpc{%s}", p.addr)
+}
+
+// LightBlock fetches a LightBlock at the given height and checks the
+// chainID matches. If the height follows the one of the previous request,
+// the next light blocks are streamed and kept for the next requests.
+func (p *grpc) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, provider.ErrBadLightBlock{Reason: fmt.Errorf("expected height >= 0, got height %d", height)}
+	}
+
+	p.mtx.Lock()
+	lb := p.buffered(height)
+	if lb != nil {
+		p.lastHeight = height
+	}
+	sequential := height != 0 && height == p.lastHeight+1
+	p.mtx.Unlock()
+	if lb != nil {
+		return lb, nil
+	}
+
+	var (
+		next []*types.LightBlock
+		err  error
+	)
+	if sequential {
+		lb, next, err = p.streamLightBlocks(ctx, height)
+	} else {
+		lb, err = p.lightBlock(ctx, height)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if sequential {
+		p.buffer = next
+	}
+	p.lastHeight = lb.Height
+	return lb, nil
+}
+
+// ReportEvidence broadcasts the evidence through the LightBlockAPI.
+func (p *grpc) ReportEvidence(ctx context.Context, ev types.Evidence) error {
+	pb, err := types.EvidenceToProto(ev)
+	if err != nil {
+		return err
+	}
+	_, err = p.client.BroadcastEvidence(ctx, &coregrpc.RequestBroadcastEvidence{Evidence: pb})
+	return err
+}
+
+// buffered returns the buffered light block at height, if any, and drops the
+// buffered light blocks below it.
+func (p *grpc) buffered(height int64) *types.LightBlock {
+	if height == 0 {
+		return nil
+	}
+	for len(p.buffer) > 0 && p.buffer[0].Height < height {
+		p.buffer = p.buffer[1:]
+	}
+	if len(p.buffer) == 0 || p.buffer[0].Height != height {
+		return nil
+	}
+	lb := p.buffer[0]
+	p.buffer = p.buffer[1:]
+	return lb
+}
+
+func (p *grpc) lightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	res, err := p.client.LightBlock(ctx, &coregrpc.RequestLightBlock{Height: height})
+	if err != nil {
+		return nil, lightBlockError(ctx, err)
+	}
+	return p.validateLightBlock(res, height)
+}
+
+// streamLightBlocks returns the light block at height, and the light blocks
+// streamed after it.
+func (p *grpc) streamLightBlocks(ctx context.Context, height int64) (*types.LightBlock, []*types.LightBlock, error) {
+	// the stream isn't necessarily read until its end
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := p.client.LightBlocks(streamCtx, &coregrpc.RequestLightBlocks{
+		FromHeight: height,
+		ToHeight:   height + readAhead - 1,
+	})
+	if err != nil {
+		return nil, nil, lightBlockError(ctx, err)
+	}
+
+	var lbs []*types.LightBlock
+	for h := height; h < height+readAhead; h++ {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if len(lbs) > 0 {
+				// the light blocks received so far are still valid
+				break
+			}
+			return nil, nil, lightBlockError(ctx, err)
+		}
+		lb, err := p.validateLightBlock(res, h)
+		if err != nil {
+			return nil, nil, err
+		}
+		lbs = append(lbs, lb)
+	}
+	if len(lbs) == 0 {
+		return nil, nil, provider.ErrBadLightBlock{Reason: errors.New("no light block streamed")}
+	}
+
+	return lbs[0], lbs[1:], nil
+}
+
+// validateLightBlock decodes the light block of res and checks it's at
+// height, unless height is 0.
+func (p *grpc) validateLightBlock(res *coregrpc.ResponseLightBlock, height int64) (*types.LightBlock, error) {
+	if res.LightBlock == nil {
+		return nil, provider.ErrBadLightBlock{Reason: errors.New("empty light block")}
+	}
+	lb, err := types.LightBlockFromProto(res.LightBlock)
+	if err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+
+	if height != 0 && lb.Height != height {
+		return nil, provider.ErrBadLightBlock{
+			Reason: fmt.Errorf("height %d responded doesn't match height %d requested", lb.Height, height),
+		}
+	}
+
+	if err := lb.ValidateBasic(p.chainID); err != nil {
+		return nil, provider.ErrBadLightBlock{Reason: err}
+	}
+	return lb, nil
+}
+
+// lightBlockError returns the provider error matching the status of err.
+func lightBlockError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return provider.ErrLightBlockNotFound
+	case codes.OutOfRange:
+		return provider.ErrHeightTooHigh
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return provider.ErrNoResponse
+	default:
+		return err
+	}
+}
@@ -0,0 +1,159 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ggrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/light/provider"
+	lightgrpc "github.com/cometbft/cometbft/light/provider/grpc"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coregrpc "github.com/cometbft/cometbft/rpc/grpc"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
+)
+
+// countingClient counts the requests made to the LightBlockAPI.
+type countingClient struct {
+	coregrpc.LightBlockAPIClient
+	unary, streams int
+}
+
+func (c *countingClient) LightBlock(
+	ctx context.Context,
+	in *coregrpc.RequestLightBlock,
+	opts ...ggrpc.CallOption,
+) (*coregrpc.ResponseLightBlock, error) {
+	c.unary++
+	return c.LightBlockAPIClient.LightBlock(ctx, in, opts...)
+}
+
+func (c *countingClient) LightBlocks(
+	ctx context.Context,
+	in *coregrpc.RequestLightBlocks,
+	opts ...ggrpc.CallOption,
+) (coregrpc.LightBlockAPI_LightBlocksClient, error) {
+	c.streams++
+	return c.LightBlockAPIClient.LightBlocks(ctx, in, opts...)
+}
+
+// blockingClient streams no light block until unblocked, and has no light
+// block to return otherwise.
+type blockingClient struct {
+	coregrpc.LightBlockAPIClient
+	streaming chan struct{}
+	unblock   chan struct{}
+}
+
+func (c *blockingClient) LightBlock(
+	ctx context.Context,
+	in *coregrpc.RequestLightBlock,
+	opts ...ggrpc.CallOption,
+) (*coregrpc.ResponseLightBlock, error) {
+	return nil, status.Error(codes.NotFound, "pruned")
+}
+
+func (c *blockingClient) LightBlocks(
+	ctx context.Context,
+	in *coregrpc.RequestLightBlocks,
+	opts ...ggrpc.CallOption,
+) (coregrpc.LightBlockAPI_LightBlocksClient, error) {
+	close(c.streaming)
+	return blockingStream{unblock: c.unblock}, nil
+}
+
+type blockingStream struct {
+	ggrpc.ClientStream
+	unblock chan struct{}
+}
+
+func (s blockingStream) Recv() (*coregrpc.ResponseLightBlock, error) {
+	<-s.unblock
+	return nil, io.EOF
+}
+
+func TestNewProvider(t *testing.T) {
+	c, err := lightgrpc.New("chain-test", "tcp://192.168.0.1:26090")
+	require.NoError(t, err)
+	require.Equal(t, "grpc{tcp://192.168.0.1:26090}", fmt.Sprintf("%s", c))
+}
+
+func TestProvider(t *testing.T) {
+	app := kvstore.NewApplication()
+	app.RetainBlocks = 10
+	node := rpctest.StartTendermint(app)
+	defer rpctest.StopTendermint(node)
+
+	cfg := rpctest.GetConfig()
+	defer os.RemoveAll(cfg.RootDir)
+	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
+	require.NoError(t, err)
+	chainID := genDoc.ChainID
+
+	c, err := rpchttp.New(cfg.RPC.ListenAddress, "/websocket")
+	require.NoError(t, err)
+	// let it produce some blocks
+	err = rpcclient.WaitForHeight(c, 12, nil)
+	require.NoError(t, err)
+
+	client := &countingClient{LightBlockAPIClient: coregrpc.StartGRPCLightBlockClient(cfg.RPC.GRPCListenAddress)}
+	p := lightgrpc.NewWithClient(chainID, client)
+
+	// let's get the highest block
+	lb, err := p.LightBlock(context.Background(), 0)
+	require.NoError(t, err)
+	require.NotNil(t, lb)
+	assert.Nil(t, lb.ValidateBasic(chainID))
+
+	// the light blocks following a requested one are streamed once
+	lower := lb.Height - 3
+	lb, err = p.LightBlock(context.Background(), lower)
+	require.NoError(t, err)
+	assert.Equal(t, lower, lb.Height)
+	for height := lower + 1; height <= lower+3; height++ {
+		lb, err = p.LightBlock(context.Background(), height)
+		require.NoError(t, err)
+		assert.Equal(t, height, lb.Height)
+		assert.Nil(t, lb.ValidateBasic(chainID))
+	}
+	assert.Equal(t, 2, client.unary)
+	assert.Equal(t, 1, client.streams)
+
+	// fetching missing heights (both future and pruned) should return appropriate errors
+	lb, err = p.LightBlock(context.Background(), 1000)
+	require.Nil(t, lb)
+	assert.Equal(t, provider.ErrHeightTooHigh, err)
+
+	lb, err = p.LightBlock(context.Background(), 1)
+	require.Nil(t, lb)
+	assert.Equal(t, provider.ErrLightBlockNotFound, err)
+}
+
+func TestProviderRequestsConcurrently(t *testing.T) {
+	client := &blockingClient{streaming: make(chan struct{}), unblock: make(chan struct{})}
+	p := lightgrpc.NewWithClient("chain-test", client)
+
+	streamErr := make(chan error, 1)
+	go func() {
+		_, err := p.LightBlock(context.Background(), 1)
+		streamErr <- err
+	}()
+	<-client.streaming
+
+	// the stream being read doesn't block the other requests
+	_, err := p.LightBlock(context.Background(), 5)
+	assert.Equal(t, provider.ErrLightBlockNotFound, err)
+
+	close(client.unblock)
+	assert.Error(t, <-streamErr)
+}
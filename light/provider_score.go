@@ -0,0 +1,211 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// The weight of the latest response in the moving averages of the latency
+	// and the error rate of a provider.
+	scoreDecay = 0.2
+	// The latency at which the score of a provider is halved.
+	scoreLatencyScale = 100 * time.Millisecond
+	// The number of requests to a provider before its score is compared with
+	// the others'.
+	minScoredRequests = 3
+)
+
+// ProviderHealth is the health of a provider of the light client, as tracked
+// from its responses (see Client.ProviderHealth).
+type ProviderHealth struct {
+	Provider provider.Provider
+	Primary  bool
+
+	Requests int64
+	Errors   int64
+	// Number of the headers of the provider which diverged from the primary's.
+	Divergences int64
+	// Moving averages of the latency of the responses and of the ratio of
+	// failed requests.
+	Latency   time.Duration
+	ErrorRate float64
+	// Score of the provider, from 0 to 1 (the best), used to rotate the
+	// primary (see PrimaryRotation).
+	Score     float64
+	LastError error
+}
+
+// providerScore tracks the responses of a provider.
+type providerScore struct {
+	requests            int64
+	errors              int64
+	consecutiveFailures int
+	divergences         int64
+	latency             time.Duration
+	errorRate           float64
+	lastError           error
+}
+
+// score is the score of the provider: 1 for a provider which responds
+// instantly, without errors nor divergences, and halved by each 100ms of
+// latency.
+func (s *providerScore) score() float64 {
+	if s.requests == 0 {
+		return 0
+	}
+	latency := float64(scoreLatencyScale) / float64(scoreLatencyScale+s.latency)
+	return (1 - s.errorRate) * latency / float64(1+s.divergences)
+}
+
+// lightBlockFrom requests the light block at height from p, and records the
+// response in the score of p.
+func (c *Client) lightBlockFrom(ctx context.Context, p provider.Provider, height int64) (*types.LightBlock, error) {
+	start := time.Now()
+	l, err := p.LightBlock(ctx, height)
+	c.recordResponse(p, time.Since(start), err)
+	return l, err
+}
+
+// recordResponse records a response of p, which took latency.
+func (c *Client) recordResponse(p provider.Provider, latency time.Duration, err error) {
+	// the requests canceled by the client say nothing of the provider
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+
+	c.scoresMutex.Lock()
+	defer c.scoresMutex.Unlock()
+
+	s := c.scoreOf(p)
+	s.requests++
+	failed := 0.0
+	switch err {
+	case nil:
+		s.consecutiveFailures = 0
+	case provider.ErrLightBlockNotFound, provider.ErrHeightTooHigh:
+		// the provider is behind or pruned the block, which lowers its score
+		// but doesn't make it bad
+		s.errors++
+		s.lastError = err
+		failed = 1
+	default:
+		s.errors++
+		s.consecutiveFailures++
+		s.lastError = err
+		failed = 1
+	}
+	s.errorRate += (failed - s.errorRate) * scoreDecay
+
+	// a provider which didn't respond has no latency
+	if err == provider.ErrNoResponse {
+		return
+	}
+	if s.latency == 0 {
+		s.latency = latency
+	} else {
+		s.latency += time.Duration(float64(latency-s.latency) * scoreDecay)
+	}
+}
+
+// recordDivergence records that a header of p diverged from the primary's.
+func (c *Client) recordDivergence(p provider.Provider) {
+	c.scoresMutex.Lock()
+	defer c.scoresMutex.Unlock()
+	c.scoreOf(p).divergences++
+}
+
+// NOTE: requires a scoresMutex lock
+func (c *Client) scoreOf(p provider.Provider) *providerScore {
+	s, ok := c.scores[p]
+	if !ok {
+		s = &providerScore{}
+		c.scores[p] = s
+	}
+	return s
+}
+
+// ProviderHealth returns the health of the primary, first, and of the
+// witnesses.
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) ProviderHealth() []ProviderHealth {
+	c.providerMutex.Lock()
+	defer c.providerMutex.Unlock()
+	c.scoresMutex.Lock()
+	defer c.scoresMutex.Unlock()
+
+	health := make([]ProviderHealth, 0, 1+len(c.witnesses))
+	for i, p := range append([]provider.Provider{c.primary}, c.witnesses...) {
+		s := c.scoreOf(p)
+		health = append(health, ProviderHealth{
+			Provider:    p,
+			Primary:     i == 0,
+			Requests:    s.requests,
+			Errors:      s.errors,
+			Divergences: s.divergences,
+			Latency:     s.latency,
+			ErrorRate:   s.errorRate,
+			Score:       s.score(),
+			LastError:   s.lastError,
+		})
+	}
+	return health
+}
+
+// rotateProviders drops the witnesses which failed too many times in a row,
+// and replaces the primary with the best witness if its score is better by
+// the rotation margin (see MaxProviderFailures and PrimaryRotation).
+func (c *Client) rotateProviders() {
+	if c.maxProviderFailures == 0 && c.rotationMargin <= 0 {
+		return
+	}
+
+	c.providerMutex.Lock()
+	defer c.providerMutex.Unlock()
+	c.scoresMutex.Lock()
+	defer c.scoresMutex.Unlock()
+
+	if c.maxProviderFailures > 0 {
+		var witnessesToRemove []int
+		for i, w := range c.witnesses {
+			if c.scoreOf(w).consecutiveFailures >= int(c.maxProviderFailures) {
+				witnessesToRemove = append(witnessesToRemove, i)
+			}
+		}
+		// at least a witness is kept to cross-check the primary
+		if len(witnessesToRemove) > 0 && len(witnessesToRemove) < len(c.witnesses) {
+			c.logger.Info("removing witnesses which failed too many times", "witnesses", witnessesToRemove)
+			if err := c.removeWitnesses(witnessesToRemove); err != nil {
+				c.logger.Error("failed to remove witnesses", "err", err, "witnessesToRemove", witnessesToRemove)
+			}
+		}
+	}
+
+	if c.rotationMargin > 0 {
+		primary := c.scoreOf(c.primary)
+		if primary.requests < minScoredRequests {
+			return
+		}
+		best, bestScore := -1, primary.score()*(1+c.rotationMargin)
+		for i, w := range c.witnesses {
+			s := c.scoreOf(w)
+			if s.requests < minScoredRequests {
+				continue
+			}
+			if score := s.score(); score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		if best >= 0 {
+			c.logger.Info("replacing primary with a better witness",
+				"primary", c.primary, "score", primary.score(),
+				"witness", c.witnesses[best], "witnessScore", bestScore)
+			c.primary, c.witnesses[best] = c.witnesses[best], c.primary
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	"github.com/cometbft/cometbft/light"
@@ -24,7 +25,9 @@ type Proxy struct {
 }
 
 // NewProxy creates the struct used to run an HTTP server for serving light
-// client rpc requests.
+// client rpc requests. Besides the proof operators of merkle.DefaultProofRuntime,
+// the client verifies the ICS23 proofs of the ABCI queries (see
+// merkle.ICS23Op), e.g. of the stores of Cosmos SDK applications.
 func NewProxy(
 	lightClient *light.Client,
 	listenAddr, providerAddr string,
@@ -37,10 +40,15 @@ func NewProxy(
 		return nil, fmt.Errorf("failed to create http client for %s: %w", providerAddr, err)
 	}
 
+	client := lrpc.NewClient(rpcClient, lightClient, opts...)
+	for _, typ := range []string{merkle.ProofOpICS23IAVL, merkle.ProofOpICS23Simple, merkle.ProofOpICS23SMT} {
+		client.RegisterOpDecoder(typ, merkle.ICS23OpDecoder)
+	}
+
 	return &Proxy{
 		Addr:   listenAddr,
 		Config: config,
-		Client: lrpc.NewClient(rpcClient, lightClient, opts...),
+		Client: client,
 		Logger: logger,
 	}, nil
 }
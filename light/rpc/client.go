@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -15,6 +16,7 @@ import (
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	service "github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/light"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
@@ -36,6 +38,12 @@ type LightClient interface {
 	TrustedLightBlock(height int64) (*types.LightBlock, error)
 }
 
+// providerHealthReporter is implemented by the light clients which track the
+// health of their providers.
+type providerHealthReporter interface {
+	ProviderHealth() []light.ProviderHealth
+}
+
 var _ rpcclient.Client = (*Client)(nil)
 
 // Client is an RPC client, which uses light#Client to verify data (if it can
@@ -50,6 +58,8 @@ type Client struct {
 	// proof runtime used to verify values returned by ABCIQuery
 	prt       *merkle.ProofRuntime
 	keyPathFn KeyPathFunc
+	// prefixes of the paths of the ABCI queries to verify, all if empty
+	verifiedPaths []string
 }
 
 var _ rpcclient.Client = (*Client)(nil)
@@ -66,6 +76,17 @@ func KeyPathFn(fn KeyPathFunc) Option {
 	}
 }
 
+// VerifiedPaths option restricts the verification of ABCI queries to the
+// paths starting with one of the given prefixes, e.g. "/store/bank/". The
+// queries of other paths are forwarded to the node and returned unverified.
+// By default, every query is verified, and fails if the node doesn't prove
+// its result.
+func VerifiedPaths(prefixes ...string) Option {
+	return func(c *Client) {
+		c.verifiedPaths = prefixes
+	}
+}
+
 // DefaultMerkleKeyPathFn creates a function used to generate merkle key paths
 // from a path string and a key. This is the default used by the cosmos SDK.
 // This merkle key paths are required when verifying /abci_query calls
@@ -116,8 +137,35 @@ func (c *Client) OnStop() {
 	}
 }
 
+// Status returns the status of the node, with the health of the providers of
+// the light client if it tracks it (see light.Client.ProviderHealth).
 func (c *Client) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
-	return c.next.Status(ctx)
+	res, err := c.next.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if lc, ok := c.lc.(providerHealthReporter); ok {
+		info := &ctypes.LightClientInfo{}
+		for _, h := range lc.ProviderHealth() {
+			p := ctypes.LightProviderInfo{
+				Address:     fmt.Sprint(h.Provider),
+				Primary:     h.Primary,
+				Requests:    h.Requests,
+				Errors:      h.Errors,
+				Divergences: h.Divergences,
+				Latency:     h.Latency,
+				ErrorRate:   h.ErrorRate,
+				Score:       h.Score,
+			}
+			if h.LastError != nil {
+				p.LastError = h.LastError.Error()
+			}
+			info.Providers = append(info.Providers, p)
+		}
+		res.LightClientInfo = info
+	}
+	return res, nil
 }
 
 func (c *Client) ABCIInfo(ctx context.Context) (*ctypes.ResultABCIInfo, error) {
@@ -129,10 +177,15 @@ func (c *Client) ABCIQuery(ctx context.Context, path string, data cmtbytes.HexBy
 	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
 }
 
-// ABCIQueryWithOptions returns an error if opts.Prove is false.
+// ABCIQueryWithOptions returns an error if the result of the query can't be
+// verified, unless its path doesn't require verification (see VerifiedPaths).
 func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data cmtbytes.HexBytes,
 	opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
 
+	if !c.verifiesPath(path) {
+		return c.next.ABCIQueryWithOptions(ctx, path, data, opts)
+	}
+
 	// always request the proof
 	opts.Prove = true
 
@@ -164,25 +217,23 @@ func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data cmt
 		return nil, err
 	}
 
+	// Build a Merkle key path from path and resp.Key.
+	if c.keyPathFn == nil {
+		return nil, errors.New("please configure Client with KeyPathFn option")
+	}
+	kp, err := c.keyPathFn(path, resp.Key)
+	if err != nil {
+		return nil, fmt.Errorf("can't build merkle key path: %w", err)
+	}
+
 	// Validate the value proof against the trusted header.
 	if resp.Value != nil {
-		// 1) build a Merkle key path from path and resp.Key
-		if c.keyPathFn == nil {
-			return nil, errors.New("please configure Client with KeyPathFn option")
-		}
-
-		kp, err := c.keyPathFn(path, resp.Key)
-		if err != nil {
-			return nil, fmt.Errorf("can't build merkle key path: %w", err)
-		}
-
-		// 2) verify value
 		err = c.prt.VerifyValue(resp.ProofOps, l.AppHash, kp.String(), resp.Value)
 		if err != nil {
 			return nil, fmt.Errorf("verify value proof: %w", err)
 		}
 	} else { // OR validate the absence proof against the trusted header.
-		err = c.prt.VerifyAbsence(resp.ProofOps, l.AppHash, string(resp.Key))
+		err = c.prt.VerifyAbsence(resp.ProofOps, l.AppHash, kp.String())
 		if err != nil {
 			return nil, fmt.Errorf("verify absence proof: %w", err)
 		}
@@ -191,6 +242,19 @@ func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data cmt
 	return &ctypes.ResultABCIQuery{Response: resp}, nil
 }
 
+// verifiesPath returns true if the ABCI queries of path must be verified.
+func (c *Client) verifiesPath(path string) bool {
+	if len(c.verifiedPaths) == 0 {
+		return true
+	}
+	for _, prefix := range c.verifiedPaths {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Client) BroadcastTxCommit(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	return c.next.BroadcastTxCommit(ctx, tx)
 }
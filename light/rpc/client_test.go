@@ -0,0 +1,188 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	ics23 "github.com/cosmos/ics23/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	lcmock "github.com/cometbft/cometbft/light/rpc/mocks"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpcmock "github.com/cometbft/cometbft/rpc/client/mocks"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ics23Tree returns the root of the simple Merkle tree of the two key/value
+// pairs, the left key first, and the ICS23 existence proofs of the keys.
+func ics23Tree(t *testing.T, leftKey, leftValue, rightKey, rightValue []byte) (
+	root []byte,
+	left, right *ics23.ExistenceProof,
+) {
+	leafSpec := ics23.TendermintSpec.LeafSpec
+	leftLeaf, err := leafSpec.Apply(leftKey, leftValue)
+	require.NoError(t, err)
+	rightLeaf, err := leafSpec.Apply(rightKey, rightValue)
+	require.NoError(t, err)
+
+	left = &ics23.ExistenceProof{
+		Key:   leftKey,
+		Value: leftValue,
+		Leaf:  leafSpec,
+		Path:  []*ics23.InnerOp{{Hash: ics23.HashOp_SHA256, Prefix: []byte{1}, Suffix: rightLeaf}},
+	}
+	right = &ics23.ExistenceProof{
+		Key:   rightKey,
+		Value: rightValue,
+		Leaf:  leafSpec,
+		Path:  []*ics23.InnerOp{{Hash: ics23.HashOp_SHA256, Prefix: append([]byte{1}, leftLeaf...)}},
+	}
+	root, err = left.Calculate()
+	require.NoError(t, err)
+	return root, left, right
+}
+
+func TestABCIQuery(t *testing.T) {
+	const height = 10
+
+	// the keys of the bank store, and the root of the store in a multistore
+	storeRoot, a, c := ics23Tree(t, []byte("a"), []byte("1"), []byte("c"), []byte("3"))
+	appHash, bank, _ := ics23Tree(t, []byte("bank"), storeRoot, []byte("staking"), []byte("root"))
+
+	proofOps := func(key string, proof *ics23.CommitmentProof) *cmtcrypto.ProofOps {
+		storeOp, err := merkle.NewICS23Op(merkle.ProofOpICS23Simple, []byte(key), proof)
+		require.NoError(t, err)
+		multistoreOp, err := merkle.NewICS23Op(merkle.ProofOpICS23Simple, []byte("bank"),
+			&ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: bank}})
+		require.NoError(t, err)
+		return &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{storeOp.ProofOp(), multistoreOp.ProofOp()}}
+	}
+
+	testCases := []struct {
+		name    string
+		path    string
+		resp    abci.ResponseQuery
+		appHash []byte
+		wantErr bool
+	}{
+		{
+			name: "existence",
+			path: "/store/bank/key",
+			resp: abci.ResponseQuery{
+				Key:      []byte("a"),
+				Value:    []byte("1"),
+				ProofOps: proofOps("a", &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: a}}),
+				Height:   height,
+			},
+			appHash: appHash,
+		},
+		{
+			name: "existence of another value",
+			path: "/store/bank/key",
+			resp: abci.ResponseQuery{
+				Key:      []byte("a"),
+				Value:    []byte("2"),
+				ProofOps: proofOps("a", &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: a}}),
+				Height:   height,
+			},
+			appHash: appHash,
+			wantErr: true,
+		},
+		{
+			name: "non-existence",
+			path: "/store/bank/key",
+			resp: abci.ResponseQuery{
+				Key: []byte("b"),
+				ProofOps: proofOps("b", &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{
+					Nonexist: &ics23.NonExistenceProof{Key: []byte("b"), Left: a, Right: c},
+				}}),
+				Height: height,
+			},
+			appHash: appHash,
+		},
+		{
+			name: "non-existence in another store",
+			path: "/store/staking/key",
+			resp: abci.ResponseQuery{
+				Key: []byte("b"),
+				ProofOps: proofOps("b", &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{
+					Nonexist: &ics23.NonExistenceProof{Key: []byte("b"), Left: a, Right: c},
+				}}),
+				Height: height,
+			},
+			appHash: appHash,
+			wantErr: true,
+		},
+		{
+			name: "untrusted app hash",
+			path: "/store/bank/key",
+			resp: abci.ResponseQuery{
+				Key:      []byte("a"),
+				Value:    []byte("1"),
+				ProofOps: proofOps("a", &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: a}}),
+				Height:   height,
+			},
+			appHash: storeRoot,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			next := &rpcmock.Client{}
+			next.On("ABCIQueryWithOptions", mock.Anything, tc.path, cmtbytes.HexBytes(tc.resp.Key),
+				rpcclient.ABCIQueryOptions{Prove: true}).
+				Return(&ctypes.ResultABCIQuery{Response: tc.resp}, nil)
+			lc := &lcmock.LightClient{}
+			lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(height+1), mock.Anything).
+				Return(&types.LightBlock{
+					SignedHeader: &types.SignedHeader{Header: &types.Header{Height: height + 1, AppHash: tc.appHash}},
+				}, nil)
+
+			c := NewClient(next, lc, KeyPathFn(DefaultMerkleKeyPathFn()))
+			c.RegisterOpDecoder(merkle.ProofOpICS23Simple, merkle.ICS23OpDecoder)
+
+			res, err := c.ABCIQuery(context.Background(), tc.path, tc.resp.Key)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.resp, res.Response)
+			next.AssertExpectations(t)
+			lc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestABCIQueryUnverifiedPath(t *testing.T) {
+	// the result isn't proven, and the proof isn't requested
+	opts := rpcclient.ABCIQueryOptions{Height: 5}
+	resp := abci.ResponseQuery{Value: []byte("v1.0.0"), Height: 5}
+	next := &rpcmock.Client{}
+	next.On("ABCIQueryWithOptions", mock.Anything, "/app/version", cmtbytes.HexBytes(nil), opts).
+		Return(&ctypes.ResultABCIQuery{Response: resp}, nil)
+	lc := &lcmock.LightClient{}
+
+	c := NewClient(next, lc, KeyPathFn(DefaultMerkleKeyPathFn()), VerifiedPaths("/store/"))
+	res, err := c.ABCIQueryWithOptions(context.Background(), "/app/version", nil, opts)
+	require.NoError(t, err)
+	assert.Equal(t, resp, res.Response)
+	next.AssertExpectations(t)
+	lc.AssertNotCalled(t, "VerifyLightBlockAtHeight", mock.Anything, mock.Anything, mock.Anything)
+
+	// the paths with a verified prefix still need a proof
+	next.On("ABCIQueryWithOptions", mock.Anything, "/store/bank/key", cmtbytes.HexBytes("a"),
+		rpcclient.ABCIQueryOptions{Height: 5, Prove: true}).
+		Return(&ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Key: []byte("a"), Value: []byte("1"), Height: 5}}, nil)
+	_, err = c.ABCIQueryWithOptions(context.Background(), "/store/bank/key", []byte("a"), opts)
+	require.Error(t, err)
+}
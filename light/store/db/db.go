@@ -181,6 +181,31 @@ func (s *dbs) FirstLightBlockHeight() (int64, error) {
 	return -1, itr.Error()
 }
 
+// LightBlockHeights returns the heights of the stored light blocks, in
+// ascending order.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *dbs) LightBlockHeights() ([]int64, error) {
+	itr, err := s.db.Iterator(
+		s.lbKey(1),
+		append(s.lbKey(1<<63-1), byte(0x00)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var heights []int64
+	for ; itr.Valid(); itr.Next() {
+		_, height, ok := parseLbKey(itr.Key())
+		if ok {
+			heights = append(heights, height)
+		}
+	}
+
+	return heights, itr.Error()
+}
+
 // LightBlockBefore iterates over light blocks until it finds a block before
 // the given height. It returns ErrLightBlockNotFound if no such block exists.
 //
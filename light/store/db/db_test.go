@@ -90,6 +90,28 @@ func Test_LightBlockBefore(t *testing.T) {
 	}
 }
 
+func Test_LightBlockHeights(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_LightBlockHeights")
+
+	// Empty store
+	heights, err := dbStore.LightBlockHeights()
+	require.NoError(t, err)
+	assert.Empty(t, heights)
+
+	// Heights are sorted numerically, not as strings
+	for _, height := range []int64{10, 2, 1, 100} {
+		err = dbStore.SaveLightBlock(randLightBlock(height))
+		require.NoError(t, err)
+	}
+	// Keys of another prefix are ignored
+	err = New(dbStore.(*dbs).db, "other").SaveLightBlock(randLightBlock(5))
+	require.NoError(t, err)
+
+	heights, err = dbStore.LightBlockHeights()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 10, 100}, heights)
+}
+
 func Test_Prune(t *testing.T) {
 	dbStore := New(dbm.NewMemDB(), "Test_Prune")
 
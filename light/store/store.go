@@ -34,6 +34,10 @@ type Store interface {
 	// If the store is empty, -1 and nil error are returned.
 	FirstLightBlockHeight() (int64, error)
 
+	// LightBlockHeights returns the heights of the stored LightBlocks, in
+	// ascending order.
+	LightBlockHeights() ([]int64, error)
+
 	// LightBlockBefore returns the LightBlock before a certain height.
 	//
 	// height must be > 0 && <= LastLightBlockHeight.
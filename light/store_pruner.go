@@ -0,0 +1,176 @@
+package light
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+)
+
+// PruningPolicy selects the light blocks pruned from the trusted store, in
+// addition to those beyond the pruning size (see PruningSize). A light block
+// is pruned if it's older than MaxAge or out of the HeightWindow, unless it's
+// a checkpoint. The latest light block is never pruned.
+type PruningPolicy struct {
+	// Maximum age of the light blocks, as a multiple of the trusting period,
+	// e.g. 2 keeps the light blocks of the last two trusting periods. 0
+	// disables the pruning by age.
+	MaxAge float64
+	// Number of the latest heights whose light blocks are kept. 0 disables
+	// the pruning by height.
+	HeightWindow int64
+	// Interval between the heights of the checkpoints, the light blocks kept
+	// whatever their age and height. 0 disables the checkpoints.
+	CheckpointInterval int64
+}
+
+// ValidateBasic performs basic validation.
+func (p PruningPolicy) ValidateBasic() error {
+	if p.MaxAge < 0 {
+		return errors.New("negative max age")
+	}
+	if p.HeightWindow < 0 {
+		return errors.New("negative height window")
+	}
+	if p.CheckpointInterval < 0 {
+		return errors.New("negative checkpoint interval")
+	}
+	return nil
+}
+
+// IsEnabled returns true if the policy prunes light blocks.
+func (p PruningPolicy) IsEnabled() bool {
+	return p.MaxAge > 0 || p.HeightWindow > 0
+}
+
+// PruneStore prunes the light blocks of the trusted store selected by policy
+// at time now, and returns the number of light blocks pruned.
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) PruneStore(policy PruningPolicy, now time.Time) (int, error) {
+	if err := policy.ValidateBasic(); err != nil {
+		return 0, fmt.Errorf("invalid pruning policy: %w", err)
+	}
+	if !policy.IsEnabled() {
+		return 0, nil
+	}
+
+	heights, err := c.trustedStore.LightBlockHeights()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list light blocks: %w", err)
+	}
+	if len(heights) < 2 {
+		return 0, nil
+	}
+	latestHeight := heights[len(heights)-1]
+	maxAge := time.Duration(policy.MaxAge * float64(c.trustingPeriod))
+
+	pruned := 0
+	for _, height := range heights[:len(heights)-1] {
+		if policy.CheckpointInterval > 0 && height%policy.CheckpointInterval == 0 {
+			continue
+		}
+
+		expired := policy.HeightWindow > 0 && height <= latestHeight-policy.HeightWindow
+		if !expired && maxAge > 0 {
+			l, err := c.trustedStore.LightBlock(height)
+			if err != nil {
+				return pruned, fmt.Errorf("failed to load light block #%d: %w", height, err)
+			}
+			expired = l.Time.Add(maxAge).Before(now)
+		}
+		// the light blocks are ordered by height and time, so none of the next
+		// ones is expired either
+		if !expired {
+			break
+		}
+
+		if err := c.trustedStore.DeleteLightBlock(height); err != nil {
+			return pruned, fmt.Errorf("failed to delete light block #%d: %w", height, err)
+		}
+		pruned++
+	}
+
+	return pruned, nil
+}
+
+// StorePruner prunes, in the background, the trusted store of a light client
+// according to a PruningPolicy, and reports the size of the store in the
+// metrics.
+type StorePruner struct {
+	service.BaseService
+
+	c        *Client
+	policy   PruningPolicy
+	interval time.Duration
+	metrics  *Metrics
+
+	quit chan struct{}
+	done chan struct{}
+}
+
+// NewStorePruner returns a new service instance, pruning the trusted store of
+// c according to policy every interval.
+func NewStorePruner(c *Client, policy PruningPolicy, interval time.Duration, metrics *Metrics) *StorePruner {
+	sp := &StorePruner{
+		c:        c,
+		policy:   policy,
+		interval: interval,
+		metrics:  metrics,
+	}
+	sp.BaseService = *service.NewBaseService(nil, "LightStorePruner", sp)
+	return sp
+}
+
+// OnStart implements service.Service by starting to prune in the background.
+func (sp *StorePruner) OnStart() error {
+	if err := sp.policy.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid pruning policy: %w", err)
+	}
+	sp.quit = make(chan struct{})
+	sp.done = make(chan struct{})
+
+	go func() {
+		defer close(sp.done)
+
+		ticker := time.NewTicker(sp.interval)
+		defer ticker.Stop()
+
+		for {
+			sp.prune(time.Now())
+
+			select {
+			case <-sp.quit:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// OnStop implements service.Service by stopping the pruning.
+func (sp *StorePruner) OnStop() {
+	close(sp.quit)
+	<-sp.done
+}
+
+func (sp *StorePruner) prune(now time.Time) {
+	pruned, err := sp.c.PruneStore(sp.policy, now)
+	if pruned > 0 {
+		sp.metrics.StorePrunedBlocks.Add(float64(pruned))
+		sp.Logger.Info("pruned trusted store", "pruned", pruned)
+	}
+	if err != nil {
+		sp.Logger.Error("failed to prune trusted store", "err", err)
+	}
+
+	sp.metrics.StoreSize.Set(float64(sp.c.trustedStore.Size()))
+	if height, err := sp.c.trustedStore.FirstLightBlockHeight(); err == nil {
+		sp.metrics.StoreFirstHeight.Set(float64(height))
+	}
+	if height, err := sp.c.trustedStore.LastLightBlockHeight(); err == nil {
+		sp.metrics.StoreLastHeight.Set(float64(height))
+	}
+}
@@ -0,0 +1,74 @@
+package light_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	dbs "github.com/cometbft/cometbft/light/store/db"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestClientPruneStore(t *testing.T) {
+	// light blocks at heights 1 to 10, one per minute after bTime
+	_, headers, vals := genMockNode(chainID, 10, 3, 0, bTime)
+
+	testCases := []struct {
+		name    string
+		policy  light.PruningPolicy
+		now     time.Time
+		heights []int64
+	}{
+		{"disabled", light.PruningPolicy{}, bTime, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"checkpoints only", light.PruningPolicy{CheckpointInterval: 5}, bTime, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"height window", light.PruningPolicy{HeightWindow: 4}, bTime, []int64{7, 8, 9, 10}},
+		{"height window with checkpoints", light.PruningPolicy{HeightWindow: 4, CheckpointInterval: 5},
+			bTime, []int64{5, 7, 8, 9, 10}},
+		// a quarter of the trusting period is an hour
+		{"max age", light.PruningPolicy{MaxAge: 0.25}, bTime.Add(65 * time.Minute), []int64{5, 6, 7, 8, 9, 10}},
+		{"max age or height window", light.PruningPolicy{MaxAge: 0.25, HeightWindow: 8},
+			bTime.Add(62 * time.Minute), []int64{3, 4, 5, 6, 7, 8, 9, 10}},
+		{"max age keeps latest", light.PruningPolicy{MaxAge: 0.25}, bTime.Add(24 * time.Hour), []int64{10}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			trustedStore := dbs.New(dbm.NewMemDB(), chainID)
+			for height := int64(1); height <= 10; height++ {
+				err := trustedStore.SaveLightBlock(&types.LightBlock{
+					SignedHeader: headers[height],
+					ValidatorSet: vals[height],
+				})
+				require.NoError(t, err)
+			}
+
+			c, err := light.NewClientFromTrustedStore(
+				chainID,
+				trustPeriod,
+				deadNode,
+				[]provider.Provider{deadNode},
+				trustedStore,
+			)
+			require.NoError(t, err)
+
+			pruned, err := c.PruneStore(tc.policy, tc.now)
+			require.NoError(t, err)
+			assert.Equal(t, 10-len(tc.heights), pruned)
+
+			heights, err := trustedStore.LightBlockHeights()
+			require.NoError(t, err)
+			assert.Equal(t, tc.heights, heights)
+			assert.EqualValues(t, len(tc.heights), trustedStore.Size())
+		})
+	}
+
+	_, err := (&light.Client{}).PruneStore(light.PruningPolicy{HeightWindow: -1}, bTime)
+	assert.Error(t, err)
+}
@@ -52,16 +52,23 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "recheck_times",
 			Help:      "Number of times transactions are rechecked in the mempool.",
 		}, labels).With(labelsAndValues...),
+		PolicyRejectedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "policy_rejected_txs",
+			Help:      "Number of transactions rejected by the admission policy before CheckTx, by rejection reason and source type (peer or rpc).",
+		}, append(labels, "reason", "source")).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Size:         discard.NewGauge(),
-		TxSizeBytes:  discard.NewHistogram(),
-		FailedTxs:    discard.NewCounter(),
-		RejectedTxs:  discard.NewCounter(),
-		EvictedTxs:   discard.NewCounter(),
-		RecheckTimes: discard.NewCounter(),
+		Size:              discard.NewGauge(),
+		TxSizeBytes:       discard.NewHistogram(),
+		FailedTxs:         discard.NewCounter(),
+		RejectedTxs:       discard.NewCounter(),
+		EvictedTxs:        discard.NewCounter(),
+		RecheckTimes:      discard.NewCounter(),
+		PolicyRejectedTxs: discard.NewCounter(),
 	}
 }
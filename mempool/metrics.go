@@ -40,4 +40,8 @@ type Metrics struct {
 
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
+
+	// Number of transactions rejected by the admission policy before
+	// CheckTx, by rejection reason and source type (peer or rpc).
+	PolicyRejectedTxs metrics.Counter `metrics_labels:"reason, source"`
 }
@@ -0,0 +1,287 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Reasons reported by ErrRejectedByPolicy. They are also used as the "reason"
+// label of the PolicyRejectedTxs metric.
+const (
+	PolicyReasonRateLimited = "rate_limited"
+	PolicyReasonSenderCap   = "sender_cap"
+)
+
+// Source types reported by ErrRejectedByPolicy and used as the "source" label
+// of the PolicyRejectedTxs metric.
+const (
+	TxSourcePeer = "peer"
+	TxSourceRPC  = "rpc"
+)
+
+// maxIdleBuckets is the number of token buckets kept before full (i.e. idle)
+// buckets are garbage collected.
+const maxIdleBuckets = 10000
+
+// AdmissionPolicy decides whether a transaction coming from a given peer or
+// RPC client may be passed to the application's CheckTx. Implementations must
+// be safe for concurrent use.
+type AdmissionPolicy interface {
+	// Admit is called for every new (i.e. not cached) transaction before
+	// CheckTx. It returns an ErrRejectedByPolicy if tx must be dropped.
+	Admit(tx types.Tx, txInfo TxInfo) error
+
+	// Added is called once a transaction admitted with txInfo made it into
+	// the mempool.
+	Added(txKey types.TxKey, txInfo TxInfo)
+
+	// Removed is called whenever a transaction leaves the mempool, be it
+	// because it was committed, invalidated, evicted or flushed, and when an
+	// admitted transaction doesn't make it into the mempool, e.g. because
+	// CheckTx rejected it.
+	Removed(txKey types.TxKey)
+}
+
+// NopAdmissionPolicy admits every transaction.
+type NopAdmissionPolicy struct{}
+
+var _ AdmissionPolicy = NopAdmissionPolicy{}
+
+func (NopAdmissionPolicy) Admit(types.Tx, TxInfo) error { return nil }
+func (NopAdmissionPolicy) Added(types.TxKey, TxInfo)    {}
+func (NopAdmissionPolicy) Removed(types.TxKey)          {}
+
+// ErrRejectedByPolicy is returned by CheckTx when the mempool's admission
+// policy refuses a transaction, e.g. because its sender exceeded its rate
+// limit.
+type ErrRejectedByPolicy struct {
+	Reason string // one of the PolicyReason* constants
+	Source string // one of the TxSource* constants
+	Sender string // peer ID or RPC client host
+}
+
+func (e ErrRejectedByPolicy) Error() string {
+	return fmt.Sprintf("tx rejected by mempool policy: %s (%s %s)", e.Reason, e.Source, e.Sender)
+}
+
+// IsRejectedByPolicy returns true if err is due to the admission policy.
+func IsRejectedByPolicy(err error) bool {
+	return errors.As(err, &ErrRejectedByPolicy{})
+}
+
+var _ AdmissionPolicy = (*RateLimitPolicy)(nil)
+
+// RateLimitPolicy is an AdmissionPolicy that limits, per peer and per RPC
+// client:
+//
+//   - the rate at which transactions are accepted, using a token bucket where
+//     each transaction costs a number of tokens that depends on its size class;
+//   - the number of transactions that may sit in the mempool at once. A slot
+//     is reserved when a transaction is admitted, so that transactions
+//     waiting for CheckTx count against the cap too.
+//
+// Transactions with neither a peer nor an RPC sender (e.g. submitted
+// in-process) are never limited.
+type RateLimitPolicy struct {
+	peerRate, peerBurst float64
+	rpcRate, rpcBurst   float64
+	maxTxsPerSender     int
+	sizeClasses         []int
+	metrics             *Metrics
+
+	mtx       cmtsync.Mutex
+	buckets   map[string]*tokenBucket
+	senderTxs map[string]int         // sender -> number of txs in the mempool
+	txSenders map[types.TxKey]string // tx -> sender it was accounted to
+	now       func() time.Time
+}
+
+// NewRateLimitPolicy returns a RateLimitPolicy configured from cfg.
+func NewRateLimitPolicy(cfg *config.MempoolConfig, metrics *Metrics) *RateLimitPolicy {
+	if metrics == nil {
+		metrics = NopMetrics()
+	}
+	return &RateLimitPolicy{
+		peerRate:        cfg.PeerRateLimit,
+		peerBurst:       float64(cfg.PeerRateBurst),
+		rpcRate:         cfg.RPCRateLimit,
+		rpcBurst:        float64(cfg.RPCRateBurst),
+		maxTxsPerSender: cfg.MaxTxsPerSender,
+		sizeClasses:     cfg.TxSizeClasses,
+		metrics:         metrics,
+		buckets:         make(map[string]*tokenBucket),
+		senderTxs:       make(map[string]int),
+		txSenders:       make(map[types.TxKey]string),
+		now:             time.Now,
+	}
+}
+
+// Admit implements AdmissionPolicy.
+func (p *RateLimitPolicy) Admit(tx types.Tx, txInfo TxInfo) error {
+	source, sender := txSender(txInfo)
+	if source == "" {
+		return nil
+	}
+
+	rate, burst := p.peerRate, p.peerBurst
+	if source == TxSourceRPC {
+		rate, burst = p.rpcRate, p.rpcBurst
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	key := source + "/" + sender
+	_, reserved := p.txSenders[tx.Key()]
+	if p.maxTxsPerSender > 0 && !reserved && p.senderTxs[key] >= p.maxTxsPerSender {
+		return p.reject(PolicyReasonSenderCap, source, sender)
+	}
+
+	if rate > 0 {
+		now := p.now()
+		b, ok := p.buckets[key]
+		if !ok {
+			p.gcBuckets(now)
+			b = &tokenBucket{tokens: burst, last: now}
+			p.buckets[key] = b
+		}
+		if !b.take(p.txCost(len(tx), burst), rate, burst, now) {
+			return p.reject(PolicyReasonRateLimited, source, sender)
+		}
+	}
+
+	// reserve a slot until the tx is added, or released by Removed if it
+	// isn't
+	if p.maxTxsPerSender > 0 && !reserved {
+		p.txSenders[tx.Key()] = key
+		p.senderTxs[key]++
+	}
+	return nil
+}
+
+// Added implements AdmissionPolicy. The tx is normally accounted for already,
+// since Admit reserved its slot.
+func (p *RateLimitPolicy) Added(txKey types.TxKey, txInfo TxInfo) {
+	if p.maxTxsPerSender == 0 {
+		return
+	}
+	source, sender := txSender(txInfo)
+	if source == "" {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.txSenders[txKey]; ok {
+		return
+	}
+	key := source + "/" + sender
+	p.txSenders[txKey] = key
+	p.senderTxs[key]++
+}
+
+// Removed implements AdmissionPolicy.
+func (p *RateLimitPolicy) Removed(txKey types.TxKey) {
+	if p.maxTxsPerSender == 0 {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	key, ok := p.txSenders[txKey]
+	if !ok {
+		return
+	}
+	delete(p.txSenders, txKey)
+	if p.senderTxs[key] <= 1 {
+		delete(p.senderTxs, key)
+	} else {
+		p.senderTxs[key]--
+	}
+}
+
+// txCost returns the number of tokens a tx of the given size costs, capped to
+// burst so that large txs can still get through an otherwise idle bucket.
+func (p *RateLimitPolicy) txCost(size int, burst float64) float64 {
+	cost := 1
+	for _, limit := range p.sizeClasses {
+		if size <= limit {
+			break
+		}
+		cost++
+	}
+	return math.Min(float64(cost), burst)
+}
+
+// gcBuckets drops buckets that have been refilled completely once too many are
+// tracked. A full bucket carries no state, so dropping it is lossless.
+// The caller must hold p.mtx.
+func (p *RateLimitPolicy) gcBuckets(now time.Time) {
+	if len(p.buckets) < maxIdleBuckets {
+		return
+	}
+	for key, b := range p.buckets {
+		rate, burst := p.peerRate, p.peerBurst
+		if strings.HasPrefix(key, TxSourceRPC+"/") {
+			rate, burst = p.rpcRate, p.rpcBurst
+		}
+		if b.tokens+now.Sub(b.last).Seconds()*rate >= burst {
+			delete(p.buckets, key)
+		}
+	}
+}
+
+// The caller must hold p.mtx.
+func (p *RateLimitPolicy) reject(reason, source, sender string) error {
+	p.metrics.PolicyRejectedTxs.With("reason", reason, "source", source).Add(1)
+	return ErrRejectedByPolicy{Reason: reason, Source: source, Sender: sender}
+}
+
+// txSender returns the source type and identity of the sender of a tx. RPC
+// clients are identified by host only, so that a client cannot escape its
+// limits by opening new connections.
+func txSender(txInfo TxInfo) (source, sender string) {
+	switch {
+	case txInfo.SenderP2PID != "":
+		return TxSourcePeer, string(txInfo.SenderP2PID)
+	case txInfo.SenderRPCAddr != "":
+		host, _, err := net.SplitHostPort(txInfo.SenderRPCAddr)
+		if err != nil {
+			host = txInfo.SenderRPCAddr
+		}
+		return TxSourceRPC, host
+	default:
+		return "", ""
+	}
+}
+
+// tokenBucket is a simple token bucket. It is not thread safe.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills the bucket according to the time elapsed since the last call
+// and removes n tokens from it. It returns false, leaving the bucket
+// untouched apart from the refill, if fewer than n tokens are available.
+func (b *tokenBucket) take(n, rate, burst float64, now time.Time) bool {
+	if now.After(b.last) {
+		b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+		b.last = now
+	}
+	if b.tokens < n {
+		return false
+	}
+	b.tokens -= n
+	return true
+}
@@ -0,0 +1,146 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/types"
+)
+
+func newTestPolicy(cfg *config.MempoolConfig) (*RateLimitPolicy, *time.Time) {
+	now := time.Now()
+	p := NewRateLimitPolicy(cfg, nil)
+	p.now = func() time.Time { return now }
+	return p, &now
+}
+
+func TestRateLimitPolicyPeerRate(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.PeerRateLimit = 1
+	cfg.PeerRateBurst = 2
+	p, now := newTestPolicy(cfg)
+
+	peerA := TxInfo{SenderID: 1, SenderP2PID: "a"}
+	peerB := TxInfo{SenderID: 2, SenderP2PID: "b"}
+	tx := types.Tx("tx")
+
+	require.NoError(t, p.Admit(tx, peerA))
+	require.NoError(t, p.Admit(tx, peerA))
+
+	err := p.Admit(tx, peerA)
+	require.Error(t, err)
+	assert.True(t, IsRejectedByPolicy(err))
+	assert.Equal(t, PolicyReasonRateLimited, err.(ErrRejectedByPolicy).Reason)
+
+	// other peers have their own bucket
+	require.NoError(t, p.Admit(tx, peerB))
+
+	// RPC clients and local txs are not affected by the peer limit
+	for i := 0; i < 10; i++ {
+		require.NoError(t, p.Admit(tx, TxInfo{SenderRPCAddr: "127.0.0.1:1234"}))
+		require.NoError(t, p.Admit(tx, TxInfo{}))
+	}
+
+	// the bucket refills over time
+	*now = now.Add(time.Second)
+	require.NoError(t, p.Admit(tx, peerA))
+	require.Error(t, p.Admit(tx, peerA))
+}
+
+func TestRateLimitPolicyRPCClientByHost(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.RPCRateLimit = 1
+	cfg.RPCRateBurst = 1
+	p, _ := newTestPolicy(cfg)
+
+	tx := types.Tx("tx")
+	require.NoError(t, p.Admit(tx, TxInfo{SenderRPCAddr: "10.0.0.1:1000"}))
+	// a new connection from the same host shares the bucket
+	err := p.Admit(tx, TxInfo{SenderRPCAddr: "10.0.0.1:1001"})
+	require.Error(t, err)
+	assert.Equal(t, TxSourceRPC, err.(ErrRejectedByPolicy).Source)
+	require.NoError(t, p.Admit(tx, TxInfo{SenderRPCAddr: "10.0.0.2:1000"}))
+}
+
+func TestRateLimitPolicySizeClasses(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.PeerRateLimit = 1
+	cfg.PeerRateBurst = 3
+	cfg.TxSizeClasses = []int{10, 100}
+	p, _ := newTestPolicy(cfg)
+
+	assert.EqualValues(t, 1, p.txCost(10, 3))
+	assert.EqualValues(t, 2, p.txCost(11, 3))
+	assert.EqualValues(t, 3, p.txCost(1000, 3))
+	assert.EqualValues(t, 2, p.txCost(1000, 2))
+
+	peer := TxInfo{SenderID: 1, SenderP2PID: "a"}
+	require.NoError(t, p.Admit(make(types.Tx, 50), peer)) // 2 tokens
+	require.Error(t, p.Admit(make(types.Tx, 50), peer))
+	require.NoError(t, p.Admit(make(types.Tx, 5), peer)) // 1 token
+}
+
+func TestRateLimitPolicySenderCap(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.MaxTxsPerSender = 2
+	p, _ := newTestPolicy(cfg)
+
+	peer := TxInfo{SenderID: 1, SenderP2PID: "a"}
+	txs := []types.Tx{types.Tx("a"), types.Tx("b"), types.Tx("c")}
+
+	for _, tx := range txs[:2] {
+		require.NoError(t, p.Admit(tx, peer))
+		p.Added(tx.Key(), peer)
+	}
+	// adding the same tx twice must not count twice
+	p.Added(txs[1].Key(), peer)
+
+	err := p.Admit(txs[2], peer)
+	require.Error(t, err)
+	assert.Equal(t, PolicyReasonSenderCap, err.(ErrRejectedByPolicy).Reason)
+
+	p.Removed(txs[0].Key())
+	require.NoError(t, p.Admit(txs[2], peer))
+	p.Added(txs[2].Key(), peer)
+
+	p.Removed(txs[1].Key())
+	p.Removed(txs[2].Key())
+	assert.Empty(t, p.senderTxs)
+	assert.Empty(t, p.txSenders)
+}
+
+func TestRateLimitPolicySenderCapReservesSlots(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.MaxTxsPerSender = 2
+	p, _ := newTestPolicy(cfg)
+
+	peer := TxInfo{SenderID: 1, SenderP2PID: "a"}
+	txs := []types.Tx{types.Tx("a"), types.Tx("b"), types.Tx("c")}
+
+	// txs waiting for CheckTx count against the cap
+	require.NoError(t, p.Admit(txs[0], peer))
+	require.NoError(t, p.Admit(txs[1], peer))
+	err := p.Admit(txs[2], peer)
+	require.Error(t, err)
+	assert.Equal(t, PolicyReasonSenderCap, err.(ErrRejectedByPolicy).Reason)
+
+	// admitting a tx twice doesn't reserve a second slot
+	require.NoError(t, p.Admit(txs[1], peer))
+
+	// CheckTx rejected the first tx
+	p.Removed(txs[0].Key())
+	require.NoError(t, p.Admit(txs[2], peer))
+
+	p.Added(txs[1].Key(), peer)
+	p.Added(txs[2].Key(), peer)
+	assert.Equal(t, 2, p.senderTxs["peer/a"])
+
+	p.Removed(txs[1].Key())
+	p.Removed(txs[2].Key())
+	assert.Empty(t, p.senderTxs)
+	assert.Empty(t, p.txSenders)
+}
@@ -14,4 +14,8 @@ type TxInfo struct {
 
 	// SenderP2PID is the actual p2p.ID of the sender, used e.g. for logging.
 	SenderP2PID p2p.ID
+
+	// SenderRPCAddr is the remote address of the RPC client that submitted the
+	// tx. It is empty for txs received from peers.
+	SenderRPCAddr string
 }
@@ -13,7 +13,6 @@ import (
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/mempool"
-	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
 )
@@ -39,6 +38,7 @@ type CListMempool struct {
 	updateMtx cmtsync.RWMutex
 	preCheck  mempool.PreCheckFunc
 	postCheck mempool.PostCheckFunc
+	policy    mempool.AdmissionPolicy
 
 	txs          *clist.CList // concurrent linked-list of good txs
 	proxyAppConn proxy.AppConnMempool
@@ -84,6 +84,7 @@ func NewCListMempool(
 		recheckEnd:    nil,
 		logger:        log.NewNopLogger(),
 		metrics:       mempool.NopMetrics(),
+		policy:        mempool.NopAdmissionPolicy{},
 	}
 
 	if cfg.CacheSize > 0 {
@@ -130,6 +131,12 @@ func WithMetrics(metrics *mempool.Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithAdmissionPolicy sets the policy deciding whether a tx from a given
+// sender may be passed to the application's CheckTx.
+func WithAdmissionPolicy(policy mempool.AdmissionPolicy) CListMempoolOption {
+	return func(mem *CListMempool) { mem.policy = policy }
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
 	mem.updateMtx.Lock()
@@ -166,6 +173,7 @@ func (mem *CListMempool) Flush() {
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		mem.txs.Remove(e)
 		e.DetachPrev()
+		mem.policy.Removed(e.Value.(*mempoolTx).tx.Key())
 	}
 
 	mem.txsMap.Range(func(key, _ interface{}) bool {
@@ -251,8 +259,14 @@ func (mem *CListMempool) CheckTx(
 		return mempool.ErrTxInCache
 	}
 
+	if err := mem.policy.Admit(tx, txInfo); err != nil {
+		// remove from cache (the sender may be allowed to send it later)
+		mem.cache.Remove(tx)
+		return err
+	}
+
 	reqRes := mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{Tx: tx})
-	reqRes.SetCallback(mem.reqResCb(tx, txInfo.SenderID, txInfo.SenderP2PID, cb))
+	reqRes.SetCallback(mem.reqResCb(tx, txInfo, cb))
 
 	return nil
 }
@@ -289,8 +303,7 @@ func (mem *CListMempool) globalCb(req *abci.Request, res *abci.Response) {
 // Used in CheckTx to record PeerID who sent us the tx.
 func (mem *CListMempool) reqResCb(
 	tx []byte,
-	peerID uint16,
-	peerP2PID p2p.ID,
+	txInfo mempool.TxInfo,
 	externalCb func(*abci.Response),
 ) func(res *abci.Response) {
 	return func(res *abci.Response) {
@@ -299,7 +312,7 @@ func (mem *CListMempool) reqResCb(
 			panic("recheck cursor is not nil in reqResCb")
 		}
 
-		mem.resCbFirstTime(tx, peerID, peerP2PID, res)
+		mem.resCbFirstTime(tx, txInfo, res)
 
 		// update metrics
 		mem.metrics.Size.Set(float64(mem.Size()))
@@ -328,6 +341,7 @@ func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromC
 	elem.DetachPrev()
 	mem.txsMap.Delete(tx.Key())
 	atomic.AddInt64(&mem.txsBytes, int64(-len(tx)))
+	mem.policy.Removed(tx.Key())
 
 	if removeFromCache {
 		mem.cache.Remove(tx)
@@ -371,8 +385,7 @@ func (mem *CListMempool) isFull(txSize int) error {
 // handled by the resCbRecheck callback.
 func (mem *CListMempool) resCbFirstTime(
 	tx []byte,
-	peerID uint16,
-	peerP2PID p2p.ID,
+	txInfo mempool.TxInfo,
 	res *abci.Response,
 ) {
 	switch r := res.Value.(type) {
@@ -387,6 +400,7 @@ func (mem *CListMempool) resCbFirstTime(
 			if err := mem.isFull(len(tx)); err != nil {
 				// remove from cache (mempool might have a space later)
 				mem.cache.Remove(tx)
+				mem.policy.Removed(types.Tx(tx).Key())
 				mem.logger.Error(err.Error())
 				return
 			}
@@ -396,8 +410,9 @@ func (mem *CListMempool) resCbFirstTime(
 				gasWanted: r.CheckTx.GasWanted,
 				tx:        tx,
 			}
-			memTx.senders.Store(peerID, true)
+			memTx.senders.Store(txInfo.SenderID, true)
 			mem.addTx(memTx)
+			mem.policy.Added(memTx.tx.Key(), txInfo)
 			mem.logger.Debug(
 				"added good transaction",
 				"tx", types.Tx(tx).Hash(),
@@ -411,11 +426,12 @@ func (mem *CListMempool) resCbFirstTime(
 			mem.logger.Debug(
 				"rejected bad transaction",
 				"tx", types.Tx(tx).Hash(),
-				"peerID", peerP2PID,
+				"peerID", txInfo.SenderP2PID,
 				"res", r,
 				"err", postCheckErr,
 			)
 			mem.metrics.FailedTxs.Add(1)
+			mem.policy.Removed(types.Tx(tx).Key())
 
 			if !mem.config.KeepInvalidTxsInCache {
 				// remove from cache (it might be good later)
@@ -40,7 +40,8 @@ type TxMempool struct {
 	config       *config.MempoolConfig
 	proxyAppConn proxy.AppConnMempool
 	metrics      *mempool.Metrics
-	cache        mempool.TxCache // seen transactions
+	cache        mempool.TxCache         // seen transactions
+	policy       mempool.AdmissionPolicy // per-sender admission limits
 
 	// Atomically-updated fields
 	txsBytes int64 // atomic: the total size of all transactions in the mempool, in bytes
@@ -74,6 +75,7 @@ func NewTxMempool(
 		proxyAppConn: proxyAppConn,
 		metrics:      mempool.NopMetrics(),
 		cache:        mempool.NopTxCache{},
+		policy:       mempool.NopAdmissionPolicy{},
 		txs:          clist.New(),
 		mtx:          new(sync.RWMutex),
 		height:       height,
@@ -110,6 +112,12 @@ func WithMetrics(metrics *mempool.Metrics) TxMempoolOption {
 	return func(txmp *TxMempool) { txmp.metrics = metrics }
 }
 
+// WithAdmissionPolicy sets the policy deciding whether a transaction from a
+// given sender may be passed to the application's CheckTx.
+func WithAdmissionPolicy(policy mempool.AdmissionPolicy) TxMempoolOption {
+	return func(txmp *TxMempool) { txmp.policy = policy }
+}
+
 // Lock obtains a write-lock on the mempool. A caller must be sure to explicitly
 // release the lock when finished.
 func (txmp *TxMempool) Lock() { txmp.mtx.Lock() }
@@ -163,6 +171,7 @@ func (txmp *TxMempool) TxsAvailable() <-chan struct{} { return txmp.txsAvailable
 // - The size of tx exceeds the configured maximum transaction size.
 // - The pre-check hook is defined and reports an error for tx.
 // - The transaction already exists in the cache.
+// - The admission policy rejects the transaction for its sender.
 // - The proxy connection to the application fails.
 //
 // If tx passes all of the above conditions, it is passed (asynchronously) to
@@ -212,6 +221,12 @@ func (txmp *TxMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo memp
 			}
 			return 0, mempool.ErrTxInCache
 		}
+
+		// Check the sender's limits now that we know the transaction is new.
+		if err := txmp.policy.Admit(tx, txInfo); err != nil {
+			txmp.cache.Remove(tx)
+			return 0, err
+		}
 		return txmp.height, nil
 	}()
 	if err != nil {
@@ -222,6 +237,7 @@ func (txmp *TxMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo memp
 	rsp, err := txmp.proxyAppConn.CheckTxSync(abci.RequestCheckTx{Tx: tx})
 	if err != nil {
 		txmp.cache.Remove(tx)
+		txmp.policy.Removed(tx.Key())
 		return err
 	}
 	wtx := &WrappedTx{
@@ -231,7 +247,7 @@ func (txmp *TxMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo memp
 		height:    height,
 	}
 	wtx.SetPeer(txInfo.SenderID)
-	txmp.addNewTransaction(wtx, rsp)
+	txmp.addNewTransaction(wtx, txInfo, rsp)
 	if cb != nil {
 		cb(&abci.Response{Value: &abci.Response_CheckTx{CheckTx: rsp}})
 	}
@@ -258,6 +274,7 @@ func (txmp *TxMempool) removeTxByKey(key types.TxKey) error {
 		elt.DetachPrev()
 		elt.DetachNext()
 		atomic.AddInt64(&txmp.txsBytes, -w.Size())
+		txmp.policy.Removed(key)
 		return nil
 	}
 	return fmt.Errorf("transaction %x not found", key)
@@ -273,6 +290,7 @@ func (txmp *TxMempool) removeTxByElement(elt *clist.CElement) {
 	elt.DetachPrev()
 	elt.DetachNext()
 	atomic.AddInt64(&txmp.txsBytes, -w.Size())
+	txmp.policy.Removed(w.tx.Key())
 }
 
 // Flush purges the contents of the mempool and the cache, leaving both empty.
@@ -445,7 +463,7 @@ func (txmp *TxMempool) Update(
 // transactions are evicted.
 //
 // Finally, the new transaction is added and size stats updated.
-func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.ResponseCheckTx) {
+func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, txInfo mempool.TxInfo, checkTxRes *abci.ResponseCheckTx) {
 	txmp.mtx.Lock()
 	defer txmp.mtx.Unlock()
 
@@ -465,6 +483,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 		)
 
 		txmp.metrics.FailedTxs.Add(1)
+		txmp.policy.Removed(wtx.tx.Key())
 
 		// Remove the invalid transaction from the cache, unless the operator has
 		// instructed us to keep invalid transactions.
@@ -499,6 +518,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 				fmt.Sprintf("rejected valid incoming transaction; tx already exists for sender %q (%X)",
 					sender, w.tx.Hash())
 			txmp.metrics.RejectedTxs.Add(1)
+			txmp.policy.Removed(wtx.tx.Key())
 			return
 		}
 	}
@@ -534,6 +554,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 				fmt.Sprintf("rejected valid incoming transaction; mempool is full (%X)",
 					wtx.tx.Hash())
 			txmp.metrics.RejectedTxs.Add(1)
+			txmp.policy.Removed(wtx.tx.Key())
 			return
 		}
 
@@ -580,6 +601,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 	wtx.SetPriority(priority)
 	wtx.SetSender(sender)
 	txmp.insertTx(wtx)
+	txmp.policy.Added(wtx.tx.Key(), txInfo)
 
 	txmp.metrics.TxSizeBytes.Observe(float64(wtx.Size()))
 	txmp.metrics.Size.Set(float64(txmp.Size()))
@@ -175,6 +175,8 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			err = memR.mempool.CheckTx(ntx, nil, txInfo)
 			if errors.Is(err, mempool.ErrTxInCache) {
 				memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
+			} else if mempool.IsRejectedByPolicy(err) {
+				memR.Logger.Debug("Tx rejected by admission policy", "tx", ntx.String(), "err", err)
 			} else if err != nil {
 				memR.Logger.Info("Could not check tx", "tx", ntx.String(), "err", err)
 			}
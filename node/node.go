@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -22,9 +23,12 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/evidence"
 
+	auto "github.com/cometbft/cometbft/libs/autofile"
+	"github.com/cometbft/cometbft/libs/eventlog"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/light"
 	mempl "github.com/cometbft/cometbft/mempool"
@@ -42,6 +46,7 @@ import (
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
 	"github.com/cometbft/cometbft/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlite"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/kv"
 	"github.com/cometbft/cometbft/state/txindex/null"
@@ -169,6 +174,9 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 					if !ni.HasChannel(chDesc.ID) {
 						ni.Channels = append(ni.Channels, chDesc.ID)
 						n.transport.AddChannel(chDesc.ID)
+						if n.quicTransport != nil {
+							n.quicTransport.AddChannel(chDesc.ID)
+						}
 					}
 				}
 				n.nodeInfo = ni
@@ -201,15 +209,19 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *p2p.MultiplexTransport
-	sw          *p2p.Switch  // p2p connections
-	addrBook    pex.AddrBook // known peers
-	nodeInfo    p2p.NodeInfo
-	nodeKey     *p2p.NodeKey // our node privkey
-	isListening bool
+	transport     *p2p.MultiplexTransport
+	quicTransport *p2p.QUICTransport  // nil unless p2p.quic_laddr is set
+	sw            *p2p.Switch         // p2p connections
+	capture       *p2p.MessageCapture // nil unless p2p.capture is set
+	addrBook      pex.AddrBook        // known peers
+	addrBookDB    dbm.DB              // where addrBook is saved
+	nodeInfo      p2p.NodeInfo
+	nodeKey       *p2p.NodeKey // our node privkey
+	isListening   bool
 
 	// services
 	eventBus          *types.EventBus // pub/sub for services
+	eventLog          *eventlog.Log   // recent events, nil if disabled
 	stateStore        sm.Store
 	blockStore        *store.BlockStore // store the blockchain to disk
 	bcReactor         p2p.Reactor       // for block-syncing
@@ -228,6 +240,7 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	indexerPruner     *txindex.PrunerService // nil unless tx_index.retain_blocks is set
 	prometheusSrv     *http.Server
 }
 
@@ -265,6 +278,46 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	return eventBus, nil
 }
 
+// createAndStartEventLog returns the event log of the recent block and tx
+// events, fed from the event bus until the bus stops, or nil if it is
+// disabled.
+func createAndStartEventLog(
+	config *cfg.Config,
+	eventBus *types.EventBus,
+	logger log.Logger,
+) (*eventlog.Log, error) {
+	if config.RPC.EventLogWindowSize == 0 {
+		return nil, nil
+	}
+	eventLog, err := eventlog.New(config.RPC.EventLogWindowSize, config.RPC.EventLogMaxItems)
+	if err != nil {
+		return nil, err
+	}
+
+	// unbuffered, so that no event is dropped
+	sub, err := eventBus.SubscribeUnbuffered(context.Background(), "EventLog", cmtquery.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		for {
+			select {
+			case msg := <-sub.Out():
+				switch msg.Data().(type) {
+				case types.EventDataNewBlock, types.EventDataTx:
+					eventLog.Add(msg.Data(), msg.Events())
+				}
+			case <-sub.Cancelled():
+				if sub.Err() != nil {
+					logger.Error("Event log stopped", "err", sub.Err())
+				}
+				return
+			}
+		}
+	}()
+	return eventLog, nil
+}
+
 func createAndStartIndexerService(
 	config *cfg.Config,
 	chainID string,
@@ -284,8 +337,13 @@ func createAndStartIndexerService(
 			return nil, nil, nil, err
 		}
 
-		txIndexer = kv.NewTxIndex(store)
-		blockIndexer = blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+		rangeIndexes, err := indexer.ParseRangeIndexes(config.TxIndex.RangeIndexes)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		txIndexer = kv.NewTxIndex(store, kv.WithRangeIndexes(rangeIndexes))
+		blockIndexer = blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")),
+			blockidxkv.WithRangeIndexes(rangeIndexes))
 
 	case "psql":
 		if config.TxIndex.PsqlConn == "" {
@@ -298,6 +356,14 @@ func createAndStartIndexerService(
 		txIndexer = es.TxIndexer()
 		blockIndexer = es.BlockIndexer()
 
+	case "sqlite":
+		es, err := sqlite.NewEventSink(filepath.Join(config.DBDir(), sqlite.DBFile), chainID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("creating sqlite indexer: %w", err)
+		}
+		txIndexer = es.TxIndexer()
+		blockIndexer = es.BlockIndexer()
+
 	default:
 		txIndexer = &null.TxIndex{}
 		blockIndexer = &blockidxnull.BlockerIndexer{}
@@ -313,6 +379,27 @@ func createAndStartIndexerService(
 	return indexerService, txIndexer, blockIndexer, nil
 }
 
+func createAndStartIndexerPruner(
+	config *cfg.Config,
+	txIndexer txindex.TxIndexer,
+	blockIndexer indexer.BlockIndexer,
+	blockStore *store.BlockStore,
+	logger log.Logger,
+) (*txindex.PrunerService, error) {
+	if config.TxIndex.RetainBlocks == 0 {
+		return nil, nil
+	}
+
+	pruner := txindex.NewPrunerService(txIndexer, blockIndexer,
+		config.TxIndex.RetainBlocks, config.TxIndex.PruneInterval, blockStore.Height)
+	pruner.SetLogger(logger.With("module", "txindex"))
+
+	if err := pruner.Start(); err != nil {
+		return nil, err
+	}
+	return pruner, nil
+}
+
 func doHandshake(
 	stateStore sm.Store,
 	state sm.State,
@@ -383,6 +470,7 @@ func createMempoolAndMempoolReactor(
 			mempoolv1.WithMetrics(memplMetrics),
 			mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
 			mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
+			mempoolv1.WithAdmissionPolicy(mempl.NewRateLimitPolicy(config.Mempool, memplMetrics)),
 		)
 
 		reactor := mempoolv1.NewReactor(
@@ -403,6 +491,7 @@ func createMempoolAndMempoolReactor(
 			mempoolv0.WithMetrics(memplMetrics),
 			mempoolv0.WithPreCheck(sm.TxPreCheck(state)),
 			mempoolv0.WithPostCheck(sm.TxPostCheck(state)),
+			mempoolv0.WithAdmissionPolicy(mempl.NewRateLimitPolicy(config.Mempool, memplMetrics)),
 		)
 
 		mp.SetLogger(logger)
@@ -499,9 +588,12 @@ func createTransport(
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
+	banList *p2p.BanList,
 ) (
 	*p2p.MultiplexTransport,
+	*p2p.QUICTransport,
 	[]p2p.PeerFilterFunc,
+	error,
 ) {
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
@@ -555,18 +647,40 @@ func createTransport(
 	}
 
 	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
+	p2p.MultiplexTransportBanList(banList)(transport)
 
 	// Limit the number of incoming connections.
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
 	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
 
-	return transport, peerFilters
+	if config.P2P.QUICListenAddress == "" {
+		return transport, nil, peerFilters, nil
+	}
+
+	// Optionally, accept and dial peers over QUIC too. The incoming connection
+	// limit applies to each transport separately, the switch enforcing the
+	// overall inbound peer limit.
+	quicTransport, err := p2p.NewQUICTransport(
+		nodeInfo,
+		*nodeKey,
+		mConnConfig,
+		p2p.QUICTransportConnFilters(connFilters...),
+		p2p.QUICTransportBanList(banList),
+		p2p.QUICTransportMaxIncomingConnections(max),
+	)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not create QUIC transport: %w", err)
+	}
+
+	return transport, quicTransport, peerFilters, nil
 }
 
 func createSwitch(config *cfg.Config,
 	transport p2p.Transport,
 	p2pMetrics *p2p.Metrics,
 	peerFilters []p2p.PeerFilterFunc,
+	banList *p2p.BanList,
+	capture *p2p.MessageCapture,
 	mempoolReactor p2p.Reactor,
 	bcReactor p2p.Reactor,
 	stateSyncReactor *statesync.Reactor,
@@ -581,6 +695,8 @@ func createSwitch(config *cfg.Config,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
+		p2p.SwitchBanList(banList),
+		p2p.SwitchMessageCapture(capture),
 	)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
@@ -596,35 +712,40 @@ func createSwitch(config *cfg.Config,
 	return sw
 }
 
-func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
+func createAddrBookAndSetOnSwitch(config *cfg.Config, dbProvider DBProvider, sw *p2p.Switch,
 	p2pLogger log.Logger, nodeKey *p2p.NodeKey,
-) (pex.AddrBook, error) {
-	addrBook := pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
-	addrBook.SetLogger(p2pLogger.With("book", config.P2P.AddrBookFile()))
+) (pex.AddrBook, dbm.DB, error) {
+	addrBookDB, err := dbProvider(&DBContext{"addrbook", config})
+	if err != nil {
+		return nil, nil, err
+	}
+	// addr_book_file is only read to import the book of older versions
+	addrBook := pex.NewAddrBookDB(addrBookDB, config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
+	addrBook.SetLogger(p2pLogger.With("module", "addrbook"))
 
 	// Add ourselves to addrbook to prevent dialing ourselves
 	if config.P2P.ExternalAddress != "" {
 		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.ExternalAddress))
 		if err != nil {
-			return nil, fmt.Errorf("p2p.external_address is incorrect: %w", err)
+			return nil, nil, fmt.Errorf("p2p.external_address is incorrect: %w", err)
 		}
 		addrBook.AddOurAddress(addr)
 	}
 	if config.P2P.ListenAddress != "" {
 		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.ListenAddress))
 		if err != nil {
-			return nil, fmt.Errorf("p2p.laddr is incorrect: %w", err)
+			return nil, nil, fmt.Errorf("p2p.laddr is incorrect: %w", err)
 		}
 		addrBook.AddOurAddress(addr)
 	}
 
 	sw.SetAddrBook(addrBook)
 
-	return addrBook, nil
+	return addrBook, addrBookDB, nil
 }
 
 func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
-	sw *p2p.Switch, logger log.Logger,
+	sw *p2p.Switch, nodeKey *p2p.NodeKey, logger log.Logger,
 ) *pex.Reactor {
 	// TODO persistent peers ? so we can have their DNS addrs saved
 	pexReactor := pex.NewReactor(addrBook,
@@ -638,6 +759,9 @@ func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 			// https://github.com/tendermint/tendermint/issues/3523
 			SeedDisconnectWaitPeriod:     28 * time.Hour,
 			PersistentPeersMaxDialPeriod: config.P2P.PersistentPeersMaxDialPeriod,
+			PrivKey:                      nodeKey.PrivKey,
+			AddrRecordTTL:                config.P2P.PexAddrRecordTTL,
+			RequireSignedAddrs:           config.P2P.PexRequireSignedAddrs,
 		})
 	pexReactor.SetLogger(logger.With("module", "pex"))
 	sw.AddReactor("PEX", pexReactor)
@@ -658,7 +782,7 @@ func startStateSync(ssR *statesync.Reactor, bcR blockSyncReactor, conR *cs.React
 		stateProvider, err = statesync.NewLightClientStateProvider(
 			ctx,
 			state.ChainID, state.Version, state.InitialHeight,
-			config.RPCServers, light.TrustOptions{
+			config.RPCServers, config.GRPCServers, light.TrustOptions{
 				Period: config.TrustPeriod,
 				Height: config.TrustHeight,
 				Hash:   config.TrustHashBytes(),
@@ -749,6 +873,16 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	indexerPruner, err := createAndStartIndexerPruner(config, txIndexer, blockIndexer, blockStore, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	eventLog, err := createAndStartEventLog(config, eventBus, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	// If an address is provided, listen on the socket for a connection from an
 	// external signing process.
 	if config.PrivValidatorListenAddr != "" {
@@ -849,12 +983,32 @@ func NewNode(config *cfg.Config,
 	}
 
 	// Setup Transport.
-	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
+	banList, err := p2p.NewBanList(config.P2P.BanListFile())
+	if err != nil {
+		return nil, fmt.Errorf("could not load ban list: %w", err)
+	}
+	transport, quicTransport, peerFilters, err := createTransport(config, nodeInfo, nodeKey, proxyApp, banList)
+	if err != nil {
+		return nil, err
+	}
+	var swTransport p2p.Transport = transport
+	if quicTransport != nil {
+		swTransport = p2p.NewMixedTransport(transport, quicTransport)
+	}
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
+	var capture *p2p.MessageCapture
+	if config.P2P.Capture {
+		capture, err = p2p.NewMessageCapture(config.P2P.CaptureFile(),
+			auto.GroupTotalSizeLimit(config.P2P.CaptureMaxSize))
+		if err != nil {
+			return nil, fmt.Errorf("could not open p2p capture files: %w", err)
+		}
+		capture.SetLogger(p2pLogger.With("module", "capture"))
+	}
 	sw := createSwitch(
-		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
+		config, swTransport, p2pMetrics, peerFilters, banList, capture, mempoolReactor, bcReactor,
 		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
 	)
 
@@ -868,7 +1022,7 @@ func NewNode(config *cfg.Config,
 		return nil, fmt.Errorf("could not add peer ids from unconditional_peer_ids field: %w", err)
 	}
 
-	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
+	addrBook, addrBookDB, err := createAddrBookAndSetOnSwitch(config, dbProvider, sw, p2pLogger, nodeKey)
 	if err != nil {
 		return nil, fmt.Errorf("could not create addrbook: %w", err)
 	}
@@ -887,7 +1041,7 @@ func NewNode(config *cfg.Config,
 	// Note we currently use the addrBook regardless at least for AddOurAddress
 	var pexReactor *pex.Reactor
 	if config.P2P.PexReactor {
-		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
+		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, nodeKey, logger)
 	}
 
 	if config.RPC.PprofListenAddress != "" {
@@ -903,11 +1057,14 @@ func NewNode(config *cfg.Config,
 		genesisDoc:    genDoc,
 		privValidator: privValidator,
 
-		transport: transport,
-		sw:        sw,
-		addrBook:  addrBook,
-		nodeInfo:  nodeInfo,
-		nodeKey:   nodeKey,
+		transport:     transport,
+		quicTransport: quicTransport,
+		sw:            sw,
+		capture:       capture,
+		addrBook:      addrBook,
+		addrBookDB:    addrBookDB,
+		nodeInfo:      nodeInfo,
+		nodeKey:       nodeKey,
 
 		stateStore:       stateStore,
 		blockStore:       blockStore,
@@ -924,8 +1081,10 @@ func NewNode(config *cfg.Config,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
+		indexerPruner:    indexerPruner,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
+		eventLog:         eventLog,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
@@ -971,9 +1130,24 @@ func (n *Node) OnStart() error {
 	if err := n.transport.Listen(*addr); err != nil {
 		return err
 	}
+	if n.quicTransport != nil {
+		quicAddr, err := p2p.NewNetAddressString(p2p.IDAddressString(n.nodeKey.ID(), n.config.P2P.QUICListenAddress))
+		if err != nil {
+			return err
+		}
+		if err := n.quicTransport.Listen(*quicAddr); err != nil {
+			return err
+		}
+	}
 
 	n.isListening = true
 
+	if n.capture != nil {
+		if err := n.capture.Start(); err != nil {
+			return err
+		}
+	}
+
 	// Start the switch (the P2P server).
 	err = n.sw.Start()
 	if err != nil {
@@ -1015,15 +1189,30 @@ func (n *Node) OnStop() {
 	if err := n.indexerService.Stop(); err != nil {
 		n.Logger.Error("Error closing indexerService", "err", err)
 	}
+	if n.indexerPruner != nil {
+		if err := n.indexerPruner.Stop(); err != nil {
+			n.Logger.Error("Error closing indexerPruner", "err", err)
+		}
+	}
 
 	// now stop the reactors
 	if err := n.sw.Stop(); err != nil {
 		n.Logger.Error("Error closing switch", "err", err)
 	}
+	if n.capture != nil {
+		if err := n.capture.Stop(); err != nil {
+			n.Logger.Error("Error closing p2p capture", "err", err)
+		}
+	}
 
 	if err := n.transport.Close(); err != nil {
 		n.Logger.Error("Error closing transport", "err", err)
 	}
+	if n.quicTransport != nil {
+		if err := n.quicTransport.Close(); err != nil {
+			n.Logger.Error("Error closing QUIC transport", "err", err)
+		}
+	}
 
 	n.isListening = false
 
@@ -1057,6 +1246,13 @@ func (n *Node) OnStop() {
 			n.Logger.Error("problem closing statestore", "err", err)
 		}
 	}
+	if n.addrBookDB != nil {
+		// the switch, and thus the PEX reactor and the address book, are
+		// stopped by now
+		if err := n.addrBookDB.Close(); err != nil {
+			n.Logger.Error("problem closing addrbook database", "err", err)
+		}
+	}
 }
 
 // ConfigureRPC makes sure RPC has all the objects it needs to operate.
@@ -1082,6 +1278,7 @@ func (n *Node) ConfigureRPC() error {
 		BlockIndexer:     n.blockIndexer,
 		ConsensusReactor: n.consensusReactor,
 		EventBus:         n.eventBus,
+		EventLog:         n.eventLog,
 		Mempool:          n.mempool,
 
 		Logger: n.Logger.With("module", "rpc"),
@@ -1118,6 +1315,25 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		config.WriteTimeout = n.config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
+	var auth *rpcserver.Authenticator
+	if n.config.RPC.IsAuthEnabled() {
+		keys := make([]rpcserver.APIKey, len(n.config.RPC.APIKeys))
+		for i, key := range n.config.RPC.APIKeys {
+			keys[i] = rpcserver.APIKey{
+				Key:          key.Key,
+				Scopes:       key.Scopes,
+				RateLimit:    key.RateLimit,
+				RateBurst:    key.RateBurst,
+				MaxBatchSize: key.MaxBatchSize,
+			}
+		}
+		var err error
+		auth, err = rpcserver.NewAuthenticator(keys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// we may expose the rpc over both a unix and tcp socket
 	listeners := make([]net.Listener, len(listenAddrs))
 	for i, listenAddr := range listenAddrs {
@@ -1136,6 +1352,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		)
 		wm.SetLogger(wmLogger)
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
+		mux.Handle("/event_stream", rpcserver.RequireScope(rpcserver.ScopeRead, http.HandlerFunc(rpccore.EventStream)))
 		rpcserver.RegisterRPCFuncs(mux, rpccore.Routes, rpcLogger)
 		listener, err := rpcserver.Listen(
 			listenAddr,
@@ -1146,13 +1363,17 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		}
 
 		var rootHandler http.Handler = mux
+		if auth != nil {
+			rootHandler = auth.Handler(rootHandler)
+		}
 		if n.config.RPC.IsCorsEnabled() {
 			corsMiddleware := cors.New(cors.Options{
 				AllowedOrigins: n.config.RPC.CORSAllowedOrigins,
 				AllowedMethods: n.config.RPC.CORSAllowedMethods,
 				AllowedHeaders: n.config.RPC.CORSAllowedHeaders,
 			})
-			rootHandler = corsMiddleware.Handler(mux)
+			// preflight requests carry no credentials, so CORS goes first
+			rootHandler = corsMiddleware.Handler(rootHandler)
 		}
 		if n.config.RPC.IsTLSEnabled() {
 			go func() {
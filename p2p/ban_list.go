@@ -0,0 +1,242 @@
+package p2p
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/libs/tempfile"
+)
+
+// BanEntry is a rule of the BanList. Rule is either a node ID, an IP address
+// or a CIDR range.
+type BanEntry struct {
+	Rule    string    `json:"rule"`
+	Reason  string    `json:"reason,omitempty"`
+	Created time.Time `json:"created"`
+	// Expires is the time at which the entry is lifted. Zero means never.
+	Expires time.Time `json:"expires"`
+}
+
+// Expired returns true if the entry no longer applies at the given time.
+func (e BanEntry) Expired(now time.Time) bool {
+	return !e.Expires.IsZero() && !now.Before(e.Expires)
+}
+
+type bannedNet struct {
+	ipNet *net.IPNet
+	entry BanEntry
+}
+
+// BanList is a list of banned node IDs and IP ranges. It is persisted to a
+// JSON file on every change, so that bans survive restarts, and is safe for
+// concurrent use. Expired entries are dropped lazily.
+type BanList struct {
+	mtx      cmtsync.Mutex
+	filePath string // empty for an in-memory list
+	ids      map[ID]BanEntry
+	nets     map[string]bannedNet // by normalized CIDR
+	now      func() time.Time
+}
+
+type banListJSON struct {
+	Entries []BanEntry `json:"entries"`
+}
+
+// NewBanList returns the ban list stored in filePath, which is created on the
+// first change if it does not exist. If filePath is empty, the list is not
+// persisted.
+func NewBanList(filePath string) (*BanList, error) {
+	bl := &BanList{
+		filePath: filePath,
+		ids:      make(map[ID]BanEntry),
+		nets:     make(map[string]bannedNet),
+		now:      time.Now,
+	}
+	if filePath == "" {
+		return bl, nil
+	}
+
+	jsonBytes, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return bl, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("reading ban list: %w", err)
+	}
+	var blJSON banListJSON
+	if err := json.Unmarshal(jsonBytes, &blJSON); err != nil {
+		return nil, fmt.Errorf("decoding ban list %s: %w", filePath, err)
+	}
+	for _, entry := range blJSON.Entries {
+		if _, err := bl.set(entry); err != nil {
+			return nil, fmt.Errorf("ban list %s: %w", filePath, err)
+		}
+	}
+	return bl, nil
+}
+
+// Add bans the given node ID, IP address or CIDR range for duration, or
+// forever if duration is zero. Banning a rule again replaces its entry.
+func (bl *BanList) Add(rule, reason string, duration time.Duration) (BanEntry, error) {
+	if duration < 0 {
+		return BanEntry{}, errors.New("ban duration can't be negative")
+	}
+
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	now := bl.now()
+	entry := BanEntry{Rule: rule, Reason: reason, Created: now}
+	if duration > 0 {
+		entry.Expires = now.Add(duration)
+	}
+	entry, err := bl.set(entry)
+	if err != nil {
+		return BanEntry{}, err
+	}
+	return entry, bl.save()
+}
+
+// Remove lifts the ban of the given rule.
+func (bl *BanList) Remove(rule string) error {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	id, ipNet, err := parseBanRule(rule)
+	if err != nil {
+		return err
+	}
+	if ipNet != nil {
+		if _, ok := bl.nets[ipNet.String()]; !ok {
+			return fmt.Errorf("%s is not banned", rule)
+		}
+		delete(bl.nets, ipNet.String())
+	} else {
+		if _, ok := bl.ids[id]; !ok {
+			return fmt.Errorf("%s is not banned", rule)
+		}
+		delete(bl.ids, id)
+	}
+	return bl.save()
+}
+
+// List returns the entries in effect, sorted by rule.
+func (bl *BanList) List() []BanEntry {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	bl.pruneExpired()
+	return bl.entries()
+}
+
+// IsBanned returns the entry banning the given node ID or IP address, if any.
+// Either may be left empty.
+func (bl *BanList) IsBanned(id ID, ip net.IP) (BanEntry, bool) {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	now := bl.now()
+	if id != "" {
+		if entry, ok := bl.ids[id]; ok && !entry.Expired(now) {
+			return entry, true
+		}
+	}
+	if ip != nil {
+		for _, bn := range bl.nets {
+			if bn.ipNet.Contains(ip) && !bn.entry.Expired(now) {
+				return bn.entry, true
+			}
+		}
+	}
+	return BanEntry{}, false
+}
+
+// set adds entry and returns it with its rule normalized.
+// The caller must hold bl.mtx, unless bl is not shared yet.
+func (bl *BanList) set(entry BanEntry) (BanEntry, error) {
+	id, ipNet, err := parseBanRule(entry.Rule)
+	if err != nil {
+		return BanEntry{}, err
+	}
+	if ipNet != nil {
+		entry.Rule = ipNet.String()
+		bl.nets[entry.Rule] = bannedNet{ipNet: ipNet, entry: entry}
+	} else {
+		bl.ids[id] = entry
+	}
+	return entry, nil
+}
+
+// The caller must hold bl.mtx.
+func (bl *BanList) pruneExpired() {
+	now := bl.now()
+	for id, entry := range bl.ids {
+		if entry.Expired(now) {
+			delete(bl.ids, id)
+		}
+	}
+	for key, bn := range bl.nets {
+		if bn.entry.Expired(now) {
+			delete(bl.nets, key)
+		}
+	}
+}
+
+// The caller must hold bl.mtx.
+func (bl *BanList) entries() []BanEntry {
+	entries := make([]BanEntry, 0, len(bl.ids)+len(bl.nets))
+	for _, entry := range bl.ids {
+		entries = append(entries, entry)
+	}
+	for _, bn := range bl.nets {
+		entries = append(entries, bn.entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Rule < entries[j].Rule })
+	return entries
+}
+
+// save writes the entries in effect to the ban list file.
+// The caller must hold bl.mtx.
+func (bl *BanList) save() error {
+	if bl.filePath == "" {
+		return nil
+	}
+	bl.pruneExpired()
+	jsonBytes, err := json.MarshalIndent(banListJSON{Entries: bl.entries()}, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(bl.filePath, jsonBytes, 0o600); err != nil {
+		return fmt.Errorf("saving ban list: %w", err)
+	}
+	return nil
+}
+
+// parseBanRule parses a node ID, an IP address or a CIDR range. IP addresses
+// are returned as single address ranges.
+func parseBanRule(rule string) (ID, *net.IPNet, error) {
+	if strings.Contains(rule, "/") {
+		_, ipNet, err := net.ParseCIDR(rule)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid CIDR %q: %w", rule, err)
+		}
+		return "", ipNet, nil
+	}
+	if ip := net.ParseIP(rule); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return "", &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	if err := validateID(ID(rule)); err != nil {
+		return "", nil, fmt.Errorf("%q is neither a node ID, an IP address nor a CIDR range: %w", rule, err)
+	}
+	return ID(rule), nil, nil
+}
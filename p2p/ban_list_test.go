@@ -0,0 +1,94 @@
+package p2p
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+func TestBanListRules(t *testing.T) {
+	bl, err := NewBanList("")
+	require.NoError(t, err)
+
+	id := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	otherID := PubKeyToID(ed25519.GenPrivKey().PubKey())
+
+	_, err = bl.Add(string(id), "spam", 0)
+	require.NoError(t, err)
+	entry, err := bl.Add("10.0.0.0/8", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/8", entry.Rule)
+	entry, err = bl.Add("192.168.1.1", "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.1.1/32", entry.Rule)
+
+	_, err = bl.Add("not-a-rule", "", 0)
+	require.Error(t, err)
+	_, err = bl.Add("10.0.0.0/33", "", 0)
+	require.Error(t, err)
+
+	entry, ok := bl.IsBanned(id, nil)
+	require.True(t, ok)
+	assert.Equal(t, "spam", entry.Reason)
+	_, ok = bl.IsBanned(otherID, net.ParseIP("1.2.3.4"))
+	assert.False(t, ok)
+	_, ok = bl.IsBanned(otherID, net.ParseIP("10.1.2.3"))
+	assert.True(t, ok)
+	_, ok = bl.IsBanned("", net.ParseIP("192.168.1.1"))
+	assert.True(t, ok)
+	_, ok = bl.IsBanned("", net.ParseIP("192.168.1.2"))
+	assert.False(t, ok)
+
+	require.NoError(t, bl.Remove("192.168.1.1"))
+	_, ok = bl.IsBanned("", net.ParseIP("192.168.1.1"))
+	assert.False(t, ok)
+	require.Error(t, bl.Remove("192.168.1.1"))
+	assert.Len(t, bl.List(), 2)
+}
+
+func TestBanListExpiry(t *testing.T) {
+	bl, err := NewBanList("")
+	require.NoError(t, err)
+	now := time.Now()
+	bl.now = func() time.Time { return now }
+
+	_, err = bl.Add("10.0.0.1", "", time.Hour)
+	require.NoError(t, err)
+	_, ok := bl.IsBanned("", net.ParseIP("10.0.0.1"))
+	assert.True(t, ok)
+
+	now = now.Add(time.Hour)
+	_, ok = bl.IsBanned("", net.ParseIP("10.0.0.1"))
+	assert.False(t, ok)
+	assert.Empty(t, bl.List())
+
+	_, err = bl.Add("10.0.0.1", "", -time.Second)
+	require.Error(t, err)
+}
+
+func TestBanListPersistence(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "banlist.json")
+	bl, err := NewBanList(filePath)
+	require.NoError(t, err)
+
+	id := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	_, err = bl.Add(string(id), "spam", 0)
+	require.NoError(t, err)
+	_, err = bl.Add("fd00::/8", "", time.Hour)
+	require.NoError(t, err)
+
+	bl, err = NewBanList(filePath)
+	require.NoError(t, err)
+	entries := bl.List()
+	require.Len(t, entries, 2)
+	_, ok := bl.IsBanned(id, nil)
+	assert.True(t, ok)
+	_, ok = bl.IsBanned("", net.ParseIP("fd00::1"))
+	assert.True(t, ok)
+}
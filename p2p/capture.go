@@ -0,0 +1,299 @@
+package p2p
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	auto "github.com/cometbft/cometbft/libs/autofile"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+const (
+	// how often the captured messages are flushed to disk
+	captureFlushInterval = 2 * time.Second
+
+	// max size of an encoded captured message, above the largest message
+	// any reactor accepts
+	maxCapturedMsgSize = 128 * 1024 * 1024
+
+	// size of the fixed part of an encoded captured message: time, flags,
+	// channel ID and length of the peer ID
+	capturedMsgHeaderSize = 8 + 1 + 1 + 1
+
+	capturedMsgOutbound = 0x01
+)
+
+var captureCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// CapturedMessage is a message exchanged with a peer, as sent on the wire.
+type CapturedMessage struct {
+	Time      time.Time
+	Outbound  bool // sent to the peer rather than received from it
+	ChannelID byte
+	PeerID    ID
+	Bytes     []byte
+}
+
+// Decode unmarshals the message using the type of its channel in
+// msgTypeByChID, and unwraps it the way the switch does before handing it to
+// the reactor.
+func (m *CapturedMessage) Decode(msgTypeByChID map[byte]proto.Message) (proto.Message, error) {
+	mt, ok := msgTypeByChID[m.ChannelID]
+	if !ok {
+		return nil, fmt.Errorf("unknown channel %#x", m.ChannelID)
+	}
+	msg := proto.Clone(mt)
+	if err := proto.Unmarshal(m.Bytes, msg); err != nil {
+		return nil, fmt.Errorf("unmarshaling message into type %s: %w", reflect.TypeOf(mt), err)
+	}
+	if w, ok := msg.(Unwrapper); ok {
+		return w.Unwrap()
+	}
+	return msg, nil
+}
+
+//-------------------------------------------------------------------------
+
+// MessageCapture records the messages exchanged with peers to a group of
+// rotating files, for debugging. A nil *MessageCapture records nothing.
+type MessageCapture struct {
+	service.BaseService
+
+	group *auto.Group
+
+	mtx cmtsync.Mutex
+	enc *CaptureEncoder
+}
+
+// NewMessageCapture returns a capture writing to the files of the autofile
+// group with the given head path.
+func NewMessageCapture(headPath string, groupOptions ...func(*auto.Group)) (*MessageCapture, error) {
+	if err := cmtos.EnsureDir(filepath.Dir(headPath), 0700); err != nil {
+		return nil, fmt.Errorf("failed to ensure capture directory is in place: %w", err)
+	}
+	group, err := auto.OpenGroup(headPath, groupOptions...)
+	if err != nil {
+		return nil, err
+	}
+	c := &MessageCapture{
+		group: group,
+		enc:   NewCaptureEncoder(group),
+	}
+	c.BaseService = *service.NewBaseService(nil, "MessageCapture", c)
+	return c, nil
+}
+
+// SetLogger implements service.Service.
+func (c *MessageCapture) SetLogger(l log.Logger) {
+	c.BaseService.Logger = l
+	c.group.SetLogger(l)
+}
+
+// OnStart implements service.Service.
+func (c *MessageCapture) OnStart() error {
+	if err := c.group.Start(); err != nil {
+		return err
+	}
+	go c.flushRoutine()
+	return nil
+}
+
+// OnStop implements service.Service.
+func (c *MessageCapture) OnStop() {
+	c.mtx.Lock()
+	if err := c.group.FlushAndSync(); err != nil {
+		c.Logger.Error("Error flushing captured messages", "err", err)
+	}
+	c.mtx.Unlock()
+	if err := c.group.Stop(); err != nil {
+		c.Logger.Error("Error stopping capture group", "err", err)
+	}
+	c.group.Close()
+}
+
+func (c *MessageCapture) flushRoutine() {
+	ticker := time.NewTicker(captureFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.mtx.Lock()
+			err := c.group.FlushAndSync()
+			c.mtx.Unlock()
+			if err != nil {
+				c.Logger.Error("Error flushing captured messages", "err", err)
+			}
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+// Capture records a message sent to (outbound) or received from the peer.
+func (c *MessageCapture) Capture(outbound bool, chID byte, peerID ID, msgBytes []byte) {
+	if c == nil || !c.IsRunning() {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	err := c.enc.Encode(&CapturedMessage{
+		Time:      time.Now(),
+		Outbound:  outbound,
+		ChannelID: chID,
+		PeerID:    peerID,
+		Bytes:     msgBytes,
+	})
+	if err != nil {
+		c.Logger.Error("Error capturing message", "peer", peerID, "chID", chID, "err", err)
+	}
+}
+
+//-------------------------------------------------------------------------
+
+// A CaptureEncoder writes captured messages to an output stream.
+//
+// Format: 4 bytes CRC sum + 4 bytes length + value, where the value is made
+// of the time (8 bytes, Unix nanoseconds), flags (1 byte), channel ID (1 byte),
+// length of the peer ID (1 byte), the peer ID and the message bytes.
+type CaptureEncoder struct {
+	wr io.Writer
+}
+
+// NewCaptureEncoder returns a new encoder that writes to wr.
+func NewCaptureEncoder(wr io.Writer) *CaptureEncoder {
+	return &CaptureEncoder{wr}
+}
+
+// Encode writes the encoding of m to the stream.
+func (enc *CaptureEncoder) Encode(m *CapturedMessage) error {
+	if len(m.PeerID) > 0xff {
+		return fmt.Errorf("peer ID is too long: %d bytes", len(m.PeerID))
+	}
+	length := capturedMsgHeaderSize + len(m.PeerID) + len(m.Bytes)
+	if length > maxCapturedMsgSize {
+		return fmt.Errorf("msg is too big: %d bytes, max: %d bytes", length, maxCapturedMsgSize)
+	}
+
+	msg := make([]byte, 8+length)
+	data := msg[8:]
+	binary.BigEndian.PutUint64(data[0:8], uint64(m.Time.UnixNano()))
+	if m.Outbound {
+		data[8] |= capturedMsgOutbound
+	}
+	data[9] = m.ChannelID
+	data[10] = byte(len(m.PeerID))
+	n := copy(data[capturedMsgHeaderSize:], m.PeerID)
+	copy(data[capturedMsgHeaderSize+n:], m.Bytes)
+
+	binary.BigEndian.PutUint32(msg[0:4], crc32.Checksum(data, captureCRCTable))
+	binary.BigEndian.PutUint32(msg[4:8], uint32(length))
+
+	_, err := enc.wr.Write(msg)
+	return err
+}
+
+// A CaptureDecoder reads captured messages from an input stream. See
+// CaptureEncoder for the format used.
+type CaptureDecoder struct {
+	rd io.Reader
+}
+
+// NewCaptureDecoder returns a new decoder that reads from rd.
+func NewCaptureDecoder(rd io.Reader) *CaptureDecoder {
+	return &CaptureDecoder{rd}
+}
+
+// Decode reads the next captured message. It returns io.EOF once the stream
+// is exhausted.
+func (dec *CaptureDecoder) Decode() (*CapturedMessage, error) {
+	var prefix [8]byte
+	if _, err := io.ReadFull(dec.rd, prefix[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to read message prefix: %w", err)
+	}
+	crc := binary.BigEndian.Uint32(prefix[0:4])
+	length := binary.BigEndian.Uint32(prefix[4:8])
+	if length < capturedMsgHeaderSize || length > maxCapturedMsgSize {
+		return nil, fmt.Errorf("invalid message length %d", length)
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(dec.rd, data); err != nil {
+		return nil, fmt.Errorf("failed to read message: %w", err)
+	}
+	if actualCRC := crc32.Checksum(data, captureCRCTable); actualCRC != crc {
+		return nil, fmt.Errorf("checksums do not match: read: %v, actual: %v", crc, actualCRC)
+	}
+
+	idLen := int(data[10])
+	if capturedMsgHeaderSize+idLen > len(data) {
+		return nil, fmt.Errorf("invalid peer ID length %d", idLen)
+	}
+	return &CapturedMessage{
+		Time:      time.Unix(0, int64(binary.BigEndian.Uint64(data[0:8]))),
+		Outbound:  data[8]&capturedMsgOutbound != 0,
+		ChannelID: data[9],
+		PeerID:    ID(data[capturedMsgHeaderSize : capturedMsgHeaderSize+idLen]),
+		Bytes:     data[capturedMsgHeaderSize+idLen:],
+	}, nil
+}
+
+//-------------------------------------------------------------------------
+
+// ReplayCapture feeds the messages read from dec which were received on the
+// channels of reactor to it, as if they came from the peers which sent them.
+// newPeer returns the peer standing for the node with the given ID, which is
+// initialized and added to the reactor before its first message is fed.
+// It returns the number of messages fed.
+func ReplayCapture(dec *CaptureDecoder, reactor Reactor, newPeer func(ID) Peer) (int, error) {
+	msgTypeByChID := make(map[byte]proto.Message)
+	for _, desc := range reactor.GetChannels() {
+		msgTypeByChID[desc.ID] = desc.MessageType
+	}
+
+	peers := make(map[ID]Peer)
+	n := 0
+	for {
+		m, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		if _, ok := msgTypeByChID[m.ChannelID]; m.Outbound || !ok {
+			continue
+		}
+
+		msg, err := m.Decode(msgTypeByChID)
+		if err != nil {
+			return n, fmt.Errorf("decoding message %d from %v: %w", n, m.PeerID, err)
+		}
+		p, ok := peers[m.PeerID]
+		if !ok {
+			p = reactor.InitPeer(newPeer(m.PeerID))
+			reactor.AddPeer(p)
+			peers[m.PeerID] = p
+		}
+		reactor.ReceiveEnvelope(Envelope{
+			ChannelID: m.ChannelID,
+			Src:       p,
+			Message:   msg,
+		})
+		n++
+	}
+}
@@ -0,0 +1,91 @@
+package p2p
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	auto "github.com/cometbft/cometbft/libs/autofile"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p/conn"
+	p2pproto "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+func TestCaptureEncoderDecoder(t *testing.T) {
+	msg := &CapturedMessage{
+		Time:      time.Unix(0, 1234567890),
+		Outbound:  true,
+		ChannelID: 0x20,
+		PeerID:    newMockPeer(nil).ID(),
+		Bytes:     []byte("message"),
+	}
+	var buf bytes.Buffer
+	require.NoError(t, NewCaptureEncoder(&buf).Encode(msg))
+
+	decoded, err := NewCaptureDecoder(bytes.NewReader(buf.Bytes())).Decode()
+	require.NoError(t, err)
+	assert.True(t, msg.Time.Equal(decoded.Time))
+	assert.Equal(t, msg.Outbound, decoded.Outbound)
+	assert.Equal(t, msg.ChannelID, decoded.ChannelID)
+	assert.Equal(t, msg.PeerID, decoded.PeerID)
+	assert.Equal(t, msg.Bytes, decoded.Bytes)
+
+	// corrupted data is detected
+	corrupted := buf.Bytes()
+	corrupted[len(corrupted)-1] ^= 0xff
+	_, err = NewCaptureDecoder(bytes.NewReader(corrupted)).Decode()
+	assert.Error(t, err)
+}
+
+func TestMessageCaptureReplay(t *testing.T) {
+	headPath := filepath.Join(t.TempDir(), "capture")
+	capture, err := NewMessageCapture(headPath)
+	require.NoError(t, err)
+	capture.SetLogger(log.TestingLogger())
+	require.NoError(t, capture.Start())
+
+	pexAddrs := &p2pproto.PexAddrs{Addrs: []p2pproto.NetAddress{{ID: "1"}}}
+	pexAddrsBytes, err := proto.Marshal(pexAddrs.Wrap())
+	require.NoError(t, err)
+	pexRequestBytes, err := proto.Marshal((&p2pproto.PexRequest{}).Wrap())
+	require.NoError(t, err)
+
+	peerA, peerB := newMockPeer(nil).ID(), newMockPeer(nil).ID()
+	capture.Capture(false, 0x00, peerA, pexAddrsBytes)
+	capture.Capture(true, 0x00, peerA, pexRequestBytes)  // sent, not replayed
+	capture.Capture(false, 0x02, peerB, pexRequestBytes) // unknown to the reactor
+	capture.Capture(false, 0x01, peerB, pexRequestBytes)
+	require.NoError(t, capture.Stop())
+
+	group, err := auto.OpenGroup(headPath)
+	require.NoError(t, err)
+	defer group.Close()
+	rd, err := group.NewReader(group.MinIndex())
+	require.NoError(t, err)
+	defer rd.Close()
+
+	reactor := NewTestReactor([]*conn.ChannelDescriptor{
+		{ID: byte(0x00), Priority: 10, MessageType: &p2pproto.Message{}},
+		{ID: byte(0x01), Priority: 10, MessageType: &p2pproto.Message{}},
+	}, true)
+	peers := make(map[ID]int)
+	n, err := ReplayCapture(NewCaptureDecoder(rd), reactor, func(id ID) Peer {
+		peers[id]++
+		return &mockPeer{id: id}
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, map[ID]int{peerA: 1, peerB: 1}, peers)
+
+	msgs := reactor.getMsgs(0x00)
+	require.Len(t, msgs, 1)
+	assert.True(t, proto.Equal(pexAddrs, msgs[0].Contents))
+	msgs = reactor.getMsgs(0x01)
+	require.Len(t, msgs, 1)
+	assert.True(t, proto.Equal(&p2pproto.PexRequest{}, msgs[0].Contents))
+}
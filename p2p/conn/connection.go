@@ -45,6 +45,10 @@ const (
 	defaultSendTimeout         = 10 * time.Second
 	defaultPingInterval        = 60 * time.Second
 	defaultPongTimeout         = 45 * time.Second
+
+	// how long to wait before retrying to send when every channel with
+	// pending messages is over its rate cap
+	channelThrottleRetry = 100 * time.Millisecond
 )
 
 type receiveCbFunc func(chID byte, msgBytes []byte)
@@ -111,10 +115,22 @@ type MConnection struct {
 	pongTimer     *time.Timer
 	pongTimeoutCh chan bool // true - timeout, false - peer sent pong
 
+	// round-trip times measured with ping/pong, in nanoseconds. pingSent is
+	// the time the outstanding ping was sent at, 0 if none.
+	pingSent int64 // atomic
+	lastRTT  int64 // atomic
+	rtt      int64 // atomic, smoothed
+
 	chStatsTimer *time.Ticker // update channel stats periodically
 
 	created time.Time // time of creation
 
+	// throttled is set by sendPacketMsg when it skipped channels with pending
+	// messages because of their rate caps. flushing disables the caps while
+	// FlushStop drains the send queues.
+	throttled bool
+	flushing  bool
+
 	_maxPacketMsgSize int
 }
 
@@ -134,6 +150,11 @@ type MConnConfig struct {
 
 	// Maximum wait time for pongs
 	PongTimeout time.Duration `mapstructure:"pong_timeout"`
+
+	// Per-channel send rate caps, in bytes/second, applied on top of
+	// SendRate. Channels without an entry are not capped. Receiving is not
+	// capped per channel, as recvRoutine can't pause a single channel.
+	ChannelSendRates map[byte]int64 `mapstructure:"channel_send_rates"`
 }
 
 // DefaultMConnConfig returns the default config.
@@ -280,9 +301,10 @@ func (c *MConnection) FlushStop() {
 		// so we dont race on calling sendSomePacketMsgs
 		<-c.doneSendRoutine
 
-		// Send and flush all pending msgs.
+		// Send and flush all pending msgs, regardless of channel rate caps.
 		// Since sendRoutine has exited, we can call this
 		// safely
+		c.flushing = true
 		eof := c.sendSomePacketMsgs()
 		for !eof {
 			eof = c.sendSomePacketMsgs()
@@ -440,6 +462,7 @@ FOR_LOOP:
 			}
 		case <-c.pingTimer.C:
 			c.Logger.Debug("Send Ping")
+			atomic.StoreInt64(&c.pingSent, time.Now().UnixNano())
 			_n, err = protoWriter.WriteMsg(mustWrapPacket(&tmp2p.PacketPing{}))
 			if err != nil {
 				c.Logger.Error("Failed to send PacketPing", "err", err)
@@ -481,6 +504,14 @@ FOR_LOOP:
 				case c.send <- struct{}{}:
 				default:
 				}
+			} else if c.throttled {
+				// Retry once the capped channels had time to drain.
+				time.AfterFunc(channelThrottleRetry, func() {
+					select {
+					case c.send <- struct{}{}:
+					default:
+					}
+				})
 			}
 		}
 
@@ -516,17 +547,24 @@ func (c *MConnection) sendSomePacketMsgs() bool {
 	return false
 }
 
-// Returns true if messages from channels were exhausted.
+// Returns true if messages from channels were exhausted, or if the channels
+// with pending messages are all over their rate cap (c.throttled is then set).
 func (c *MConnection) sendPacketMsg() bool {
 	// Choose a channel to create a PacketMsg from.
 	// The chosen channel will be the one whose recentlySent/priority is the least.
 	var leastRatio float32 = math.MaxFloat32
 	var leastChannel *Channel
+	c.throttled = false
 	for _, channel := range c.channels {
 		// If nothing to send, skip this channel
 		if !channel.isSendPending() {
 			continue
 		}
+		// If over its rate cap, skip this channel for now
+		if !c.flushing && !channel.underSendRate() {
+			c.throttled = true
+			continue
+		}
 		// Get ratio, and keep track of lowest ratio.
 		ratio := float32(channel.recentlySent) / float32(channel.desc.Priority)
 		if ratio < leastRatio {
@@ -619,6 +657,7 @@ FOR_LOOP:
 			}
 		case *tmp2p.Packet_PacketPong:
 			c.Logger.Debug("Receive Pong")
+			c.recordRTT()
 			select {
 			case c.pongTimeoutCh <- false:
 			default:
@@ -634,6 +673,7 @@ FOR_LOOP:
 				break FOR_LOOP
 			}
 
+			channel.recvMonitor.Update(_n)
 			msgBytes, err := channel.recvPacketMsg(*pkt.PacketMsg)
 			if err != nil {
 				if c.IsRunning() {
@@ -645,6 +685,7 @@ FOR_LOOP:
 			if msgBytes != nil {
 				c.Logger.Debug("Received bytes", "chID", channelID, "msgBytes", msgBytes)
 				// NOTE: This means the reactor.Receive runs in the same thread as the p2p recv routine
+				atomic.AddInt64(&channel.recvMsgs, 1)
 				c.onReceive(channelID, msgBytes)
 			}
 		default:
@@ -662,6 +703,25 @@ FOR_LOOP:
 	}
 }
 
+// recordRTT updates the round-trip times with the outstanding ping, if any.
+// Pongs received without a ping are ignored. The smoothed RTT follows
+// RFC 6298. Only called by recvRoutine.
+func (c *MConnection) recordRTT() {
+	sent := atomic.SwapInt64(&c.pingSent, 0)
+	if sent == 0 {
+		return
+	}
+	rtt := time.Now().UnixNano() - sent
+	atomic.StoreInt64(&c.lastRTT, rtt)
+	srtt := atomic.LoadInt64(&c.rtt)
+	if srtt == 0 {
+		srtt = rtt
+	} else {
+		srtt += (rtt - srtt) / 8
+	}
+	atomic.StoreInt64(&c.rtt, srtt)
+}
+
 // not goroutine-safe
 func (c *MConnection) stopPongTimer() {
 	if c.pongTimer != nil {
@@ -688,6 +748,11 @@ type ConnectionStatus struct {
 	SendMonitor flow.Status
 	RecvMonitor flow.Status
 	Channels    []ChannelStatus
+
+	// Smoothed and last round-trip times measured with ping/pong. Zero until
+	// the first pong is received.
+	RTT     time.Duration
+	LastRTT time.Duration
 }
 
 type ChannelStatus struct {
@@ -696,6 +761,19 @@ type ChannelStatus struct {
 	SendQueueSize     int
 	Priority          int
 	RecentlySent      int64
+
+	// Totals since the connection was established. Bytes include packet
+	// framing, messages are counted once fully sent or received.
+	SendBytes int64
+	RecvBytes int64
+	SendMsgs  int64
+	RecvMsgs  int64
+
+	// Current transfer rates and send rate cap (0 if uncapped), in
+	// bytes/second.
+	CurSendRate   int64
+	CurRecvRate   int64
+	SendRateLimit int64
 }
 
 func (c *MConnection) Status() ConnectionStatus {
@@ -703,6 +781,8 @@ func (c *MConnection) Status() ConnectionStatus {
 	status.Duration = time.Since(c.created)
 	status.SendMonitor = c.sendMonitor.Status()
 	status.RecvMonitor = c.recvMonitor.Status()
+	status.RTT = time.Duration(atomic.LoadInt64(&c.rtt))
+	status.LastRTT = time.Duration(atomic.LoadInt64(&c.lastRTT))
 	status.Channels = make([]ChannelStatus, len(c.channels))
 	for i, channel := range c.channels {
 		status.Channels[i] = ChannelStatus{
@@ -712,6 +792,7 @@ func (c *MConnection) Status() ConnectionStatus {
 			Priority:          channel.desc.Priority,
 			RecentlySent:      atomic.LoadInt64(&channel.recentlySent),
 		}
+		channel.fillStatus(&status.Channels[i])
 	}
 	return status
 }
@@ -752,6 +833,13 @@ type Channel struct {
 	sending       []byte
 	recentlySent  int64 // exponential moving average
 
+	// per-channel accounting and send rate cap (0 if uncapped)
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	sendMsgs    int64 // atomic
+	recvMsgs    int64 // atomic
+	sendRate    int64
+
 	maxPacketMsgPayloadSize int
 
 	Logger log.Logger
@@ -767,6 +855,9 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
+		sendMonitor:             flow.New(0, 0),
+		recvMonitor:             flow.New(0, 0),
+		sendRate:                conn.config.ChannelSendRates[desc.ID],
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
 	}
 }
@@ -835,6 +926,7 @@ func (ch *Channel) nextPacketMsg() tmp2p.PacketMsg {
 		packet.EOF = true
 		ch.sending = nil
 		atomic.AddInt32(&ch.sendQueueSize, -1) // decrement sendQueueSize
+		atomic.AddInt64(&ch.sendMsgs, 1)
 	} else {
 		packet.EOF = false
 		ch.sending = ch.sending[cmtmath.MinInt(maxSize, len(ch.sending)):]
@@ -848,9 +940,33 @@ func (ch *Channel) writePacketMsgTo(w io.Writer) (n int, err error) {
 	packet := ch.nextPacketMsg()
 	n, err = protoio.NewDelimitedWriter(w).WriteMsg(mustWrapPacket(&packet))
 	atomic.AddInt64(&ch.recentlySent, int64(n))
+	ch.sendMonitor.Update(n)
 	return
 }
 
+// Returns true if the channel may send a packet without exceeding its rate
+// cap.
+// Goroutine-safe
+func (ch *Channel) underSendRate() bool {
+	if ch.sendRate <= 0 {
+		return true
+	}
+	return ch.sendMonitor.Limit(ch.maxPacketMsgPayloadSize, ch.sendRate, false) > 0
+}
+
+// fillStatus sets the accounting fields of status.
+// Goroutine-safe
+func (ch *Channel) fillStatus(status *ChannelStatus) {
+	sendStatus, recvStatus := ch.sendMonitor.Status(), ch.recvMonitor.Status()
+	status.SendBytes = sendStatus.Bytes
+	status.RecvBytes = recvStatus.Bytes
+	status.SendMsgs = atomic.LoadInt64(&ch.sendMsgs)
+	status.RecvMsgs = atomic.LoadInt64(&ch.recvMsgs)
+	status.CurSendRate = sendStatus.CurRate
+	status.CurRecvRate = recvStatus.CurRate
+	status.SendRateLimit = ch.sendRate
+}
+
 // Handles incoming PacketMsgs. It returns a message bytes if message is
 // complete. NOTE message bytes may change on next call to recvPacketMsg.
 // Not goroutine-safe
@@ -163,6 +163,68 @@ func TestMConnectionStatus(t *testing.T) {
 	assert.Zero(t, status.Channels[0].SendQueueSize)
 }
 
+func TestMConnectionChannelAccounting(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	receivedCh := make(chan []byte)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- msgBytes
+	}
+	mconn1 := createMConnectionWithCallbacks(client, onReceive, func(r interface{}) {})
+	err := mconn1.Start()
+	require.Nil(t, err)
+	defer mconn1.Stop() //nolint:errcheck // ignore for tests
+
+	mconn2 := createTestMConnection(server)
+	err = mconn2.Start()
+	require.Nil(t, err)
+	defer mconn2.Stop() //nolint:errcheck // ignore for tests
+
+	msg := []byte("Cyclops")
+	assert.True(t, mconn2.Send(0x01, msg))
+
+	select {
+	case <-receivedCh:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatalf("Did not receive %s message in 500ms", msg)
+	}
+
+	sent := mconn2.Status().Channels[0]
+	assert.EqualValues(t, 1, sent.SendMsgs)
+	assert.Greater(t, sent.SendBytes, int64(len(msg)))
+	assert.Zero(t, sent.RecvMsgs)
+
+	// the receiver updates its stats before calling onReceive
+	recv := mconn1.Status().Channels[0]
+	assert.EqualValues(t, 1, recv.RecvMsgs)
+	assert.Equal(t, sent.SendBytes, recv.RecvBytes)
+}
+
+func TestMConnectionChannelSendRate(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	cfg := DefaultMConnConfig()
+	cfg.ChannelSendRates = map[byte]int64{0x01: 1000}
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1},
+		{ID: 0x02, Priority: 1},
+	}
+	mconn := NewMConnectionWithConfig(client, chDescs, nil, nil, cfg)
+
+	capped, uncapped := mconn.channelsIdx[0x01], mconn.channelsIdx[0x02]
+	assert.EqualValues(t, 1000, mconn.Status().Channels[0].SendRateLimit)
+	assert.True(t, capped.underSendRate())
+
+	capped.sendMonitor.Update(10000)
+	uncapped.sendMonitor.Update(10000)
+	assert.False(t, capped.underSendRate())
+	assert.True(t, uncapped.underSendRate())
+}
+
 func TestMConnectionPongTimeoutResultsInError(t *testing.T) {
 	server, client := net.Pipe()
 	defer server.Close()
@@ -257,6 +319,42 @@ func TestMConnectionMultiplePongsInTheBeginning(t *testing.T) {
 	}
 }
 
+func TestMConnectionRTT(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	mconn := createTestMConnection(client)
+	err := mconn.Start()
+	require.Nil(t, err)
+	defer mconn.Stop() //nolint:errcheck // ignore for tests
+
+	assert.Zero(t, mconn.Status().RTT)
+
+	// an unsolicited pong is not measured
+	protoWriter := protoio.NewDelimitedWriter(server)
+	_, err = protoWriter.WriteMsg(mustWrapPacket(&tmp2p.PacketPong{}))
+	require.NoError(t, err)
+
+	// read ping, then respond with pong after a delay
+	delay := 20 * time.Millisecond
+	var packet tmp2p.Packet
+	_, err = protoio.NewDelimitedReader(server, maxPingPongPacketSize).ReadMsg(&packet)
+	require.NoError(t, err)
+	require.IsType(t, &tmp2p.Packet_PacketPing{}, packet.Sum)
+	time.Sleep(delay)
+	_, err = protoWriter.WriteMsg(mustWrapPacket(&tmp2p.PacketPong{}))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return mconn.Status().RTT != 0
+	}, time.Second, 5*time.Millisecond)
+	status := mconn.Status()
+	assert.GreaterOrEqual(t, status.RTT, delay)
+	assert.Less(t, status.RTT, mconn.config.PingInterval)
+	assert.Equal(t, status.RTT, status.LastRTT)
+}
+
 func TestMConnectionMultiplePings(t *testing.T) {
 	server, client := net.Pipe()
 	defer server.Close()
@@ -145,6 +145,18 @@ func (e ErrTransportClosed) Error() string {
 	return "transport has been closed"
 }
 
+// ErrPeerBanned is raised when a peer matches an entry of the BanList.
+type ErrPeerBanned struct {
+	Entry BanEntry
+}
+
+func (e ErrPeerBanned) Error() string {
+	if e.Entry.Reason == "" {
+		return fmt.Sprintf("peer banned by rule %s", e.Entry.Rule)
+	}
+	return fmt.Sprintf("peer banned by rule %s: %s", e.Entry.Rule, e.Entry.Reason)
+}
+
 // ErrPeerRemoval is raised when attempting to remove a peer results in an error.
 type ErrPeerRemoval struct{}
 
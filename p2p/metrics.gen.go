@@ -56,6 +56,36 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "message_send_bytes_total",
 			Help:      "Number of bytes of each message type sent.",
 		}, append(labels, "message_type")).With(labelsAndValues...),
+		PeerReceiveMessagesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_receive_messages_total",
+			Help:      "Number of messages received from a given peer.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerSendMessagesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_messages_total",
+			Help:      "Number of messages sent to a given peer.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelSendRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_send_rate",
+			Help:      "Current rate at which a given peer's channel sends, in bytes/s.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerChannelRecvRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_channel_recv_rate",
+			Help:      "Current rate at which a given peer's channel receives, in bytes/s.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerRTTSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_rtt_seconds",
+			Help:      "Smoothed round-trip time to a given peer, in seconds.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
 	}
 }
 
@@ -68,5 +98,10 @@ func NopMetrics() *Metrics {
 		NumTxs:                   discard.NewGauge(),
 		MessageReceiveBytesTotal: discard.NewCounter(),
 		MessageSendBytesTotal:    discard.NewCounter(),
+		PeerReceiveMessagesTotal: discard.NewCounter(),
+		PeerSendMessagesTotal:    discard.NewCounter(),
+		PeerChannelSendRate:      discard.NewGauge(),
+		PeerChannelRecvRate:      discard.NewGauge(),
+		PeerRTTSeconds:           discard.NewGauge(),
 	}
 }
@@ -40,6 +40,16 @@ type Metrics struct {
 	MessageReceiveBytesTotal metrics.Counter `metrics_labels:"message_type"`
 	// Number of bytes of each message type sent.
 	MessageSendBytesTotal metrics.Counter `metrics_labels:"message_type"`
+	// Number of messages received from a given peer.
+	PeerReceiveMessagesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
+	// Number of messages sent to a given peer.
+	PeerSendMessagesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
+	// Current rate at which a given peer's channel sends, in bytes/s.
+	PeerChannelSendRate metrics.Gauge `metrics_labels:"peer_id,chID"`
+	// Current rate at which a given peer's channel receives, in bytes/s.
+	PeerChannelRecvRate metrics.Gauge `metrics_labels:"peer_id,chID"`
+	// Smoothed round-trip time to a given peer, in seconds.
+	PeerRTTSeconds metrics.Gauge `metrics_labels:"peer_id" metrics_name:"peer_rtt_seconds"`
 }
 
 type metricsLabelCache struct {
@@ -42,6 +42,15 @@ func NewPeer(ip net.IP) *Peer {
 	return mp
 }
 
+// NewPeerWithID creates and starts a new mock peer with the given ID and a
+// random routable address.
+func NewPeerWithID(id p2p.ID) *Peer {
+	mp := NewPeer(nil)
+	mp.id = id
+	mp.addr.ID = id
+	return mp
+}
+
 func (mp *Peer) FlushStop()                          { mp.Stop() } //nolint:errcheck //ignore error
 func (mp *Peer) TrySendEnvelope(e p2p.Envelope) bool { return true }
 func (mp *Peer) SendEnvelope(e p2p.Envelope) bool    { return true }
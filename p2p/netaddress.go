@@ -20,12 +20,19 @@ import (
 // EmptyNetAddress defines the string representation of an empty NetAddress
 const EmptyNetAddress = "<nil-NetAddress>"
 
+// ProtocolQUIC is the address scheme ("quic://") of peers reachable over the
+// QUIC transport. Addresses without it are reached over TCP.
+const ProtocolQUIC = "quic"
+
 // NetAddress defines information about a peer on the network
 // including its ID, IP address, and port.
 type NetAddress struct {
 	ID   ID     `json:"id"`
 	IP   net.IP `json:"ip"`
 	Port uint16 `json:"port"`
+
+	// Protocol is ProtocolQUIC for QUIC addresses and empty for TCP ones.
+	Protocol string `json:"protocol,omitempty"`
 }
 
 // IDAddressString returns id@hostPort. It strips the leading
@@ -35,16 +42,25 @@ func IDAddressString(id ID, protocolHostPort string) string {
 	return fmt.Sprintf("%s@%s", id, hostPort)
 }
 
-// NewNetAddress returns a new NetAddress using the provided TCP
-// address. When testing, other net.Addr (except TCP) will result in
-// using 0.0.0.0:0. When normal run, other net.Addr (except TCP) will
-// panic. Panics if ID is invalid.
+// NewNetAddress returns a new NetAddress using the provided TCP or UDP
+// (QUIC) address. When testing, other net.Addr will result in using
+// 0.0.0.0:0. When normal run, other net.Addr will panic. Panics if ID is
+// invalid.
 // TODO: socks proxies?
 func NewNetAddress(id ID, addr net.Addr) *NetAddress {
-	tcpAddr, ok := addr.(*net.TCPAddr)
-	if !ok {
+	var (
+		ip       net.IP
+		port     uint16
+		protocol string
+	)
+	switch a := addr.(type) {
+	case *net.TCPAddr:
+		ip, port = a.IP, uint16(a.Port)
+	case *net.UDPAddr:
+		ip, port, protocol = a.IP, uint16(a.Port), ProtocolQUIC
+	default:
 		if flag.Lookup("test.v") == nil { // normal run
-			panic(fmt.Sprintf("Only TCPAddrs are supported. Got: %v", addr))
+			panic(fmt.Sprintf("Only TCPAddrs and UDPAddrs are supported. Got: %v", addr))
 		} else { // in testing
 			netAddr := NewNetAddressIPPort(net.IP("127.0.0.1"), 0)
 			netAddr.ID = id
@@ -56,15 +72,15 @@ func NewNetAddress(id ID, addr net.Addr) *NetAddress {
 		panic(fmt.Sprintf("Invalid ID %v: %v (addr: %v)", id, err, addr))
 	}
 
-	ip := tcpAddr.IP
-	port := uint16(tcpAddr.Port)
 	na := NewNetAddressIPPort(ip, port)
 	na.ID = id
+	na.Protocol = protocol
 	return na
 }
 
 // NewNetAddressString returns a new NetAddress using the provided address in
-// the form of "ID@IP:Port".
+// the form of "ID@IP:Port", optionally prefixed by a protocol. Addresses
+// prefixed by "quic://" are QUIC addresses, all others are TCP addresses.
 // Also resolves the host if host is not an IP.
 // Errors are of type ErrNetAddressXxx where Xxx is in (NoID, Invalid, Lookup)
 func NewNetAddressString(addr string) (*NetAddress, error) {
@@ -108,6 +124,9 @@ func NewNetAddressString(addr string) (*NetAddress, error) {
 
 	na := NewNetAddressIPPort(ip, uint16(port))
 	na.ID = id
+	if strings.HasPrefix(addr, ProtocolQUIC+"://") {
+		na.Protocol = ProtocolQUIC
+	}
 	return na, nil
 }
 
@@ -145,10 +164,14 @@ func NetAddressFromProto(pb tmp2p.NetAddress) (*NetAddress, error) {
 	if pb.Port >= 1<<16 {
 		return nil, fmt.Errorf("invalid port number %v", pb.Port)
 	}
+	if pb.Protocol != "" && pb.Protocol != ProtocolQUIC {
+		return nil, fmt.Errorf("invalid protocol %v", pb.Protocol)
+	}
 	return &NetAddress{
-		ID:   ID(pb.ID),
-		IP:   ip,
-		Port: uint16(pb.Port),
+		ID:       ID(pb.ID),
+		IP:       ip,
+		Port:     uint16(pb.Port),
+		Protocol: pb.Protocol,
 	}, nil
 }
 
@@ -179,9 +202,10 @@ func NetAddressesToProto(nas []*NetAddress) []tmp2p.NetAddress {
 // ToProto converts a NetAddress to Protobuf.
 func (na *NetAddress) ToProto() tmp2p.NetAddress {
 	return tmp2p.NetAddress{
-		ID:   string(na.ID),
-		IP:   na.IP.String(),
-		Port: uint32(na.Port),
+		ID:       string(na.ID),
+		IP:       na.IP.String(),
+		Port:     uint32(na.Port),
+		Protocol: na.Protocol,
 	}
 }
 
@@ -207,7 +231,8 @@ func (na *NetAddress) Same(other interface{}) bool {
 	return false
 }
 
-// String representation: <ID>@<IP>:<PORT>
+// String representation: <ID>@<IP>:<PORT>, prefixed by "quic://" for QUIC
+// addresses.
 func (na *NetAddress) String() string {
 	if na == nil {
 		return EmptyNetAddress
@@ -217,10 +242,18 @@ func (na *NetAddress) String() string {
 	if na.ID != "" {
 		addrStr = IDAddressString(na.ID, addrStr)
 	}
+	if na.IsQUIC() {
+		addrStr = ProtocolQUIC + "://" + addrStr
+	}
 
 	return addrStr
 }
 
+// IsQUIC returns true if the address must be dialed over QUIC.
+func (na *NetAddress) IsQUIC() bool {
+	return na.Protocol == ProtocolQUIC
+}
+
 func (na *NetAddress) DialString() string {
 	if na == nil {
 		return "<nil-NetAddress>"
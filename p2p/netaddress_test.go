@@ -47,6 +47,24 @@ func TestNewNetAddress(t *testing.T) {
 	}, "Calling NewNetAddress with UDPAddr should not panic in testing")
 }
 
+func TestNetAddressProto(t *testing.T) {
+	for _, addr := range []string{
+		"deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080",
+		"quic://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080",
+	} {
+		na, err := NewNetAddressString(addr)
+		require.NoError(t, err)
+		decoded, err := NetAddressFromProto(na.ToProto())
+		require.NoError(t, err)
+		assert.Equal(t, addr, decoded.String())
+	}
+
+	pb := NewNetAddressIPPort(net.ParseIP("127.0.0.1"), 8080).ToProto()
+	pb.Protocol = "udp"
+	_, err := NetAddressFromProto(pb)
+	assert.Error(t, err)
+}
+
 func TestNewNetAddressString(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -76,6 +94,12 @@ func TestNewNetAddressString(t *testing.T) {
 			"deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080",
 			true,
 		},
+		{
+			"quic input",
+			"quic://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080",
+			"quic://deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080",
+			true,
+		},
 		{"malformed tcp input", "tcp//deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "", false},
 		{"malformed udp input", "udp//deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", "", false},
 
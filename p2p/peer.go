@@ -124,6 +124,8 @@ type peer struct {
 	metricsTicker *time.Ticker
 	mlc           *metricsLabelCache
 
+	capture *MessageCapture // nil unless capturing messages
+
 	// When removal of a peer fails, we set this flag
 	removalAttemptFailed bool
 }
@@ -284,11 +286,13 @@ func (p *peer) send(chID byte, msg proto.Message, sendFunc func(byte, []byte) bo
 	}
 	res := sendFunc(chID, msgBytes)
 	if res {
+		p.capture.Capture(true, chID, p.ID(), msgBytes)
 		labels := []string{
 			"peer_id", string(p.ID()),
 			"chID", fmt.Sprintf("%#x", chID),
 		}
 		p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.metrics.PeerSendMessagesTotal.With(labels...).Add(1)
 		p.metrics.MessageSendBytesTotal.With("message_type", metricLabelValue).Add(float64(len(msgBytes)))
 	}
 	return res
@@ -367,6 +371,13 @@ func PeerMetrics(metrics *Metrics) PeerOption {
 	}
 }
 
+// PeerMessageCapture records the messages exchanged with the peer.
+func PeerMessageCapture(capture *MessageCapture) PeerOption {
+	return func(p *peer) {
+		p.capture = capture
+	}
+}
+
 func (p *peer) metricsReporter() {
 	for {
 		select {
@@ -375,9 +386,19 @@ func (p *peer) metricsReporter() {
 			var sendQueueSize float64
 			for _, chStatus := range status.Channels {
 				sendQueueSize += float64(chStatus.SendQueueSize)
+
+				labels := []string{
+					"peer_id", string(p.ID()),
+					"chID", fmt.Sprintf("%#x", chStatus.ID),
+				}
+				p.metrics.PeerChannelSendRate.With(labels...).Set(float64(chStatus.CurSendRate))
+				p.metrics.PeerChannelRecvRate.With(labels...).Set(float64(chStatus.CurRecvRate))
 			}
 
 			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
+			if status.RTT > 0 {
+				p.metrics.PeerRTTSeconds.With("peer_id", string(p.ID())).Set(status.RTT.Seconds())
+			}
 		case <-p.Quit():
 			return
 		}
@@ -398,6 +419,7 @@ func createMConnection(
 ) *cmtconn.MConnection {
 
 	onReceive := func(chID byte, msgBytes []byte) {
+		p.capture.Capture(false, chID, p.ID(), msgBytes)
 		reactor := reactorsByCh[chID]
 		if reactor == nil {
 			// Note that its ok to panic here as it's caught in the conn._recover,
@@ -421,6 +443,7 @@ func createMConnection(
 			}
 		}
 		p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+		p.metrics.PeerReceiveMessagesTotal.With(labels...).Add(1)
 		p.metrics.MessageReceiveBytesTotal.With("message_type", p.mlc.ValueToMetricLabel(msg)).Add(float64(len(msgBytes)))
 		reactor.ReceiveEnvelope(Envelope{
 			ChannelID: chID,
@@ -0,0 +1,414 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cometbft/cometbft/libs/cmap"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	cmtconn "github.com/cometbft/cometbft/p2p/conn"
+)
+
+// memoryPeer implements Peer for the ends of a connection between two
+// MemoryTransports. Sent messages are marshaled like on the wire and
+// delivered to the remote end by the MemoryNetwork.
+type memoryPeer struct {
+	service.BaseService
+
+	network    *MemoryNetwork
+	localID    ID          // ID of our node
+	remote     *memoryPeer // the other end of the connection
+	outbound   bool
+	persistent bool
+	socketAddr *NetAddress
+
+	// peer's node info and the channel it knows about
+	nodeInfo NodeInfo
+	channels []byte
+
+	reactorsByCh map[byte]Reactor
+	msgTypes     map[byte]proto.Message
+	onPeerError  func(Peer, interface{})
+
+	created time.Time // virtual time
+
+	// Delivered messages are queued in pending and passed to the reactors by
+	// recvRoutine, which runs once the peer is started, like a connection
+	// buffers them. Once the peer is stopped, messages are dropped.
+	recvMtx cmtsync.Mutex
+	started bool
+	stopped bool
+	pending []memoryMsg
+	recvc   chan struct{} // signals new messages in pending to recvRoutine
+
+	closeOnce  sync.Once
+	connClosed uint32 // atomic, set once the remote end is closed
+	errored    uint32 // atomic
+
+	// User data
+	Data *cmap.CMap
+
+	metrics *Metrics
+	mlc     *metricsLabelCache
+	capture *MessageCapture // nil unless capturing messages
+
+	// When removal of a peer fails, we set this flag
+	removalAttemptFailed bool
+}
+
+var _ Peer = (*memoryPeer)(nil)
+
+type memoryMsg struct {
+	chID     byte
+	msgBytes []byte
+}
+
+func newMemoryPeer(
+	network *MemoryNetwork,
+	localID ID,
+	outbound, persistent bool,
+	socketAddr *NetAddress,
+	nodeInfo NodeInfo,
+	cfg peerConfig,
+) *memoryPeer {
+	metrics := cfg.metrics
+	if metrics == nil {
+		metrics = NopMetrics()
+	}
+
+	p := &memoryPeer{
+		network:      network,
+		localID:      localID,
+		outbound:     outbound,
+		persistent:   persistent,
+		socketAddr:   socketAddr,
+		nodeInfo:     nodeInfo,
+		channels:     nodeInfo.(DefaultNodeInfo).Channels,
+		reactorsByCh: cfg.reactorsByCh,
+		msgTypes:     cfg.msgTypeByChID,
+		onPeerError:  cfg.onPeerError,
+		created:      network.Now(),
+		Data:         cmap.NewCMap(),
+		metrics:      metrics,
+		mlc:          cfg.mlc,
+		capture:      cfg.capture,
+		recvc:        make(chan struct{}, 1),
+	}
+	p.BaseService = *service.NewBaseService(nil, "MemoryPeer", p)
+
+	return p
+}
+
+// String representation.
+func (p *memoryPeer) String() string {
+	if p.outbound {
+		return fmt.Sprintf("Peer{Memory %v %v out}", p.socketAddr, p.ID())
+	}
+
+	return fmt.Sprintf("Peer{Memory %v %v in}", p.socketAddr, p.ID())
+}
+
+//---------------------------------------------------
+// Implements service.Service
+
+// SetLogger implements BaseService.
+func (p *memoryPeer) SetLogger(l log.Logger) {
+	p.Logger = l
+}
+
+// OnStart implements BaseService.
+func (p *memoryPeer) OnStart() error {
+	if err := p.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	// Pass the messages which arrived before the peer was started as well.
+	p.recvMtx.Lock()
+	p.started = true
+	if len(p.pending) > 0 {
+		p.network.addBusy(len(p.pending))
+		p.signalRecv()
+	}
+	p.recvMtx.Unlock()
+	go p.recvRoutine()
+
+	if atomic.LoadUint32(&p.connClosed) == 1 {
+		go p.stopForError(errMemoryConnClosed)
+	}
+	return nil
+}
+
+// FlushStop implements Peer. Messages already sent are delivered by the
+// network, so there is nothing to flush.
+func (p *memoryPeer) FlushStop() {
+	p.BaseService.OnStop()
+	p.closeConn()
+}
+
+// OnStop implements BaseService.
+func (p *memoryPeer) OnStop() {
+	p.BaseService.OnStop()
+	p.closeConn()
+}
+
+//---------------------------------------------------
+// Implements Peer
+
+// ID returns the peer's ID - the hex encoded hash of its pubkey.
+func (p *memoryPeer) ID() ID {
+	return p.nodeInfo.ID()
+}
+
+// IsOutbound returns true if the connection is outbound, false otherwise.
+func (p *memoryPeer) IsOutbound() bool {
+	return p.outbound
+}
+
+// IsPersistent returns true if the peer is persitent, false otherwise.
+func (p *memoryPeer) IsPersistent() bool {
+	return p.persistent
+}
+
+// NodeInfo returns a copy of the peer's NodeInfo.
+func (p *memoryPeer) NodeInfo() NodeInfo {
+	return p.nodeInfo
+}
+
+// SocketAddr returns the address of the socket: the dialed address for
+// outbound peers, the address of the dialing transport for inbound ones.
+func (p *memoryPeer) SocketAddr() *NetAddress {
+	return p.socketAddr
+}
+
+// RemoteIP returns the IP of the socket address.
+func (p *memoryPeer) RemoteIP() net.IP {
+	return p.socketAddr.IP
+}
+
+// RemoteAddr returns peer's remote network address.
+func (p *memoryPeer) RemoteAddr() net.Addr {
+	return &net.TCPAddr{IP: p.socketAddr.IP, Port: int(p.socketAddr.Port)}
+}
+
+// Status returns the peer's ConnectionStatus. The duration is in virtual
+// time, and the RTT is the configured one.
+func (p *memoryPeer) Status() cmtconn.ConnectionStatus {
+	rtt := p.network.linkLatency(p.localID, p.ID()) + p.network.linkLatency(p.ID(), p.localID)
+	return cmtconn.ConnectionStatus{
+		Duration: p.network.Now().Sub(p.created),
+		RTT:      rtt,
+		LastRTT:  rtt,
+	}
+}
+
+// SendEnvelope sends the message in the envelope on the channel specified by
+// the envelope. It never blocks: the message is queued on the link.
+func (p *memoryPeer) SendEnvelope(e Envelope) bool {
+	return p.send(e.ChannelID, e.Message)
+}
+
+// TrySendEnvelope is the same as SendEnvelope.
+func (p *memoryPeer) TrySendEnvelope(e Envelope) bool {
+	return p.send(e.ChannelID, e.Message)
+}
+
+func (p *memoryPeer) send(chID byte, msg proto.Message) bool {
+	if !p.IsRunning() {
+		return false
+	} else if !p.hasChannel(chID) {
+		return false
+	}
+	metricLabelValue := p.mlc.ValueToMetricLabel(msg)
+	if w, ok := msg.(Wrapper); ok {
+		msg = w.Wrap()
+	}
+	msgBytes, err := proto.Marshal(msg)
+	if err != nil {
+		p.Logger.Error("marshaling message to send", "error", err)
+		return false
+	}
+
+	remote := p.remote
+	p.network.schedule(p.localID, p.ID(), len(msgBytes), func() {
+		remote.deliver(chID, msgBytes)
+	})
+	p.capture.Capture(true, chID, p.ID(), msgBytes)
+
+	labels := []string{
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+	}
+	p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+	p.metrics.PeerSendMessagesTotal.With(labels...).Add(1)
+	p.metrics.MessageSendBytesTotal.With("message_type", metricLabelValue).Add(float64(len(msgBytes)))
+	return true
+}
+
+// Get the data for a given key.
+func (p *memoryPeer) Get(key string) interface{} {
+	return p.Data.Get(key)
+}
+
+// Set sets the data for the given key.
+func (p *memoryPeer) Set(key string, data interface{}) {
+	p.Data.Set(key, data)
+}
+
+// hasChannel returns true if the peer reported
+// knowing about the given chID.
+func (p *memoryPeer) hasChannel(chID byte) bool {
+	for _, ch := range p.channels {
+		if ch == chID {
+			return true
+		}
+	}
+	p.Logger.Debug(
+		"Unknown channel for peer",
+		"channel",
+		chID,
+		"channels",
+		p.channels,
+	)
+	return false
+}
+
+// CloseConn closes the connection. Used for cleaning up in cases where the
+// peer had not been started at all.
+func (p *memoryPeer) CloseConn() error {
+	p.closeConn()
+	return nil
+}
+
+func (p *memoryPeer) SetRemovalFailed() {
+	p.removalAttemptFailed = true
+}
+
+func (p *memoryPeer) GetRemovalFailed() bool {
+	return p.removalAttemptFailed
+}
+
+//---------------------------------------------------
+
+// closeConn reports the closing of the connection to the remote end, which
+// stops for error like on a broken connection.
+func (p *memoryPeer) closeConn() {
+	p.closeOnce.Do(func() {
+		remote := p.remote
+		if remote == nil {
+			return
+		}
+		atomic.StoreUint32(&remote.connClosed, 1)
+		go remote.stopForError(errMemoryConnClosed)
+	})
+}
+
+// deliver is called by the network when a message sent by the remote end
+// arrives.
+func (p *memoryPeer) deliver(chID byte, msgBytes []byte) {
+	p.recvMtx.Lock()
+	defer p.recvMtx.Unlock()
+	if p.stopped {
+		return
+	}
+	p.pending = append(p.pending, memoryMsg{chID, msgBytes})
+	if p.started {
+		p.network.addBusy(1)
+		p.signalRecv()
+	}
+}
+
+// Must be called with p.recvMtx held.
+func (p *memoryPeer) signalRecv() {
+	select {
+	case p.recvc <- struct{}{}:
+	default:
+	}
+}
+
+// recvRoutine passes the delivered messages to the reactors, in order, until
+// the peer is stopped. The messages left are then dropped.
+func (p *memoryPeer) recvRoutine() {
+	for {
+		select {
+		case <-p.recvc:
+		case <-p.Quit():
+			p.recvMtx.Lock()
+			p.stopped = true
+			dropped := len(p.pending)
+			p.pending = nil
+			p.recvMtx.Unlock()
+			p.network.addBusy(-dropped)
+			return
+		}
+
+		p.recvMtx.Lock()
+		msgs := p.pending
+		p.pending = nil
+		p.recvMtx.Unlock()
+		for _, m := range msgs {
+			p.receive(m.chID, m.msgBytes)
+			p.network.addBusy(-1)
+		}
+	}
+}
+
+func (p *memoryPeer) receive(chID byte, msgBytes []byte) {
+	if !p.IsRunning() {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			p.Logger.Error("Memory peer panicked", "err", r)
+			p.stopForError(fmt.Errorf("recovered from panic: %v", r))
+		}
+	}()
+
+	p.capture.Capture(false, chID, p.ID(), msgBytes)
+	reactor := p.reactorsByCh[chID]
+	if reactor == nil {
+		panic(fmt.Sprintf("Unknown channel %X", chID))
+	}
+	mt := p.msgTypes[chID]
+	msg := proto.Clone(mt)
+	err := proto.Unmarshal(msgBytes, msg)
+	if err != nil {
+		panic(fmt.Errorf("unmarshaling message: %s into type: %s", err, reflect.TypeOf(mt)))
+	}
+	labels := []string{
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+	}
+	if w, ok := msg.(Unwrapper); ok {
+		msg, err = w.Unwrap()
+		if err != nil {
+			panic(fmt.Errorf("unwrapping message: %s", err))
+		}
+	}
+	p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+	p.metrics.PeerReceiveMessagesTotal.With(labels...).Add(1)
+	p.metrics.MessageReceiveBytesTotal.With("message_type", p.mlc.ValueToMetricLabel(msg)).Add(float64(len(msgBytes)))
+	reactor.ReceiveEnvelope(Envelope{
+		ChannelID: chID,
+		Src:       p,
+		Message:   msg,
+	})
+}
+
+// stopForError reports the first error of the peer to the switch. Errors
+// occurring once the peer is stopping are ignored.
+func (p *memoryPeer) stopForError(r interface{}) {
+	if !p.IsRunning() {
+		return
+	}
+	if atomic.CompareAndSwapUint32(&p.errored, 0, 1) {
+		p.onPeerError(p, r)
+	}
+}
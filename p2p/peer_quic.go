@@ -0,0 +1,573 @@
+package p2p
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/libs/cmap"
+	flow "github.com/cometbft/cometbft/libs/flowrate"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtconn "github.com/cometbft/cometbft/p2p/conn"
+)
+
+// quicPeer implements Peer on top of a QUIC connection.
+//
+// Each channel is carried by its own stream in each direction, opened lazily
+// by the sending side, so that a busy channel does not delay the others. The
+// first byte of a stream is the channel ID, followed by messages framed as a
+// uvarint length and the message bytes.
+type quicPeer struct {
+	service.BaseService
+
+	peerConn
+	conn *quicConn
+
+	// peer's node info and the channel it knows about
+	nodeInfo NodeInfo
+	channels []byte
+
+	chs          map[byte]*quicChannel
+	reactorsByCh map[byte]Reactor
+	msgTypes     map[byte]proto.Message
+	onPeerError  func(Peer, interface{})
+	mConfig      cmtconn.MConnConfig
+
+	created     time.Time
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+
+	flushc  chan struct{}
+	sendWg  sync.WaitGroup
+	errored uint32 // atomic
+
+	// User data
+	Data *cmap.CMap
+
+	metrics       *Metrics
+	metricsTicker *time.Ticker
+	mlc           *metricsLabelCache
+
+	capture *MessageCapture // nil unless capturing messages
+
+	// When removal of a peer fails, we set this flag
+	removalAttemptFailed bool
+}
+
+var _ Peer = (*quicPeer)(nil)
+
+// quicChannel is the sending side of a channel.
+type quicChannel struct {
+	desc          cmtconn.ChannelDescriptor
+	sendQueue     chan []byte
+	sendQueueSize int32 // atomic
+	recentlySent  int64 // atomic
+
+	// accounting and rate cap (0 if uncapped) of the channel
+	sendMonitor *flow.Monitor
+	sendRate    int64
+}
+
+func newQUICPeer(
+	c *quicConn,
+	outbound, persistent bool,
+	socketAddr *NetAddress,
+	nodeInfo NodeInfo,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+	onPeerError func(Peer, interface{}),
+	mlc *metricsLabelCache,
+	metrics *Metrics,
+	capture *MessageCapture,
+	mConfig cmtconn.MConnConfig,
+) *quicPeer {
+	if metrics == nil {
+		metrics = NopMetrics()
+	}
+
+	p := &quicPeer{
+		peerConn:      newPeerConn(outbound, persistent, c, socketAddr),
+		conn:          c,
+		nodeInfo:      nodeInfo,
+		channels:      nodeInfo.(DefaultNodeInfo).Channels,
+		chs:           make(map[byte]*quicChannel, len(chDescs)),
+		reactorsByCh:  reactorsByCh,
+		msgTypes:      msgTypeByChID,
+		onPeerError:   onPeerError,
+		mConfig:       mConfig,
+		created:       time.Now(),
+		sendMonitor:   flow.New(0, 0),
+		recvMonitor:   flow.New(0, 0),
+		flushc:        make(chan struct{}),
+		Data:          cmap.NewCMap(),
+		metrics:       metrics,
+		metricsTicker: time.NewTicker(metricsTickerDuration),
+		mlc:           mlc,
+		capture:       capture,
+	}
+	for _, desc := range chDescs {
+		desc := desc.FillDefaults()
+		p.chs[desc.ID] = &quicChannel{
+			desc:        desc,
+			sendQueue:   make(chan []byte, desc.SendQueueCapacity),
+			sendMonitor: flow.New(0, 0),
+			sendRate:    mConfig.ChannelSendRates[desc.ID],
+		}
+	}
+	p.BaseService = *service.NewBaseService(nil, "QUICPeer", p)
+
+	return p
+}
+
+// String representation.
+func (p *quicPeer) String() string {
+	if p.outbound {
+		return fmt.Sprintf("Peer{QUIC %v %v out}", p.conn.RemoteAddr(), p.ID())
+	}
+
+	return fmt.Sprintf("Peer{QUIC %v %v in}", p.conn.RemoteAddr(), p.ID())
+}
+
+//---------------------------------------------------
+// Implements service.Service
+
+// SetLogger implements BaseService.
+func (p *quicPeer) SetLogger(l log.Logger) {
+	p.Logger = l
+}
+
+// OnStart implements BaseService.
+func (p *quicPeer) OnStart() error {
+	if err := p.BaseService.OnStart(); err != nil {
+		return err
+	}
+
+	for _, ch := range p.chs {
+		p.sendWg.Add(1)
+		go p.sendRoutine(ch)
+	}
+	go p.acceptStreams()
+	go p.metricsReporter()
+	return nil
+}
+
+// FlushStop mimics OnStop but additionally ensures that all successful
+// SendEnvelope() calls will get flushed before closing the connection.
+// NOTE: it is not safe to call this method more than once.
+func (p *quicPeer) FlushStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+	close(p.flushc)
+	p.sendWg.Wait()
+	_ = p.conn.Close()
+}
+
+// OnStop implements BaseService.
+func (p *quicPeer) OnStop() {
+	p.metricsTicker.Stop()
+	p.BaseService.OnStop()
+	if err := p.conn.Close(); err != nil {
+		p.Logger.Debug("Error while stopping peer", "err", err)
+	}
+}
+
+//---------------------------------------------------
+// Implements Peer
+
+// ID returns the peer's ID - the hex encoded hash of its pubkey.
+func (p *quicPeer) ID() ID {
+	return p.nodeInfo.ID()
+}
+
+// IsOutbound returns true if the connection is outbound, false otherwise.
+func (p *quicPeer) IsOutbound() bool {
+	return p.peerConn.outbound
+}
+
+// IsPersistent returns true if the peer is persitent, false otherwise.
+func (p *quicPeer) IsPersistent() bool {
+	return p.peerConn.persistent
+}
+
+// NodeInfo returns a copy of the peer's NodeInfo.
+func (p *quicPeer) NodeInfo() NodeInfo {
+	return p.nodeInfo
+}
+
+// SocketAddr returns the address of the socket.
+func (p *quicPeer) SocketAddr() *NetAddress {
+	return p.peerConn.socketAddr
+}
+
+// RemoteAddr returns peer's remote network address.
+func (p *quicPeer) RemoteAddr() net.Addr {
+	return p.conn.RemoteAddr()
+}
+
+// Status returns the peer's ConnectionStatus. RTTs are left zero, as quic-go
+// does not expose its RTT estimates.
+func (p *quicPeer) Status() cmtconn.ConnectionStatus {
+	status := cmtconn.ConnectionStatus{
+		Duration:    time.Since(p.created),
+		SendMonitor: p.sendMonitor.Status(),
+		RecvMonitor: p.recvMonitor.Status(),
+		Channels:    make([]cmtconn.ChannelStatus, 0, len(p.chs)),
+	}
+	for _, ch := range p.chs {
+		sendStatus := ch.sendMonitor.Status()
+		status.Channels = append(status.Channels, cmtconn.ChannelStatus{
+			ID:                ch.desc.ID,
+			SendQueueCapacity: cap(ch.sendQueue),
+			SendQueueSize:     int(atomic.LoadInt32(&ch.sendQueueSize)),
+			Priority:          ch.desc.Priority,
+			RecentlySent:      atomic.LoadInt64(&ch.recentlySent),
+			SendBytes:         sendStatus.Bytes,
+			CurSendRate:       sendStatus.CurRate,
+			SendRateLimit:     ch.sendRate,
+		})
+	}
+	return status
+}
+
+// SendEnvelope queues the message in the envelope for sending on the channel
+// specified by the envelope. Returns false if the channel's send queue stays
+// full for quicSendTimeout.
+func (p *quicPeer) SendEnvelope(e Envelope) bool {
+	return p.send(e.ChannelID, e.Message, true)
+}
+
+// TrySendEnvelope attempts to queue the message in the envelope. Returns
+// false immediately if the channel's send queue is full.
+func (p *quicPeer) TrySendEnvelope(e Envelope) bool {
+	return p.send(e.ChannelID, e.Message, false)
+}
+
+func (p *quicPeer) send(chID byte, msg proto.Message, block bool) bool {
+	if !p.IsRunning() {
+		return false
+	} else if !p.hasChannel(chID) {
+		return false
+	}
+	ch, ok := p.chs[chID]
+	if !ok {
+		p.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+	metricLabelValue := p.mlc.ValueToMetricLabel(msg)
+	if w, ok := msg.(Wrapper); ok {
+		msg = w.Wrap()
+	}
+	msgBytes, err := proto.Marshal(msg)
+	if err != nil {
+		p.Logger.Error("marshaling message to send", "error", err)
+		return false
+	}
+
+	atomic.AddInt32(&ch.sendQueueSize, 1)
+	if !ch.enqueue(msgBytes, block) {
+		atomic.AddInt32(&ch.sendQueueSize, -1)
+		return false
+	}
+	p.capture.Capture(true, chID, p.ID(), msgBytes)
+
+	labels := []string{
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+	}
+	p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+	p.metrics.PeerSendMessagesTotal.With(labels...).Add(1)
+	p.metrics.MessageSendBytesTotal.With("message_type", metricLabelValue).Add(float64(len(msgBytes)))
+	return true
+}
+
+// Get the data for a given key.
+func (p *quicPeer) Get(key string) interface{} {
+	return p.Data.Get(key)
+}
+
+// Set sets the data for the given key.
+func (p *quicPeer) Set(key string, data interface{}) {
+	p.Data.Set(key, data)
+}
+
+// hasChannel returns true if the peer reported
+// knowing about the given chID.
+func (p *quicPeer) hasChannel(chID byte) bool {
+	for _, ch := range p.channels {
+		if ch == chID {
+			return true
+		}
+	}
+	p.Logger.Debug(
+		"Unknown channel for peer",
+		"channel",
+		chID,
+		"channels",
+		p.channels,
+	)
+	return false
+}
+
+// CloseConn closes the QUIC connection. Used for cleaning up in cases where
+// the peer had not been started at all.
+func (p *quicPeer) CloseConn() error {
+	return p.conn.Close()
+}
+
+func (p *quicPeer) SetRemovalFailed() {
+	p.removalAttemptFailed = true
+}
+
+func (p *quicPeer) GetRemovalFailed() bool {
+	return p.removalAttemptFailed
+}
+
+//---------------------------------------------------
+
+// enqueue queues msgBytes for sending, waiting up to quicSendTimeout for room
+// in the queue if block is true.
+func (ch *quicChannel) enqueue(msgBytes []byte, block bool) bool {
+	if !block {
+		select {
+		case ch.sendQueue <- msgBytes:
+			return true
+		default:
+			return false
+		}
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		return true
+	case <-time.After(quicSendTimeout):
+		return false
+	}
+}
+
+// quicSendTimeout is how long SendEnvelope waits for room in a send queue,
+// same as for MConnection.
+const quicSendTimeout = 10 * time.Second
+
+// sendRoutine writes the messages queued on ch to its stream, which is
+// opened on the first message. On FlushStop, the messages still queued are
+// written before returning.
+func (p *quicPeer) sendRoutine(ch *quicChannel) {
+	defer p.sendWg.Done()
+
+	var w *bufio.Writer
+	var stream quic.Stream
+	write := func(msgBytes []byte) error {
+		atomic.AddInt32(&ch.sendQueueSize, -1)
+		if stream == nil {
+			var err error
+			stream, err = p.conn.qconn.OpenStreamSync(p.conn.qconn.Context())
+			if err != nil {
+				return err
+			}
+			w = bufio.NewWriter(stream)
+			if err := w.WriteByte(ch.desc.ID); err != nil {
+				return err
+			}
+		}
+
+		var lenBuf [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(lenBuf[:], uint64(len(msgBytes)))
+		size := n + len(msgBytes)
+		// the channel has its own stream, so waiting for its rate cap
+		// doesn't delay the others
+		ch.sendMonitor.Limit(size, ch.sendRate, true)
+		p.sendMonitor.Limit(size, p.mConfig.SendRate, true)
+		if _, err := w.Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := w.Write(msgBytes); err != nil {
+			return err
+		}
+		p.sendMonitor.Update(size)
+		ch.sendMonitor.Update(size)
+		atomic.AddInt64(&ch.recentlySent, int64(size))
+		// Only flush once the queue is drained, to batch small messages.
+		if len(ch.sendQueue) == 0 {
+			return w.Flush()
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case msgBytes := <-ch.sendQueue:
+			if err := write(msgBytes); err != nil {
+				p.stopForError(err)
+				return
+			}
+		case <-p.flushc:
+			for {
+				select {
+				case msgBytes := <-ch.sendQueue:
+					if err := write(msgBytes); err != nil {
+						return
+					}
+				default:
+					if stream != nil {
+						_ = w.Flush()
+						_ = stream.Close()
+					}
+					return
+				}
+			}
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+// acceptStreams hands every stream opened by the remote peer to a
+// recvRoutine.
+func (p *quicPeer) acceptStreams() {
+	for {
+		stream, err := p.conn.qconn.AcceptStream(p.conn.qconn.Context())
+		if err != nil {
+			p.stopForError(err)
+			return
+		}
+		go p.recvRoutine(stream)
+	}
+}
+
+// recvRoutine reads the channel ID, then the messages of a stream, and
+// passes them to the channel's reactor.
+func (p *quicPeer) recvRoutine(stream quic.Stream) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.Logger.Error("QUIC peer panicked", "err", r)
+			p.stopForError(fmt.Errorf("recovered from panic: %v", r))
+		}
+	}()
+
+	r := bufio.NewReader(p.recvMonitorReader(stream))
+	chID, err := r.ReadByte()
+	if err != nil {
+		p.stopForError(err)
+		return
+	}
+	ch, ok := p.chs[chID]
+	if !ok {
+		p.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				p.stopForError(err)
+			}
+			return
+		}
+		if size > uint64(ch.desc.RecvMessageCapacity) {
+			p.stopForError(fmt.Errorf(
+				"received message exceeds available capacity: %v < %v",
+				ch.desc.RecvMessageCapacity, size,
+			))
+			return
+		}
+		msgBytes := make([]byte, size)
+		if _, err := io.ReadFull(r, msgBytes); err != nil {
+			p.stopForError(err)
+			return
+		}
+		p.receive(chID, msgBytes)
+	}
+}
+
+// recvMonitorReader wraps r so that reads are rate limited and accounted for
+// by the receive monitor.
+func (p *quicPeer) recvMonitorReader(r io.Reader) io.Reader {
+	return readerFunc(func(b []byte) (int, error) {
+		n := p.recvMonitor.Limit(len(b), p.mConfig.RecvRate, true)
+		if n <= 0 {
+			n = 1
+		}
+		return p.recvMonitor.IO(r.Read(b[:n]))
+	})
+}
+
+func (p *quicPeer) receive(chID byte, msgBytes []byte) {
+	p.capture.Capture(false, chID, p.ID(), msgBytes)
+	reactor := p.reactorsByCh[chID]
+	if reactor == nil {
+		// Note that its ok to panic here as it's caught in recvRoutine,
+		// which does onPeerError.
+		panic(fmt.Sprintf("Unknown channel %X", chID))
+	}
+	mt := p.msgTypes[chID]
+	msg := proto.Clone(mt)
+	err := proto.Unmarshal(msgBytes, msg)
+	if err != nil {
+		panic(fmt.Errorf("unmarshaling message: %s into type: %s", err, reflect.TypeOf(mt)))
+	}
+	labels := []string{
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+	}
+	if w, ok := msg.(Unwrapper); ok {
+		msg, err = w.Unwrap()
+		if err != nil {
+			panic(fmt.Errorf("unwrapping message: %s", err))
+		}
+	}
+	p.metrics.PeerReceiveBytesTotal.With(labels...).Add(float64(len(msgBytes)))
+	p.metrics.PeerReceiveMessagesTotal.With(labels...).Add(1)
+	p.metrics.MessageReceiveBytesTotal.With("message_type", p.mlc.ValueToMetricLabel(msg)).Add(float64(len(msgBytes)))
+	reactor.ReceiveEnvelope(Envelope{
+		ChannelID: chID,
+		Src:       p,
+		Message:   msg,
+	})
+}
+
+// stopForError reports the first error of the peer to the switch. Errors
+// occurring once the peer is stopping are ignored.
+func (p *quicPeer) stopForError(r interface{}) {
+	if !p.IsRunning() {
+		return
+	}
+	if atomic.CompareAndSwapUint32(&p.errored, 0, 1) {
+		p.onPeerError(p, r)
+	}
+}
+
+func (p *quicPeer) metricsReporter() {
+	for {
+		select {
+		case <-p.metricsTicker.C:
+			var sendQueueSize float64
+			for _, ch := range p.chs {
+				sendQueueSize += float64(atomic.LoadInt32(&ch.sendQueueSize))
+				// Exponentially decay recentlySent, like MConnection does.
+				recentlySent := atomic.LoadInt64(&ch.recentlySent)
+				atomic.StoreInt64(&ch.recentlySent, int64(float64(recentlySent)*0.8))
+			}
+
+			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
+		case <-p.Quit():
+			return
+		}
+	}
+}
+
+// readerFunc adapts a function to io.Reader.
+type readerFunc func([]byte) (int, error)
+
+func (f readerFunc) Read(b []byte) (int, error) { return f(b) }
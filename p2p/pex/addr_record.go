@@ -0,0 +1,140 @@
+package pex
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/p2p"
+	cmtp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+// AddrRecord is a node's address signed with the node's key. Unlike bare
+// addresses, records can be relayed by any peer without letting it spoof the
+// address of another node. A record supersedes the records of the same node
+// with a lower sequence number.
+type AddrRecord struct {
+	Addr      *p2p.NetAddress
+	Sequence  uint64
+	Expires   time.Time
+	PubKey    crypto.PubKey
+	Signature []byte
+}
+
+// NewAddrRecord returns the record of addr signed with privKey, which must be
+// the key of the node addr points to.
+func NewAddrRecord(
+	addr *p2p.NetAddress,
+	sequence uint64,
+	expires time.Time,
+	privKey crypto.PrivKey,
+) (*AddrRecord, error) {
+	if p2p.PubKeyToID(privKey.PubKey()) != addr.ID {
+		return nil, fmt.Errorf("key does not match the ID of address %v", addr)
+	}
+	rec := &AddrRecord{
+		Addr:     addr,
+		Sequence: sequence,
+		Expires:  expires,
+		PubKey:   privKey.PubKey(),
+	}
+	signBytes, err := rec.signBytes()
+	if err != nil {
+		return nil, err
+	}
+	rec.Signature, err = privKey.Sign(signBytes)
+	if err != nil {
+		return nil, fmt.Errorf("signing address record: %w", err)
+	}
+	return rec, nil
+}
+
+// Expired returns true if the record is no longer valid at the given time.
+func (rec *AddrRecord) Expired(now time.Time) bool {
+	return !now.Before(rec.Expires)
+}
+
+// Verify checks that the record is signed by the node it advertises and has
+// not expired.
+func (rec *AddrRecord) Verify(now time.Time) error {
+	if rec.Addr == nil {
+		return errors.New("missing address")
+	}
+	if rec.PubKey == nil {
+		return errors.New("missing public key")
+	}
+	if id := p2p.PubKeyToID(rec.PubKey); id != rec.Addr.ID {
+		return fmt.Errorf("public key of node %v does not match the ID of address %v", id, rec.Addr)
+	}
+	if rec.Expired(now) {
+		return fmt.Errorf("record of %v expired at %v", rec.Addr, rec.Expires)
+	}
+	if rec.Expires.After(now.Add(config.MaxPexAddrRecordTTL)) {
+		return fmt.Errorf("record of %v expires too far in the future (%v)", rec.Addr, rec.Expires)
+	}
+	signBytes, err := rec.signBytes()
+	if err != nil {
+		return err
+	}
+	if !rec.PubKey.VerifySignature(signBytes, rec.Signature) {
+		return fmt.Errorf("invalid signature of the record of %v", rec.Addr)
+	}
+	return nil
+}
+
+// Equal returns true if both records are the same.
+func (rec *AddrRecord) Equal(other *AddrRecord) bool {
+	return rec.Addr.Equals(other.Addr) &&
+		rec.Sequence == other.Sequence &&
+		rec.Expires.Equal(other.Expires) &&
+		bytes.Equal(rec.Signature, other.Signature)
+}
+
+// signBytes returns the Protobuf encoding of the record without signature.
+func (rec *AddrRecord) signBytes() ([]byte, error) {
+	pb, err := rec.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	pb.Signature = nil
+	return pb.Marshal()
+}
+
+// ToProto converts the record to Protobuf.
+func (rec *AddrRecord) ToProto() (*cmtp2p.SignedAddress, error) {
+	pk, err := cryptoenc.PubKeyToProto(rec.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &cmtp2p.SignedAddress{
+		Addr:      rec.Addr.ToProto(),
+		Sequence:  rec.Sequence,
+		Expires:   rec.Expires,
+		PubKey:    pk,
+		Signature: rec.Signature,
+	}, nil
+}
+
+// AddrRecordFromProto converts a Protobuf SignedAddress to a record. The
+// record must still be verified.
+func AddrRecordFromProto(pb *cmtp2p.SignedAddress) (*AddrRecord, error) {
+	addr, err := p2p.NetAddressFromProto(pb.Addr)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := cryptoenc.PubKeyFromProto(pb.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &AddrRecord{
+		Addr:      addr,
+		Sequence:  pb.Sequence,
+		Expires:   pb.Expires,
+		PubKey:    pk,
+		Signature: pb.Signature,
+	}, nil
+}
@@ -16,6 +16,8 @@ import (
 
 	"github.com/minio/highwayhash"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
@@ -48,6 +50,11 @@ type AddrBook interface {
 	AddAddress(addr *p2p.NetAddress, src *p2p.NetAddress) error
 	RemoveAddress(*p2p.NetAddress)
 
+	// Add the address of a verified record signed by the node
+	AddAddressRecord(rec *AddrRecord, src *p2p.NetAddress) error
+	// Get the signed record of a node, if any
+	AddressRecord(p2p.ID) *AddrRecord
+
 	// Check if the address is in the book
 	HasAddress(*p2p.NetAddress) bool
 
@@ -100,7 +107,17 @@ type addrBook struct {
 	nOld       int
 	nNew       int
 
+	// addresses per network group and per source, used to cap how much of
+	// the book a single subnet or peer can fill
+	newPerGroup map[string]int
+	oldPerGroup map[string]int
+	newPerSrc   map[p2p.ID]int
+
+	// hashes of the entries as last written to db
+	saved map[p2p.ID][]byte
+
 	// immutable after creation
+	db                dbm.DB // nil if the book is saved to filePath
 	filePath          string
 	key               string // random prefix for bucket placement
 	routabilityStrict bool
@@ -115,15 +132,32 @@ func newHashKey() []byte {
 	return result
 }
 
-// NewAddrBook creates a new address book.
+// NewAddrBook creates a new address book saved to a JSON file.
 // Use Start to begin processing asynchronous address updates.
 func NewAddrBook(filePath string, routabilityStrict bool) AddrBook {
+	return newAddrBook(nil, filePath, routabilityStrict)
+}
+
+// NewAddrBookDB creates a new address book saved to db. Only the addresses
+// which changed since the last save are written. If the database is empty and
+// legacyFilePath points to an address book file, the file is imported.
+// Use Start to begin processing asynchronous address updates.
+func NewAddrBookDB(db dbm.DB, legacyFilePath string, routabilityStrict bool) AddrBook {
+	return newAddrBook(db, legacyFilePath, routabilityStrict)
+}
+
+func newAddrBook(db dbm.DB, filePath string, routabilityStrict bool) *addrBook {
 	am := &addrBook{
 		rand:              cmtrand.NewRand(),
 		ourAddrs:          make(map[string]struct{}),
 		privateIDs:        make(map[p2p.ID]struct{}),
 		addrLookup:        make(map[p2p.ID]*knownAddress),
 		badPeers:          make(map[p2p.ID]*knownAddress),
+		newPerGroup:       make(map[string]int),
+		oldPerGroup:       make(map[string]int),
+		newPerSrc:         make(map[p2p.ID]int),
+		saved:             make(map[p2p.ID][]byte),
+		db:                db,
 		filePath:          filePath,
 		routabilityStrict: routabilityStrict,
 		hashKey:           newHashKey(),
@@ -134,7 +168,7 @@ func NewAddrBook(filePath string, routabilityStrict bool) AddrBook {
 }
 
 // Initialize the buckets.
-// When modifying this, don't forget to update loadFromFile()
+// When modifying this, don't forget to update loadAddrs()
 func (a *addrBook) init() {
 	a.key = crypto.CRandHex(24) // 24/2 * 8 = 96 bits
 	// New addr buckets
@@ -154,7 +188,9 @@ func (a *addrBook) OnStart() error {
 	if err := a.BaseService.OnStart(); err != nil {
 		return err
 	}
-	a.loadFromFile(a.filePath)
+	if err := a.load(); err != nil {
+		return err
+	}
 
 	// wg.Add to ensure that any invocation of .Wait()
 	// later on will wait for saveRoutine to terminate.
@@ -167,6 +203,11 @@ func (a *addrBook) OnStart() error {
 // OnStop implements Service.
 func (a *addrBook) OnStop() {
 	a.BaseService.OnStop()
+	if a.db != nil {
+		// Flush now rather than in saveRoutine, so that the database can be
+		// closed as soon as the book is stopped.
+		a.saveToDB()
+	}
 }
 
 func (a *addrBook) Wait() {
@@ -217,6 +258,64 @@ func (a *addrBook) AddAddress(addr *p2p.NetAddress, src *p2p.NetAddress) error {
 	return a.addAddress(addr, src)
 }
 
+// AddAddressRecord implements AddrBook - adds the address of a verified
+// record. Unlike bare addresses, which never replace the address known for a
+// node, the record replaces it unless the book has a record of the node with
+// a higher or equal sequence number.
+func (a *addrBook) AddAddressRecord(rec *AddrRecord, src *p2p.NetAddress) error {
+	pb, err := rec.ToProto()
+	if err != nil {
+		return err
+	}
+	bz, err := pb.Marshal()
+	if err != nil {
+		return err
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if ka := a.addrLookup[rec.Addr.ID]; ka != nil {
+		if known := ka.record(time.Now()); known != nil && known.Sequence >= rec.Sequence {
+			return nil
+		}
+		if ka.Addr.Equals(rec.Addr) {
+			ka.Record = bz
+			return nil
+		}
+		// The node moved, or the address we knew was spoofed. Check the new
+		// address first, so that the entry is kept if it can't be replaced.
+		if err := a.checkAddress(rec.Addr, src); err != nil {
+			return err
+		}
+		if err := a.checkNewCaps(rec.Addr, src); err != nil {
+			return err
+		}
+		a.removeFromAllBuckets(ka)
+	}
+
+	if err := a.addAddress(rec.Addr, src); err != nil {
+		return err
+	}
+	if ka := a.addrLookup[rec.Addr.ID]; ka != nil {
+		ka.Record = bz
+	}
+	return nil
+}
+
+// AddressRecord implements AddrBook - returns the unexpired record signed by
+// the node with the given ID, if any.
+func (a *addrBook) AddressRecord(id p2p.ID) *AddrRecord {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[id]
+	if ka == nil {
+		return nil
+	}
+	return ka.record(time.Now())
+}
+
 // RemoveAddress implements AddrBook - removes the address from the book.
 func (a *addrBook) RemoveAddress(addr *p2p.NetAddress) {
 	a.mtx.Lock()
@@ -488,7 +587,33 @@ func (a *addrBook) size() int {
 
 // Save persists the address book to disk.
 func (a *addrBook) Save() {
-	a.saveToFile(a.filePath) // thread safe
+	a.save() // thread safe
+}
+
+func (a *addrBook) save() {
+	if a.db != nil {
+		a.saveToDB()
+		return
+	}
+	a.saveToFile(a.filePath)
+}
+
+// load restores the address book from the database or the file. A database
+// which was never written to is seeded with the file, if any.
+func (a *addrBook) load() error {
+	if a.db == nil {
+		a.loadFromFile(a.filePath)
+		return nil
+	}
+	loaded, err := a.loadFromDB()
+	if err != nil {
+		return err
+	}
+	if !loaded && a.filePath != "" && a.loadFromFile(a.filePath) {
+		a.Logger.Info("Imported address book file into the database", "file", a.filePath, "size", a.Size())
+		a.saveToDB()
+	}
+	return nil
 }
 
 func (a *addrBook) saveRoutine() {
@@ -499,13 +624,15 @@ out:
 	for {
 		select {
 		case <-saveFileTicker.C:
-			a.saveToFile(a.filePath)
+			a.save()
 		case <-a.Quit():
 			break out
 		}
 	}
 	saveFileTicker.Stop()
-	a.saveToFile(a.filePath)
+	if a.db == nil {
+		a.saveToFile(a.filePath)
+	}
 }
 
 //----------------------------------------------------------
@@ -548,6 +675,7 @@ func (a *addrBook) addToNewBucket(ka *knownAddress, bucketIdx int) error {
 	// increment nNew if the peer doesnt already exist in a bucket
 	if ka.addBucketRef(bucketIdx) == 1 {
 		a.nNew++
+		a.countAddr(ka, 1)
 	}
 
 	// Add it to addrLookup
@@ -584,6 +712,7 @@ func (a *addrBook) addToOldBucket(ka *knownAddress, bucketIdx int) bool {
 	bucket[addrStr] = ka
 	if ka.addBucketRef(bucketIdx) == 1 {
 		a.nOld++
+		a.countAddr(ka, 1)
 	}
 
 	// Ensure in addrLookup
@@ -605,6 +734,7 @@ func (a *addrBook) removeFromBucket(ka *knownAddress, bucketType byte, bucketIdx
 		} else {
 			a.nOld--
 		}
+		a.countAddr(ka, -1)
 		delete(a.addrLookup, ka.ID())
 	}
 }
@@ -620,9 +750,47 @@ func (a *addrBook) removeFromAllBuckets(ka *knownAddress) {
 	} else {
 		a.nOld--
 	}
+	a.countAddr(ka, -1)
 	delete(a.addrLookup, ka.ID())
 }
 
+// countAddr updates the per group and per source counts of the addresses
+// when ka enters (delta = 1) or leaves (delta = -1) the buckets of its type.
+func (a *addrBook) countAddr(ka *knownAddress, delta int) {
+	inc := func(m map[string]int, k string) {
+		if m[k] += delta; m[k] <= 0 {
+			delete(m, k)
+		}
+	}
+	group := a.groupKey(ka.Addr)
+	if ka.isOld() {
+		inc(a.oldPerGroup, group)
+		return
+	}
+	inc(a.newPerGroup, group)
+	if ka.Src != nil {
+		if a.newPerSrc[ka.Src.ID] += delta; a.newPerSrc[ka.Src.ID] <= 0 {
+			delete(a.newPerSrc, ka.Src.ID)
+		}
+	}
+}
+
+// checkNewCaps returns an error if the new buckets are already holding too
+// many addresses of addr's network group or coming from src. Non-routable
+// addresses, as found in private and local networks, are not capped.
+func (a *addrBook) checkNewCaps(addr, src *p2p.NetAddress) error {
+	if !addr.Routable() {
+		return nil
+	}
+	if group := a.groupKey(addr); a.newPerGroup[group] >= maxNewAddrsPerGroup {
+		return ErrAddrBookGroupFull{Addr: addr, Group: group}
+	}
+	if a.newPerSrc[src.ID] >= maxNewAddrsPerSource {
+		return ErrAddrBookSourceFull{Addr: addr, Src: src}
+	}
+	return nil
+}
+
 //----------------------------------------------------------
 
 func (a *addrBook) pickOldest(bucketType byte, bucketIdx int) *knownAddress {
@@ -639,33 +807,8 @@ func (a *addrBook) pickOldest(bucketType byte, bucketIdx int) *knownAddress {
 // adds the address to a "new" bucket. if its already in one,
 // it only adds it probabilistically
 func (a *addrBook) addAddress(addr, src *p2p.NetAddress) error {
-	if addr == nil || src == nil {
-		return ErrAddrBookNilAddr{addr, src}
-	}
-
-	if err := addr.Valid(); err != nil {
-		return ErrAddrBookInvalidAddr{Addr: addr, AddrErr: err}
-	}
-
-	if _, ok := a.badPeers[addr.ID]; ok {
-		return ErrAddressBanned{addr}
-	}
-
-	if _, ok := a.privateIDs[addr.ID]; ok {
-		return ErrAddrBookPrivate{addr}
-	}
-
-	if _, ok := a.privateIDs[src.ID]; ok {
-		return ErrAddrBookPrivateSrc{src}
-	}
-
-	// TODO: we should track ourAddrs by ID and by IP:PORT and refuse both.
-	if _, ok := a.ourAddrs[addr.String()]; ok {
-		return ErrAddrBookSelf{addr}
-	}
-
-	if a.routabilityStrict && !addr.Routable() {
-		return ErrAddrBookNonRoutable{addr}
+	if err := a.checkAddress(addr, src); err != nil {
+		return err
 	}
 
 	ka := a.addrLookup[addr.ID]
@@ -686,6 +829,9 @@ func (a *addrBook) addAddress(addr, src *p2p.NetAddress) error {
 			return nil
 		}
 	} else {
+		if err := a.checkNewCaps(addr, src); err != nil {
+			return err
+		}
 		ka = newKnownAddress(addr, src)
 	}
 
@@ -696,6 +842,39 @@ func (a *addrBook) addAddress(addr, src *p2p.NetAddress) error {
 	return a.addToNewBucket(ka, bucket)
 }
 
+// checkAddress returns an error if the address can't be added to the book.
+func (a *addrBook) checkAddress(addr, src *p2p.NetAddress) error {
+	if addr == nil || src == nil {
+		return ErrAddrBookNilAddr{addr, src}
+	}
+
+	if err := addr.Valid(); err != nil {
+		return ErrAddrBookInvalidAddr{Addr: addr, AddrErr: err}
+	}
+
+	if _, ok := a.badPeers[addr.ID]; ok {
+		return ErrAddressBanned{addr}
+	}
+
+	if _, ok := a.privateIDs[addr.ID]; ok {
+		return ErrAddrBookPrivate{addr}
+	}
+
+	if _, ok := a.privateIDs[src.ID]; ok {
+		return ErrAddrBookPrivateSrc{src}
+	}
+
+	// TODO: we should track ourAddrs by ID and by IP:PORT and refuse both.
+	if _, ok := a.ourAddrs[addr.String()]; ok {
+		return ErrAddrBookSelf{addr}
+	}
+
+	if a.routabilityStrict && !addr.Routable() {
+		return ErrAddrBookNonRoutable{addr}
+	}
+	return nil
+}
+
 func (a *addrBook) randomPickAddresses(bucketType byte, num int) []*p2p.NetAddress {
 	var buckets []map[string]*knownAddress
 	switch bucketType {
@@ -764,6 +943,13 @@ func (a *addrBook) moveToOld(ka *knownAddress) error {
 		a.Logger.Error(fmt.Sprintf("Cannot promote address that isn't in any new buckets %v", ka))
 		return nil
 	}
+	if ka.Addr.Routable() {
+		if group := a.groupKey(ka.Addr); a.oldPerGroup[group] >= maxOldAddrsPerGroup {
+			a.Logger.Debug("Too many old addresses in network group, keeping address new",
+				"addr", ka.Addr, "group", group)
+			return nil
+		}
+	}
 
 	// Remove from all (new) buckets.
 	a.removeFromAllBuckets(ka)
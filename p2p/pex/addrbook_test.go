@@ -1,7 +1,9 @@
 package pex
 
 import (
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
 	"net"
@@ -12,6 +14,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
@@ -643,6 +648,189 @@ func TestAddrBookAddDoesNotOverwriteOldIP(t *testing.T) {
 	}
 }
 
+func TestAddrBookAddAddressRecord(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	privKey := ed25519.GenPrivKey()
+	peerID := p2p.PubKeyToID(privKey.PubKey())
+	newAddr := func(ip string) *p2p.NetAddress {
+		addr, err := p2p.NewNetAddressString(string(peerID) + "@" + ip + ":26656")
+		require.NoError(t, err)
+		return addr
+	}
+	newRecord := func(addr *p2p.NetAddress, seq uint64) *AddrRecord {
+		rec, err := NewAddrRecord(addr, seq, time.Now().Add(time.Hour), privKey)
+		require.NoError(t, err)
+		return rec
+	}
+	src, err := p2p.NewNetAddressString("b0dd378c3fbc4c156cd6d302a799f0d2e4227201@159.89.121.174:26656")
+	require.NoError(t, err)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	assertIP := func(ip string) {
+		selection := book.GetSelection()
+		require.Len(t, selection, 1)
+		assert.Equal(t, ip, selection[0].IP.String())
+	}
+
+	// a spoofed address is replaced by the node's record
+	require.NoError(t, book.AddAddress(newAddr("1.1.1.1"), src))
+	book.MarkGood(peerID)
+	assert.Nil(t, book.AddressRecord(peerID))
+	require.NoError(t, book.AddAddressRecord(newRecord(newAddr("2.2.2.2"), 2), src))
+	assertIP("2.2.2.2")
+	rec := book.AddressRecord(peerID)
+	require.NotNil(t, rec)
+	assert.EqualValues(t, 2, rec.Sequence)
+
+	// older records and bare addresses don't replace it
+	require.NoError(t, book.AddAddressRecord(newRecord(newAddr("3.3.3.3"), 1), src))
+	require.NoError(t, book.AddAddress(newAddr("3.3.3.3"), src))
+	assertIP("2.2.2.2")
+	assert.EqualValues(t, 2, book.AddressRecord(peerID).Sequence)
+
+	// newer ones do
+	require.NoError(t, book.AddAddressRecord(newRecord(newAddr("4.4.4.4"), 3), src))
+	assertIP("4.4.4.4")
+
+	// unless their address can't be added, which keeps the entry
+	require.Error(t, book.AddAddressRecord(newRecord(newAddr("10.0.0.1"), 4), src))
+	assertIP("4.4.4.4")
+	assert.EqualValues(t, 3, book.AddressRecord(peerID).Sequence)
+
+	// records are persisted
+	book.Save()
+	book = NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	defer book.Stop() //nolint:errcheck // ignore for tests
+	rec = book.AddressRecord(peerID)
+	require.NotNil(t, rec)
+	assert.EqualValues(t, 3, rec.Sequence)
+	assert.NoError(t, rec.Verify(time.Now()))
+}
+
+func TestAddrBookSaveLoadDB(t *testing.T) {
+	db := dbm.NewMemDB()
+	book := NewAddrBookDB(db, "", true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+
+	randAddrs := randNetAddressPairs(t, 100)
+	for i, addrSrc := range randAddrs {
+		require.NoError(t, book.AddAddress(addrSrc.addr, addrSrc.src))
+		if i < 10 {
+			book.MarkGood(addrSrc.addr.ID)
+		}
+	}
+	book.Save()
+	entries, err := LoadAddrBookEntries(db, true)
+	require.NoError(t, err)
+	assert.Len(t, entries, 100)
+
+	// removed addresses are deleted from the database
+	removed := randAddrs[0].addr
+	book.RemoveAddress(removed)
+	require.NoError(t, book.Stop())
+	has, err := db.Has(addrKey(removed.ID))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	book = NewAddrBookDB(db, "", true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	defer book.Stop() //nolint:errcheck // ignore for tests
+	assert.Equal(t, 99, book.Size())
+	assert.True(t, book.IsGood(randAddrs[1].addr))
+	assert.False(t, book.IsGood(randAddrs[10].addr))
+	assert.Len(t, book.(*addrBook).saved, 99)
+}
+
+func TestAddrBookDBImportsFile(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	fileBook := NewAddrBook(fname, true)
+	fileBook.SetLogger(log.TestingLogger())
+	for _, addrSrc := range randNetAddressPairs(t, 10) {
+		require.NoError(t, fileBook.AddAddress(addrSrc.addr, addrSrc.src))
+	}
+	fileBook.Save()
+
+	db := dbm.NewMemDB()
+	book := NewAddrBookDB(db, fname, true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	assert.Equal(t, 10, book.Size())
+	assert.Equal(t, fileBook.(*addrBook).key, book.(*addrBook).key)
+	require.NoError(t, book.Stop())
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportAddrBook(db, &buf))
+	aJSON := &addrBookJSON{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), aJSON))
+	assert.Equal(t, fileBook.(*addrBook).key, aJSON.Key)
+	assert.Len(t, aJSON.Addrs, 10)
+}
+
+func TestAddrBookGroupCaps(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+
+	groupAddr := func(i int) *p2p.NetAddress {
+		id := p2p.ID(hex.EncodeToString(cmtrand.Bytes(p2p.IDByteLength)))
+		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(id, fmt.Sprintf("8.8.%d.%d:26656", i/250, i%250+1)))
+		require.NoError(t, err)
+		return addr
+	}
+
+	// new addresses of a group are capped
+	addrs := make([]*p2p.NetAddress, maxNewAddrsPerGroup)
+	for i := range addrs {
+		addrs[i] = groupAddr(i)
+		require.NoError(t, book.AddAddress(addrs[i], randIPv4Address(t)))
+	}
+	err := book.AddAddress(groupAddr(maxNewAddrsPerGroup), randIPv4Address(t))
+	assert.IsType(t, ErrAddrBookGroupFull{}, err)
+	require.NoError(t, book.AddAddress(randIPv4Address(t), randIPv4Address(t)))
+
+	// and so are old ones
+	for _, addr := range addrs {
+		book.MarkGood(addr.ID)
+	}
+	nOld := 0
+	for _, addr := range addrs {
+		if book.IsGood(addr) {
+			nOld++
+		}
+	}
+	assert.Equal(t, maxOldAddrsPerGroup, nOld)
+
+	// promoted addresses make room in the new buckets
+	require.NoError(t, book.AddAddress(groupAddr(maxNewAddrsPerGroup), randIPv4Address(t)))
+}
+
+func TestAddrBookSourceCap(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+
+	src := randIPv4Address(t)
+	for i := 0; i < maxNewAddrsPerSource; i++ {
+		require.NoError(t, book.AddAddress(randIPv4Address(t), src))
+	}
+	err := book.AddAddress(randIPv4Address(t), src)
+	assert.IsType(t, ErrAddrBookSourceFull{}, err)
+	require.NoError(t, book.AddAddress(randIPv4Address(t), randIPv4Address(t)))
+}
+
 func TestAddrBookGroupKey(t *testing.T) {
 	// non-strict routability
 	testCases := []struct {
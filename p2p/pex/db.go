@@ -0,0 +1,196 @@
+package pex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+/* Loading & Saving to a database */
+
+var (
+	addrBookKeyKey = []byte("key")
+	addrKeyPrefix  = []byte("addr:")
+)
+
+func addrKey(id p2p.ID) []byte {
+	return append(append([]byte{}, addrKeyPrefix...), id...)
+}
+
+// saveToDB writes the addresses which changed since the last save and
+// deletes the ones which left the book, in a single batch.
+func (a *addrBook) saveToDB() {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	batch := a.db.NewBatch()
+	defer batch.Close()
+
+	saved := make(map[p2p.ID][]byte, len(a.addrLookup))
+	changes := 0
+	for id, ka := range a.addrLookup {
+		bz, err := json.Marshal(ka)
+		if err != nil {
+			a.Logger.Error("Failed to encode address", "addr", ka.Addr, "err", err)
+			saved[id] = a.saved[id]
+			continue
+		}
+		hash := tmhash.Sum(bz)
+		saved[id] = hash
+		if bytes.Equal(a.saved[id], hash) {
+			continue
+		}
+		if err := batch.Set(addrKey(id), bz); err != nil {
+			a.Logger.Error("Failed to save AddrBook to database", "err", err)
+			return
+		}
+		changes++
+	}
+	for id := range a.saved {
+		if _, ok := saved[id]; ok {
+			continue
+		}
+		if err := batch.Delete(addrKey(id)); err != nil {
+			a.Logger.Error("Failed to save AddrBook to database", "err", err)
+			return
+		}
+		changes++
+	}
+	if changes == 0 {
+		return
+	}
+
+	if err := batch.Set(addrBookKeyKey, []byte(a.key)); err != nil {
+		a.Logger.Error("Failed to save AddrBook to database", "err", err)
+		return
+	}
+	if err := batch.WriteSync(); err != nil {
+		a.Logger.Error("Failed to save AddrBook to database", "err", err)
+		return
+	}
+	a.saved = saved
+	a.Logger.Info("Saved AddrBook to database", "size", a.size(), "changes", changes)
+}
+
+// Returns false if the book was never saved to the database.
+func (a *addrBook) loadFromDB() (bool, error) {
+	key, err := a.db.Get(addrBookKeyKey)
+	if err != nil {
+		return false, err
+	}
+	if key == nil {
+		return false, nil
+	}
+
+	addrs, encoded, err := loadKnownAddresses(a.db)
+	if err != nil {
+		return false, err
+	}
+	a.key = string(key)
+	for i, ka := range addrs {
+		a.saved[ka.ID()] = tmhash.Sum(encoded[i])
+	}
+	a.loadAddrs(addrs)
+	return true, nil
+}
+
+// loadKnownAddresses returns the addresses saved in db along with their
+// encoding.
+func loadKnownAddresses(db dbm.DB) ([]*knownAddress, [][]byte, error) {
+	iter, err := dbm.IteratePrefix(db, addrKeyPrefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		addrs   []*knownAddress
+		encoded [][]byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		ka := new(knownAddress)
+		if err := json.Unmarshal(iter.Value(), ka); err != nil {
+			return nil, nil, fmt.Errorf("decoding address %q: %w", iter.Key(), err)
+		}
+		addrs = append(addrs, ka)
+		encoded = append(encoded, append([]byte{}, iter.Value()...))
+	}
+	return addrs, encoded, iter.Error()
+}
+
+//------------------------------------------------------------------------
+// Inspection
+
+// AddrBookEntry describes an address saved in an address book database.
+type AddrBookEntry struct {
+	Addr        *p2p.NetAddress `json:"addr"`
+	Src         *p2p.NetAddress `json:"src"`
+	BucketType  string          `json:"bucket_type"` // "new" or "old"
+	Buckets     []int           `json:"buckets"`
+	Group       string          `json:"group"` // network group of Addr
+	Attempts    int32           `json:"attempts"`
+	LastAttempt time.Time       `json:"last_attempt"`
+	LastSuccess time.Time       `json:"last_success"`
+	Signed      bool            `json:"signed"` // whether the node signed a record of Addr
+}
+
+// LoadAddrBookEntries returns the addresses saved in an address book
+// database, sorted by address.
+func LoadAddrBookEntries(db dbm.DB, routabilityStrict bool) ([]AddrBookEntry, error) {
+	addrs, _, err := loadKnownAddresses(db)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]AddrBookEntry, 0, len(addrs))
+	for _, ka := range addrs {
+		bucketType := "new"
+		if ka.isOld() {
+			bucketType = "old"
+		}
+		entries = append(entries, AddrBookEntry{
+			Addr:        ka.Addr,
+			Src:         ka.Src,
+			BucketType:  bucketType,
+			Buckets:     ka.Buckets,
+			Group:       groupKeyFor(ka.Addr, routabilityStrict),
+			Attempts:    ka.Attempts,
+			LastAttempt: ka.LastAttempt,
+			LastSuccess: ka.LastSuccess,
+			Signed:      ka.record(time.Now()) != nil,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Addr.String() < entries[j].Addr.String()
+	})
+	return entries, nil
+}
+
+// ExportAddrBook writes the address book saved in db to w, in the format of
+// the address book file. The output can be imported by NewAddrBookDB.
+func ExportAddrBook(db dbm.DB, w io.Writer) error {
+	key, err := db.Get(addrBookKeyKey)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return fmt.Errorf("no address book in database")
+	}
+	addrs, _, err := loadKnownAddresses(db)
+	if err != nil {
+		return err
+	}
+	jsonBytes, err := json.MarshalIndent(&addrBookJSON{Key: string(key), Addrs: addrs}, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(jsonBytes)
+	return err
+}
@@ -76,6 +76,28 @@ func (err ErrAddrBookInvalidAddr) Error() string {
 	return fmt.Sprintf("Cannot add invalid address %v: %v", err.Addr, err.AddrErr)
 }
 
+// ErrAddrBookGroupFull is thrown when the new buckets already hold as many
+// addresses of the address' network group as allowed.
+type ErrAddrBookGroupFull struct {
+	Addr  *p2p.NetAddress
+	Group string
+}
+
+func (err ErrAddrBookGroupFull) Error() string {
+	return fmt.Sprintf("Cannot add address %v: too many new addresses in network group %s", err.Addr, err.Group)
+}
+
+// ErrAddrBookSourceFull is thrown when the new buckets already hold as many
+// addresses coming from the source as allowed.
+type ErrAddrBookSourceFull struct {
+	Addr *p2p.NetAddress
+	Src  *p2p.NetAddress
+}
+
+func (err ErrAddrBookSourceFull) Error() string {
+	return fmt.Sprintf("Cannot add address %v: too many new addresses coming from %v", err.Addr, err.Src)
+}
+
 // ErrAddressBanned is thrown when the address has been banned and therefore cannot be used
 type ErrAddressBanned struct {
 	Addr *p2p.NetAddress
@@ -87,3 +109,14 @@ func (err ErrAddressBanned) Error() string {
 
 // ErrUnsolicitedList is thrown when a peer provides a list of addresses that have not been asked for.
 var ErrUnsolicitedList = errors.New("unsolicited pexAddrsMessage")
+
+// ErrInvalidAddrRecord is thrown when a peer provides an address record which
+// is not properly signed by the node it advertises.
+type ErrInvalidAddrRecord struct {
+	Addr *p2p.NetAddress
+	Err  error
+}
+
+func (err ErrInvalidAddrRecord) Error() string {
+	return fmt.Sprintf("Invalid record of address %v: %v", err.Addr, err.Err)
+}
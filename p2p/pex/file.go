@@ -66,8 +66,16 @@ func (a *addrBook) loadFromFile(filePath string) bool {
 	// Restore all the fields...
 	// Restore the key
 	a.key = aJSON.Key
-	// Restore .bucketsNew & .bucketsOld
-	for _, ka := range aJSON.Addrs {
+	a.loadAddrs(aJSON.Addrs)
+	return true
+}
+
+// loadAddrs restores .bucketsNew & .bucketsOld.
+func (a *addrBook) loadAddrs(addrs []*knownAddress) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	for _, ka := range addrs {
 		for _, bucketIndex := range ka.Buckets {
 			bucket := a.getBucket(ka.BucketType, bucketIndex)
 			bucket[ka.Addr.String()] = ka
@@ -78,6 +86,6 @@ func (a *addrBook) loadFromFile(filePath string) bool {
 		} else {
 			a.nOld++
 		}
+		a.countAddr(ka, 1)
 	}
-	return true
 }
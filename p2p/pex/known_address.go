@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/p2p"
+	cmtp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
 )
 
 // knownAddress tracks information about a known network address
@@ -17,6 +18,8 @@ type knownAddress struct {
 	LastAttempt time.Time       `json:"last_attempt"`
 	LastSuccess time.Time       `json:"last_success"`
 	LastBanTime time.Time       `json:"last_ban_time"`
+	// Protobuf encoded AddrRecord signed by the node, if any
+	Record []byte `json:"record,omitempty"`
 }
 
 func newKnownAddress(addr *p2p.NetAddress, src *p2p.NetAddress) *knownAddress {
@@ -34,6 +37,23 @@ func (ka *knownAddress) ID() p2p.ID {
 	return ka.Addr.ID
 }
 
+// record returns the node's signed record, or nil if there is none or it
+// expired.
+func (ka *knownAddress) record(now time.Time) *AddrRecord {
+	if len(ka.Record) == 0 {
+		return nil
+	}
+	var pb cmtp2p.SignedAddress
+	if err := pb.Unmarshal(ka.Record); err != nil {
+		return nil
+	}
+	rec, err := AddrRecordFromProto(&pb)
+	if err != nil || rec.Expired(now) {
+		return nil
+	}
+	return rec
+}
+
 func (ka *knownAddress) isOld() bool {
 	return ka.BucketType == bucketTypeOld
 }
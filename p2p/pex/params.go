@@ -27,6 +27,16 @@ const (
 	// new buckets over which a source address group will be spread.
 	newBucketsPerGroup = 32
 
+	// max new addresses of a single network group (e.g. an IPv4 /16), so that
+	// a subnet can't fill the new buckets.
+	maxNewAddrsPerGroup = 256
+
+	// max old addresses of a single network group.
+	maxOldAddrsPerGroup = 32
+
+	// max new addresses coming from a single source.
+	maxNewAddrsPerSource = 512
+
 	// buckets a frequently seen new address may end up in.
 	maxNewBucketsPerAddress = 4
 
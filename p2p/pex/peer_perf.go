@@ -0,0 +1,94 @@
+package pex
+
+import (
+	"sort"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// maxPeerPerfs bounds the number of peers whose performance is remembered.
+	maxPeerPerfs = 1000
+
+	// perfRefSize is the amount of data, in bytes, used to weigh a peer's
+	// round-trip time against its throughput.
+	perfRefSize = 64 * 1024
+)
+
+// peerPerf is the last known performance of a peer.
+type peerPerf struct {
+	rtt      time.Duration
+	recvRate int64 // bytes/s
+}
+
+// cost estimates how long it takes to request and receive perfRefSize bytes
+// from the peer.
+func (pp peerPerf) cost() time.Duration {
+	cost := pp.rtt
+	if pp.recvRate > 0 {
+		cost += time.Duration(perfRefSize * int64(time.Second) / pp.recvRate)
+	}
+	return cost
+}
+
+// peerPerfs remembers the performance of the peers we have been connected
+// to, so that faster peers are preferred when choosing whom to dial.
+type peerPerfs struct {
+	mtx   cmtsync.Mutex
+	perfs map[p2p.ID]peerPerf
+}
+
+func newPeerPerfs() *peerPerfs {
+	return &peerPerfs{perfs: make(map[p2p.ID]peerPerf)}
+}
+
+// record saves the performance of a connected peer. Peers whose round-trip
+// time hasn't been measured yet are ignored.
+func (pp *peerPerfs) record(p Peer) {
+	status := p.Status()
+	if status.RTT == 0 {
+		return
+	}
+
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	if _, ok := pp.perfs[p.ID()]; !ok && len(pp.perfs) >= maxPeerPerfs {
+		// evict an arbitrary peer
+		for id := range pp.perfs {
+			delete(pp.perfs, id)
+			break
+		}
+	}
+	pp.perfs[p.ID()] = peerPerf{rtt: status.RTT, recvRate: status.RecvMonitor.AvgRate}
+}
+
+// sortByCost sorts addrs from the cheapest to the most expensive peer.
+// Peers of unknown performance are given the average cost of the known ones,
+// so that they are preferred to the slow peers but not to the fast ones.
+func (pp *peerPerfs) sortByCost(addrs []*p2p.NetAddress) {
+	pp.mtx.Lock()
+	costs := make(map[p2p.ID]time.Duration, len(addrs))
+	var total time.Duration
+	for _, addr := range addrs {
+		if perf, ok := pp.perfs[addr.ID]; ok {
+			costs[addr.ID] = perf.cost()
+			total += costs[addr.ID]
+		}
+	}
+	pp.mtx.Unlock()
+
+	if len(costs) == 0 {
+		return
+	}
+	avg := total / time.Duration(len(costs))
+	cost := func(addr *p2p.NetAddress) time.Duration {
+		if c, ok := costs[addr.ID]; ok {
+			return c
+		}
+		return avg
+	}
+	sort.SliceStable(addrs, func(i, j int) bool { return cost(addrs[i]) < cost(addrs[j]) })
+}
@@ -6,6 +6,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/cmap"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
@@ -30,6 +32,11 @@ const (
 	// small request results in up to maxMsgSize response
 	maxMsgSize = maxAddressSize * maxGetSelection
 
+	// Address records are sent along with the addresses, so their number is
+	// capped to keep responses under maxMsgSize for nodes predating them.
+	// A record takes about 200 bytes on top of its address.
+	maxSignedAddrsPerMsg = 100
+
 	// ensure we have enough peers
 	defaultEnsurePeersPeriod = 30 * time.Second
 
@@ -50,6 +57,8 @@ const (
 
 	// if a peer is marked bad, it will be banned for at least this time period
 	defaultBanTime = 24 * time.Hour
+
+	defaultAddrRecordTTL = 24 * time.Hour
 )
 
 type errMaxAttemptsToDial struct {
@@ -94,6 +103,13 @@ type Reactor struct {
 
 	attemptsToDial sync.Map // address (string) -> {number of attempts (int), last time dialed (time.Time)}
 
+	// performance of the peers we have been connected to
+	perfs *peerPerfs
+
+	// record of our address gossiped to peers
+	ourRecordMtx sync.Mutex
+	ourRecord    *AddrRecord
+
 	// seed/crawled mode fields
 	crawlPeerInfos map[p2p.ID]crawlPeerInfo
 }
@@ -120,6 +136,19 @@ type ReactorConfig struct {
 	// Seeds is a list of addresses reactor may use
 	// if it can't connect to peers in the addrbook.
 	Seeds []string
+
+	// PrivKey is the node's key, used to sign the record of our address
+	// gossiped to peers. If nil, no record is gossiped.
+	PrivKey crypto.PrivKey
+
+	// AddrRecordTTL is how long the record of our address remains valid.
+	// Defaults to defaultAddrRecordTTL, and is capped to
+	// config.MaxPexAddrRecordTTL, beyond which peers reject the records.
+	AddrRecordTTL time.Duration
+
+	// RequireSignedAddrs makes the reactor ignore the addresses which are
+	// received without a record signed by the node they advertise.
+	RequireSignedAddrs bool
 }
 
 type _attemptsToDial struct {
@@ -136,6 +165,7 @@ func NewReactor(b AddrBook, config *ReactorConfig) *Reactor {
 		requestsSent:         cmap.NewCMap(),
 		lastReceivedRequests: cmap.NewCMap(),
 		crawlPeerInfos:       make(map[p2p.ID]crawlPeerInfo),
+		perfs:                newPeerPerfs(),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("PEX", r)
 	return r
@@ -215,8 +245,10 @@ func (r *Reactor) AddPeer(p Peer) {
 	}
 }
 
-// RemovePeer implements Reactor by resetting peer's requests info.
+// RemovePeer implements Reactor by resetting peer's requests info and
+// remembering its performance.
 func (r *Reactor) RemovePeer(p Peer, reason interface{}) {
+	r.perfs.record(p)
 	id := string(p.ID())
 	r.requestsSent.Delete(id)
 	r.lastReceivedRequests.Delete(id)
@@ -284,10 +316,20 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
 			return
 		}
-		err = r.ReceiveAddrs(addrs, e.Src)
+		records := make([]*AddrRecord, 0, len(msg.SignedAddrs))
+		for i := range msg.SignedAddrs {
+			rec, err := AddrRecordFromProto(&msg.SignedAddrs[i])
+			if err != nil {
+				r.Switch.StopPeerForError(e.Src, err)
+				r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
+				return
+			}
+			records = append(records, rec)
+		}
+		err = r.receiveAddrs(addrs, records, e.Src)
 		if err != nil {
 			r.Switch.StopPeerForError(e.Src, err)
-			if err == ErrUnsolicitedList {
+			if _, ok := err.(ErrInvalidAddrRecord); ok || err == ErrUnsolicitedList {
 				r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
 			}
 			return
@@ -351,6 +393,13 @@ func (r *Reactor) RequestAddrs(p Peer) {
 // request for this peer and deletes the open request.
 // If there's no open request for the src peer, it returns an error.
 func (r *Reactor) ReceiveAddrs(addrs []*p2p.NetAddress, src Peer) error {
+	return r.receiveAddrs(addrs, nil, src)
+}
+
+// receiveAddrs is ReceiveAddrs with the signed records of some of the addrs,
+// which take precedence over the bare addresses. Expired records are
+// skipped, invalid ones fail with ErrInvalidAddrRecord.
+func (r *Reactor) receiveAddrs(addrs []*p2p.NetAddress, records []*AddrRecord, src Peer) error {
 	id := string(src.ID())
 	if !r.requestsSent.Has(id) {
 		return ErrUnsolicitedList
@@ -370,7 +419,32 @@ func (r *Reactor) ReceiveAddrs(addrs []*p2p.NetAddress, src Peer) error {
 		}
 	}
 
+	now := time.Now()
+	signed := make(map[p2p.ID]struct{}, len(records))
+	added := make([]*p2p.NetAddress, 0, len(addrs)+len(records))
+	for _, rec := range records {
+		if rec.Expired(now) {
+			continue
+		}
+		if err := rec.Verify(now); err != nil {
+			return ErrInvalidAddrRecord{Addr: rec.Addr, Err: err}
+		}
+		signed[rec.Addr.ID] = struct{}{}
+		if err := r.book.AddAddressRecord(rec, srcAddr); err != nil {
+			r.logErrAddrBook(err)
+			continue
+		}
+		added = append(added, rec.Addr)
+	}
+
 	for _, netAddr := range addrs {
+		if _, ok := signed[netAddr.ID]; ok {
+			continue
+		}
+		if r.config.RequireSignedAddrs {
+			r.Logger.Debug("Ignoring address without signed record", "addr", netAddr, "src", src)
+			continue
+		}
 		// NOTE: we check netAddr validity and routability in book#AddAddress.
 		err = r.book.AddAddress(netAddr, srcAddr)
 		if err != nil {
@@ -379,10 +453,13 @@ func (r *Reactor) ReceiveAddrs(addrs []*p2p.NetAddress, src Peer) error {
 			// peer here too?
 			continue
 		}
+		added = append(added, netAddr)
+	}
 
-		// If this address came from a seed node, try to connect to it without
-		// waiting (#2093)
-		if srcIsSeed {
+	// If these addresses came from a seed node, try to connect to them
+	// without waiting (#2093)
+	if srcIsSeed {
+		for _, netAddr := range added {
 			go func(addr *p2p.NetAddress) {
 				err := r.dialPeer(addr)
 				if err != nil {
@@ -400,15 +477,83 @@ func (r *Reactor) ReceiveAddrs(addrs []*p2p.NetAddress, src Peer) error {
 	return nil
 }
 
-// SendAddrs sends addrs to the peer.
+// SendAddrs sends addrs to the peer, along with the signed records we have
+// of them and of our own address.
 func (r *Reactor) SendAddrs(p Peer, netAddrs []*p2p.NetAddress) {
 	e := p2p.Envelope{
 		ChannelID: PexChannel,
-		Message:   &cmtp2p.PexAddrs{Addrs: p2p.NetAddressesToProto(netAddrs)},
+		Message: &cmtp2p.PexAddrs{
+			Addrs:       p2p.NetAddressesToProto(netAddrs),
+			SignedAddrs: r.addrRecords(netAddrs),
+		},
 	}
 	p.SendEnvelope(e)
 }
 
+// addrRecords returns the records of our address and of netAddrs, up to
+// maxSignedAddrsPerMsg.
+func (r *Reactor) addrRecords(netAddrs []*p2p.NetAddress) []cmtp2p.SignedAddress {
+	records := make([]*AddrRecord, 0, cmtmath.MinInt(len(netAddrs)+1, maxSignedAddrsPerMsg))
+	if rec := r.ourAddrRecord(); rec != nil {
+		records = append(records, rec)
+	}
+	for _, addr := range netAddrs {
+		if len(records) >= maxSignedAddrsPerMsg {
+			break
+		}
+		if rec := r.book.AddressRecord(addr.ID); rec != nil {
+			records = append(records, rec)
+		}
+	}
+
+	pbs := make([]cmtp2p.SignedAddress, 0, len(records))
+	for _, rec := range records {
+		pb, err := rec.ToProto()
+		if err != nil {
+			r.Logger.Error("Failed to encode address record", "addr", rec.Addr, "err", err)
+			continue
+		}
+		pbs = append(pbs, *pb)
+	}
+	return pbs
+}
+
+// ourAddrRecord returns the record of our address, signed anew when half of
+// its TTL has elapsed or our address changed. Returns nil if we have no key
+// or no valid address to advertise.
+func (r *Reactor) ourAddrRecord() *AddrRecord {
+	if r.config.PrivKey == nil || r.Switch == nil {
+		return nil
+	}
+	addr, err := r.Switch.NodeInfo().NetAddress()
+	if err != nil || addr.Valid() != nil {
+		return nil
+	}
+
+	r.ourRecordMtx.Lock()
+	defer r.ourRecordMtx.Unlock()
+
+	ttl := r.config.AddrRecordTTL
+	if ttl <= 0 {
+		ttl = defaultAddrRecordTTL
+	}
+	if ttl > config.MaxPexAddrRecordTTL {
+		ttl = config.MaxPexAddrRecordTTL
+	}
+	now := time.Now()
+	if r.ourRecord != nil && r.ourRecord.Addr.Equals(addr) && now.Add(ttl/2).Before(r.ourRecord.Expires) {
+		return r.ourRecord
+	}
+	// Use the time as sequence, so that it increases across restarts.
+	rec, err := NewAddrRecord(addr, uint64(now.UnixNano()), now.Add(ttl), r.config.PrivKey)
+	if err != nil {
+		r.Logger.Error("Failed to sign our address record", "addr", addr, "err", err)
+		return nil
+	}
+	r.ourRecord = rec
+	return rec
+}
+
 // SetEnsurePeersPeriod sets period to ensure peers connected.
 func (r *Reactor) SetEnsurePeersPeriod(d time.Duration) {
 	r.ensurePeersPeriod = d
@@ -472,11 +617,17 @@ func (r *Reactor) ensurePeers() {
 	// NOTE: range here is [10, 90]. Too high ?
 	newBias := cmtmath.MinInt(out, 8)*10 + 10
 
+	for _, p := range r.Switch.Peers().List() {
+		r.perfs.record(p)
+	}
+
 	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
+	// Try maxAttempts times to pick twice as many addresses as needed, and keep
+	// the numToDial fastest ones.
 	maxAttempts := numToDial * 3
+	numCandidates := numToDial * 2
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	for i := 0; i < maxAttempts && len(toDial) < numCandidates; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
@@ -492,6 +643,16 @@ func (r *Reactor) ensurePeers() {
 		// before dialing again, or have dialed too many times already
 		toDial[try.ID] = try
 	}
+	if len(toDial) > numToDial {
+		candidates := make([]*p2p.NetAddress, 0, len(toDial))
+		for _, addr := range toDial {
+			candidates = append(candidates, addr)
+		}
+		r.perfs.sortByCost(candidates)
+		for _, addr := range candidates[numToDial:] {
+			delete(toDial, addr.ID)
+		}
+	}
 
 	// Dial picked addresses
 	for _, addr := range toDial {
@@ -3,6 +3,7 @@ package pex
 import (
 	"encoding/hex"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
@@ -13,8 +14,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	flow "github.com/cometbft/cometbft/libs/flowrate"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
 	"github.com/cometbft/cometbft/p2p/mock"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
 )
@@ -138,6 +142,37 @@ func TestPEXReactorReceiveEnvelope(t *testing.T) {
 	r.ReceiveEnvelope(p2p.Envelope{ChannelID: PexChannel, Src: peer, Message: &tmp2p.PexRequest{}})
 }
 
+func TestPEXReactorReceiveQUICAddrs(t *testing.T) {
+	r, book := createReactor(&ReactorConfig{})
+	defer teardownReactor(book)
+
+	peer := p2p.CreateRandomPeer(false)
+	r.RequestAddrs(peer)
+
+	_, tcpAddr := p2p.CreateRoutableAddr()
+	_, quicAddr := p2p.CreateRoutableAddr()
+	quicAddr.Protocol = p2p.ProtocolQUIC
+
+	// the addresses go through the wire encoding
+	msg := &tmp2p.PexAddrs{Addrs: p2p.NetAddressesToProto([]*p2p.NetAddress{tcpAddr, quicAddr})}
+	bz, err := proto.Marshal(msg.Wrap())
+	require.NoError(t, err)
+	var pb tmp2p.Message
+	require.NoError(t, proto.Unmarshal(bz, &pb))
+	received, err := pb.Unwrap()
+	require.NoError(t, err)
+
+	r.ReceiveEnvelope(p2p.Envelope{ChannelID: PexChannel, Src: peer, Message: received})
+	require.Equal(t, 2, book.Size())
+
+	protocols := make(map[p2p.ID]string)
+	for _, addr := range book.GetSelection() {
+		protocols[addr.ID] = addr.Protocol
+	}
+	assert.Equal(t, "", protocols[tcpAddr.ID])
+	assert.Equal(t, p2p.ProtocolQUIC, protocols[quicAddr.ID])
+}
+
 func TestPEXReactorRequestMessageAbuse(t *testing.T) {
 	r, book := createReactor(&ReactorConfig{})
 	defer teardownReactor(book)
@@ -704,3 +739,131 @@ func TestPexVectors(t *testing.T) {
 		require.Equal(t, tc.expBytes, hex.EncodeToString(bz), tc.testName)
 	}
 }
+
+type perfPeer struct {
+	*mock.Peer
+	rtt      time.Duration
+	recvRate int64
+}
+
+func (p perfPeer) Status() conn.ConnectionStatus {
+	return conn.ConnectionStatus{
+		RTT:         p.rtt,
+		RecvMonitor: flow.Status{AvgRate: p.recvRate},
+	}
+}
+
+func TestPeerPerfsSortByCost(t *testing.T) {
+	perfs := newPeerPerfs()
+
+	slow := perfPeer{mock.NewPeer(nil), 300 * time.Millisecond, 1024 * 1024}
+	fast := perfPeer{mock.NewPeer(nil), 20 * time.Millisecond, 1024 * 1024}
+	lowThroughput := perfPeer{mock.NewPeer(nil), 20 * time.Millisecond, 64 * 1024}
+	unmeasured := perfPeer{mock.NewPeer(nil), 0, 1024 * 1024}
+	for _, p := range []perfPeer{slow, fast, lowThroughput, unmeasured} {
+		perfs.record(p)
+	}
+
+	unknown := mock.NewPeer(nil)
+	addrs := []*p2p.NetAddress{
+		unknown.SocketAddr(),
+		slow.SocketAddr(),
+		unmeasured.SocketAddr(),
+		lowThroughput.SocketAddr(),
+		fast.SocketAddr(),
+	}
+	perfs.sortByCost(addrs)
+
+	// peers of unknown performance get the average cost of the known ones
+	assert.Equal(t, []*p2p.NetAddress{
+		fast.SocketAddr(),
+		slow.SocketAddr(),
+		unknown.SocketAddr(),
+		unmeasured.SocketAddr(),
+		lowThroughput.SocketAddr(),
+	}, addrs)
+}
+
+func TestAddrRecordVerify(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	addr := p2p.NewNetAddressIPPort(net.ParseIP("1.2.3.4"), 26656)
+	addr.ID = p2p.PubKeyToID(privKey.PubKey())
+	now := time.Now()
+
+	_, err := NewAddrRecord(addr, 1, now.Add(time.Hour), ed25519.GenPrivKey())
+	require.Error(t, err, "key of another node")
+
+	rec, err := NewAddrRecord(addr, 1, now.Add(time.Hour), privKey)
+	require.NoError(t, err)
+	require.NoError(t, rec.Verify(now))
+
+	pb, err := rec.ToProto()
+	require.NoError(t, err)
+	decoded, err := AddrRecordFromProto(pb)
+	require.NoError(t, err)
+	require.NoError(t, decoded.Verify(now))
+	assert.True(t, rec.Equal(decoded))
+
+	decoded.Addr.Port++
+	assert.Error(t, decoded.Verify(now), "tampered address")
+	assert.Error(t, rec.Verify(now.Add(time.Hour)), "expired")
+
+	rec, err = NewAddrRecord(addr, 1, now.Add(2*config.MaxPexAddrRecordTTL), privKey)
+	require.NoError(t, err)
+	assert.Error(t, rec.Verify(now), "expires too late")
+}
+
+func TestPEXReactorReceiveSignedAddrs(t *testing.T) {
+	r, book := createReactor(&ReactorConfig{RequireSignedAddrs: true})
+	defer teardownReactor(book)
+
+	sw := createSwitchAndAddReactors(r)
+	sw.SetAddrBook(book)
+
+	peer := mock.NewPeer(nil)
+	p2p.AddPeerToSwitchPeerSet(sw, peer)
+	require.NoError(t, book.AddAddress(peer.SocketAddr(), peer.SocketAddr()))
+
+	newRecord := func(ip string) (*AddrRecord, tmp2p.SignedAddress) {
+		privKey := ed25519.GenPrivKey()
+		addr := p2p.NewNetAddressIPPort(net.ParseIP(ip), 26656)
+		addr.ID = p2p.PubKeyToID(privKey.PubKey())
+		rec, err := NewAddrRecord(addr, 1, time.Now().Add(time.Hour), privKey)
+		require.NoError(t, err)
+		pb, err := rec.ToProto()
+		require.NoError(t, err)
+		return rec, *pb
+	}
+	signed, signedPB := newRecord("1.2.3.4")
+	_, expiredPB := newRecord("1.2.3.5")
+	expiredPB.Expires = time.Now().Add(-time.Minute)
+
+	// bare addresses are ignored, even if they spoof a signed node's ID
+	_, unsignedAddr := p2p.CreateRoutableAddr()
+	spoofed := p2p.NewNetAddressIPPort(net.ParseIP("5.6.7.8"), 26656)
+	spoofed.ID = signed.Addr.ID
+	r.RequestAddrs(peer)
+	r.ReceiveEnvelope(p2p.Envelope{ChannelID: PexChannel, Src: peer, Message: &tmp2p.PexAddrs{
+		Addrs:       p2p.NetAddressesToProto([]*p2p.NetAddress{unsignedAddr, spoofed}),
+		SignedAddrs: []tmp2p.SignedAddress{signedPB, expiredPB},
+	}})
+	assert.True(t, sw.Peers().Has(peer.ID()))
+	assert.Equal(t, 2, book.Size())
+	assert.False(t, book.HasAddress(unsignedAddr))
+	rec := book.AddressRecord(signed.Addr.ID)
+	require.NotNil(t, rec)
+	assert.True(t, signed.Equal(rec))
+
+	// records are relayed to other peers
+	assert.Len(t, r.addrRecords([]*p2p.NetAddress{unsignedAddr, signed.Addr}), 1)
+
+	// a forged record causes a disconnect and ban
+	_, forgedPB := newRecord("1.2.3.6")
+	forgedPB.Addr.IP = "1.2.3.7"
+	r.RequestAddrs(peer)
+	r.ReceiveEnvelope(p2p.Envelope{ChannelID: PexChannel, Src: peer, Message: &tmp2p.PexAddrs{
+		SignedAddrs: []tmp2p.SignedAddress{forgedPB},
+	}})
+	assert.False(t, sw.Peers().Has(peer.ID()))
+	assert.True(t, book.IsBanned(peer.SocketAddr()))
+}
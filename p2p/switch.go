@@ -1,6 +1,7 @@
 package p2p
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"sync"
@@ -38,6 +39,8 @@ func MConnConfig(cfg *config.P2PConfig) conn.MConnConfig {
 	mConfig.SendRate = cfg.SendRate
 	mConfig.RecvRate = cfg.RecvRate
 	mConfig.MaxPacketMsgPayloadSize = cfg.MaxPacketMsgPayloadSize
+	// the rates were checked by P2PConfig.ValidateBasic
+	mConfig.ChannelSendRates, _ = config.ParseChannelRates(cfg.ChannelSendRates)
 	return mConfig
 }
 
@@ -88,11 +91,13 @@ type Switch struct {
 
 	filterTimeout time.Duration
 	peerFilters   []PeerFilterFunc
+	banList       *BanList
 
 	rng *rand.Rand // seed for randomizing dial times and orders
 
 	metrics *Metrics
 	mlc     *metricsLabelCache
+	capture *MessageCapture // nil unless capturing messages
 }
 
 // NetAddress returns the address the switch is listening on.
@@ -150,6 +155,17 @@ func SwitchPeerFilters(filters ...PeerFilterFunc) SwitchOption {
 	return func(sw *Switch) { sw.peerFilters = filters }
 }
 
+// SwitchBanList sets the list of banned peers. Banned peers are neither dialed
+// nor accepted.
+func SwitchBanList(bl *BanList) SwitchOption {
+	return func(sw *Switch) { sw.banList = bl }
+}
+
+// SwitchMessageCapture records the messages exchanged with all peers.
+func SwitchMessageCapture(c *MessageCapture) SwitchOption {
+	return func(sw *Switch) { sw.capture = c }
+}
+
 // WithMetrics sets the metrics.
 func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
@@ -412,6 +428,9 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 			return // success
 		} else if _, ok := err.(ErrCurrentlyDialingOrExistingAddress); ok {
 			return
+		} else if _, ok := err.(ErrPeerBanned); ok {
+			sw.Logger.Info("Not reconnecting to banned peer", "addr", addr, "err", err)
+			return
 		}
 
 		sw.Logger.Info("Error reconnecting to peer. Trying again", "tries", i, "err", err, "addr", addr)
@@ -436,6 +455,9 @@ func (sw *Switch) reconnectToPeer(addr *NetAddress) {
 			return // success
 		} else if _, ok := err.(ErrCurrentlyDialingOrExistingAddress); ok {
 			return
+		} else if _, ok := err.(ErrPeerBanned); ok {
+			sw.Logger.Info("Not reconnecting to banned peer", "addr", addr, "err", err)
+			return
 		}
 		sw.Logger.Info("Error reconnecting to peer. Trying again", "tries", i, "err", err, "addr", addr)
 	}
@@ -545,11 +567,17 @@ func (sw *Switch) dialPeersAsync(netAddrs []*NetAddress) {
 // DialPeerWithAddress dials the given peer and runs sw.addPeer if it connects
 // and authenticates successfully.
 // If we're currently dialing this address or it belongs to an existing peer,
-// ErrCurrentlyDialingOrExistingAddress is returned.
+// ErrCurrentlyDialingOrExistingAddress is returned. If the peer is banned,
+// ErrPeerBanned is returned.
 func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
 	if sw.IsDialingOrExistingAddress(addr) {
 		return ErrCurrentlyDialingOrExistingAddress{addr.String()}
 	}
+	if sw.banList != nil {
+		if entry, ok := sw.banList.IsBanned(addr.ID, addr.IP); ok {
+			return ErrPeerBanned{Entry: entry}
+		}
+	}
 
 	sw.dialing.Set(string(addr.ID), addr)
 	defer sw.dialing.Delete(string(addr.ID))
@@ -619,6 +647,42 @@ func (sw *Switch) AddPrivatePeerIDs(ids []string) error {
 	return nil
 }
 
+// BanPeer bans the given node ID, IP address or CIDR range for duration, or
+// forever if duration is zero, and disconnects the matching peers.
+func (sw *Switch) BanPeer(rule, reason string, duration time.Duration) (BanEntry, error) {
+	if sw.banList == nil {
+		return BanEntry{}, errors.New("ban list is disabled")
+	}
+	entry, err := sw.banList.Add(rule, reason, duration)
+	if err != nil {
+		return BanEntry{}, err
+	}
+	sw.Logger.Info("Banned peers", "rule", entry.Rule, "reason", reason, "expires", entry.Expires)
+
+	for _, p := range sw.peers.List() {
+		if _, ok := sw.banList.IsBanned(p.ID(), p.RemoteIP()); ok {
+			sw.StopPeerForError(p, ErrPeerBanned{Entry: entry})
+		}
+	}
+	return entry, nil
+}
+
+// UnbanPeer lifts the ban of the given rule.
+func (sw *Switch) UnbanPeer(rule string) error {
+	if sw.banList == nil {
+		return errors.New("ban list is disabled")
+	}
+	return sw.banList.Remove(rule)
+}
+
+// BannedPeers returns the entries of the ban list in effect.
+func (sw *Switch) BannedPeers() []BanEntry {
+	if sw.banList == nil {
+		return nil
+	}
+	return sw.banList.List()
+}
+
 func (sw *Switch) IsPeerPersistent(na *NetAddress) bool {
 	for _, pa := range sw.persistentPeersAddrs {
 		if pa.Equals(na) {
@@ -638,6 +702,7 @@ func (sw *Switch) acceptRoutine() {
 			metrics:       sw.metrics,
 			mlc:           sw.mlc,
 			isPersistent:  sw.IsPeerPersistent,
+			capture:       sw.capture,
 		})
 		if err != nil {
 			switch err := err.(type) {
@@ -743,6 +808,7 @@ func (sw *Switch) addOutboundPeerWithConfig(
 		msgTypeByChID: sw.msgTypeByChID,
 		metrics:       sw.metrics,
 		mlc:           sw.mlc,
+		capture:       sw.capture,
 	})
 	if err != nil {
 		if e, ok := err.(ErrRejected); ok {
@@ -782,6 +848,12 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	if sw.banList != nil {
+		if entry, ok := sw.banList.IsBanned(p.ID(), p.RemoteIP()); ok {
+			return ErrRejected{id: p.ID(), err: ErrPeerBanned{Entry: entry}, isFiltered: true}
+		}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
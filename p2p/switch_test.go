@@ -366,6 +366,57 @@ func TestSwitchPeerFilterDuplicate(t *testing.T) {
 	}
 }
 
+func TestSwitchBanPeer(t *testing.T) {
+	banList, err := NewBanList("")
+	require.NoError(t, err)
+	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc, SwitchBanList(banList))
+	err = sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	defer rp.Stop()
+
+	dial := func() Peer {
+		p, err := sw.transport.Dial(*rp.Addr(), peerConfig{
+			chDescs:      sw.chDescs,
+			onPeerError:  sw.StopPeerForError,
+			isPersistent: sw.IsPeerPersistent,
+			reactorsByCh: sw.reactorsByCh,
+		})
+		require.NoError(t, err)
+		return p
+	}
+
+	require.NoError(t, sw.addPeer(dial()))
+	require.Equal(t, 1, sw.Peers().Size())
+
+	// banning the peer disconnects it
+	_, err = sw.BanPeer(string(rp.ID()), "testing", 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sw.Peers().Size())
+	assert.Len(t, sw.BannedPeers(), 1)
+
+	// and rejects it afterwards
+	err = sw.addPeer(dial())
+	if errRej, ok := err.(ErrRejected); ok {
+		assert.True(t, errRej.IsFiltered())
+	} else {
+		t.Errorf("expected ErrRejected, got %v", err)
+	}
+	err = sw.DialPeerWithAddress(rp.Addr())
+	assert.IsType(t, ErrPeerBanned{}, err)
+
+	require.NoError(t, sw.UnbanPeer(string(rp.ID())))
+	require.NoError(t, sw.addPeer(dial()))
+}
+
 func assertNoPeersAfterTimeout(t *testing.T, sw *Switch, timeout time.Duration) {
 	time.Sleep(timeout)
 	if sw.Peers().Size() != 0 {
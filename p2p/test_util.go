@@ -219,6 +219,103 @@ func MakeSwitch(
 	return sw
 }
 
+//------------------------------------------------------------------
+// Connects switches through a MemoryNetwork. Used for testing.
+
+// MakeMemorySwitch returns a switch on the in-memory network, listening on a
+// new address of the network.
+func MakeMemorySwitch(
+	network *MemoryNetwork,
+	cfg *config.P2PConfig,
+	i int,
+	initSwitch func(int, *Switch) *Switch,
+	opts ...SwitchOption,
+) *Switch {
+
+	nodeKey := NodeKey{
+		PrivKey: ed25519.GenPrivKey(),
+	}
+	addr := network.NewAddress(nodeKey.ID())
+	nodeInfo := DefaultNodeInfo{
+		ProtocolVersion: defaultProtocolVersion,
+		DefaultNodeID:   nodeKey.ID(),
+		ListenAddr:      addr.DialString(),
+		Network:         "testing",
+		Version:         "1.2.3-rc0-deadbeef",
+		Moniker:         fmt.Sprintf("node%d", i),
+		Other: DefaultNodeInfoOther{
+			TxIndex: "on",
+		},
+	}
+
+	t := NewMemoryTransport(network, nodeInfo, nodeKey)
+	if err := t.Listen(*addr); err != nil {
+		panic(err)
+	}
+
+	sw := initSwitch(i, NewSwitch(cfg, t, opts...))
+	sw.SetLogger(log.TestingLogger().With("switch", i))
+	sw.SetNodeKey(&nodeKey)
+
+	for ch := range sw.reactorsByCh {
+		nodeInfo.Channels = append(nodeInfo.Channels, ch)
+	}
+	t.nodeInfo = nodeInfo
+	sw.SetNodeInfo(nodeInfo)
+
+	return sw
+}
+
+// MakeConnectedMemorySwitches returns n started switches on the in-memory
+// network, connected according to the connect func. If
+// connect==ConnectMemorySwitches, the switches will be fully connected, which
+// requires cfg.MaxNumInboundPeers to be at least n.
+// NOTE: panics if any switch fails to start.
+func MakeConnectedMemorySwitches(
+	network *MemoryNetwork,
+	cfg *config.P2PConfig,
+	n int,
+	initSwitch func(int, *Switch) *Switch,
+	connect func([]*Switch, int, int),
+) []*Switch {
+	switches := make([]*Switch, n)
+	for i := 0; i < n; i++ {
+		switches[i] = MakeMemorySwitch(network, cfg, i, initSwitch)
+	}
+
+	if err := StartSwitches(switches); err != nil {
+		panic(err)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			connect(switches, i, j)
+		}
+	}
+
+	return switches
+}
+
+// ConnectMemorySwitches makes switch i dial switch j through their
+// MemoryTransports. Blocks until both switches have added the other as a
+// peer.
+// NOTE: caller ensures i and j are within bounds.
+func ConnectMemorySwitches(switches []*Switch, i, j int) {
+	switchI := switches[i]
+	switchJ := switches[j]
+
+	addr := switchJ.NetAddress()
+	if err := switchI.DialPeerWithAddress(addr); err != nil {
+		panic(err)
+	}
+	for !switchJ.Peers().Has(switchI.NodeInfo().ID()) {
+		if !switchI.Peers().Has(addr.ID) {
+			panic(fmt.Sprintf("switch %d rejected switch %d", j, i))
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
 func testInboundPeerConn(
 	conn net.Conn,
 	config *config.P2PConfig,
@@ -53,6 +53,7 @@ type peerConfig struct {
 	msgTypeByChID map[byte]proto.Message
 	metrics       *Metrics
 	mlc           *metricsLabelCache
+	capture       *MessageCapture
 }
 
 // Transport emits and connects to Peers. The implementation of Peer is left to
@@ -133,6 +134,12 @@ func MultiplexTransportMaxIncomingConnections(n int) MultiplexTransportOption {
 	return func(mt *MultiplexTransport) { mt.maxIncomingConnections = n }
 }
 
+// MultiplexTransportBanList sets the BanList checked against the IP addresses
+// and node IDs of incoming and outgoing connections.
+func MultiplexTransportBanList(bl *BanList) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.banList = bl }
+}
+
 // MultiplexTransport accepts and dials tcp connections and upgrades them to
 // multiplexed peers.
 type MultiplexTransport struct {
@@ -146,6 +153,7 @@ type MultiplexTransport struct {
 	// Lookup table for duplicate ip and id checks.
 	conns       ConnSet
 	connFilters []ConnFilterFunc
+	banList     *BanList
 
 	dialTimeout      time.Duration
 	filterTimeout    time.Duration
@@ -195,18 +203,23 @@ func (mt *MultiplexTransport) Accept(cfg peerConfig) (Peer, error) {
 	// This case should never have any side-effectful/blocking operations to
 	// ensure that quality peers are ready to be used.
 	case a := <-mt.acceptc:
-		if a.err != nil {
-			return nil, a.err
-		}
-
-		cfg.outbound = false
-
-		return mt.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+		return mt.acceptPeer(a, cfg)
 	case <-mt.closec:
 		return nil, ErrTransportClosed{}
 	}
 }
 
+// acceptPeer turns an upgraded inbound connection into a Peer.
+func (mt *MultiplexTransport) acceptPeer(a accept, cfg peerConfig) (Peer, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	cfg.outbound = false
+
+	return mt.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+}
+
 // Dial implements Transport.
 func (mt *MultiplexTransport) Dial(
 	addr NetAddress,
@@ -377,6 +390,10 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 		return err
 	}
 
+	if err := checkBanned(mt.banList, c, "", ips); err != nil {
+		return err
+	}
+
 	errc := make(chan error, len(mt.connFilters))
 
 	for _, f := range mt.connFilters {
@@ -438,6 +455,10 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	if err := checkBanned(mt.banList, c, connID, nil); err != nil {
+		return nil, nil, err
+	}
+
 	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
 	if err != nil {
 		return nil, nil, ErrRejected{
@@ -447,8 +468,18 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	if err := checkPeerNodeInfo(c, connID, mt.nodeInfo, nodeInfo); err != nil {
+		return nil, nil, err
+	}
+
+	return secretConn, nodeInfo, nil
+}
+
+// checkPeerNodeInfo validates the NodeInfo received from the peer
+// authenticated as connID over c, and checks it is compatible with ours.
+func checkPeerNodeInfo(c net.Conn, connID ID, ourNodeInfo, nodeInfo NodeInfo) error {
 	if err := nodeInfo.Validate(); err != nil {
-		return nil, nil, ErrRejected{
+		return ErrRejected{
 			conn:              c,
 			err:               err,
 			isNodeInfoInvalid: true,
@@ -457,7 +488,7 @@ func (mt *MultiplexTransport) upgrade(
 
 	// Ensure connection key matches self reported key.
 	if connID != nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+		return ErrRejected{
 			conn: c,
 			id:   connID,
 			err: fmt.Errorf(
@@ -470,8 +501,8 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	// Reject self.
-	if mt.nodeInfo.ID() == nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+	if ourNodeInfo.ID() == nodeInfo.ID() {
+		return ErrRejected{
 			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
 			conn:   c,
 			id:     nodeInfo.ID(),
@@ -479,8 +510,8 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
-		return nil, nil, ErrRejected{
+	if err := ourNodeInfo.CompatibleWith(nodeInfo); err != nil {
+		return ErrRejected{
 			conn:           c,
 			err:            err,
 			id:             nodeInfo.ID(),
@@ -488,7 +519,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
+	return nil
 }
 
 func (mt *MultiplexTransport) wrapPeer(
@@ -527,6 +558,7 @@ func (mt *MultiplexTransport) wrapPeer(
 		cfg.onPeerError,
 		cfg.mlc,
 		PeerMetrics(cfg.metrics),
+		PeerMessageCapture(cfg.capture),
 	)
 
 	return p
@@ -591,6 +623,23 @@ func upgradeSecretConn(
 	return sc, sc.SetDeadline(time.Time{})
 }
 
+// checkBanned returns an ErrRejected if the node ID or one of the IPs of c is
+// banned by bl, which may be nil.
+func checkBanned(bl *BanList, c net.Conn, id ID, ips []net.IP) error {
+	if bl == nil {
+		return nil
+	}
+	if entry, ok := bl.IsBanned(id, nil); ok {
+		return ErrRejected{conn: c, id: id, err: ErrPeerBanned{Entry: entry}, isFiltered: true}
+	}
+	for _, ip := range ips {
+		if entry, ok := bl.IsBanned("", ip); ok {
+			return ErrRejected{conn: c, err: ErrPeerBanned{Entry: entry}, isFiltered: true}
+		}
+	}
+	return nil
+}
+
 func resolveIPs(resolver IPResolver, c net.Conn) ([]net.IP, error) {
 	host, _, err := net.SplitHostPort(c.RemoteAddr().String())
 	if err != nil {
@@ -0,0 +1,518 @@
+package p2p
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// memoryListenPort is the port of the addresses handed out by
+// MemoryNetwork.NewAddress.
+const memoryListenPort = 26656
+
+// LinkConfig describes the conditions of a link between two nodes of a
+// MemoryNetwork. The zero value is an ideal link: messages are delivered on
+// the next call to Advance, with no loss.
+type LinkConfig struct {
+	// Latency is the one-way delay of every message.
+	Latency time.Duration
+	// Jitter is the maximum extra delay, drawn uniformly for every message.
+	// Messages are still delivered in order.
+	Jitter time.Duration
+	// Bandwidth is the rate of the link in bytes per second, 0 for
+	// unlimited. Messages queue behind each other like on a real link.
+	Bandwidth int64
+	// Loss is the probability that a message is silently dropped.
+	Loss float64
+}
+
+// memoryLink is the state of a link in one direction.
+type memoryLink struct {
+	cfg         LinkConfig
+	busyUntil   time.Time // when the last queued message leaves
+	lastArrival time.Time // when the last queued message arrives
+}
+
+type memoryLinkKey struct {
+	from, to ID
+}
+
+// memoryEvent is a message delivery scheduled on the virtual clock.
+type memoryEvent struct {
+	at  time.Time
+	seq uint64 // breaks ties in scheduling order
+	fn  func()
+}
+
+type memoryEventHeap []*memoryEvent
+
+func (h memoryEventHeap) Len() int { return len(h) }
+func (h memoryEventHeap) Less(i, j int) bool {
+	if h[i].at.Equal(h[j].at) {
+		return h[i].seq < h[j].seq
+	}
+	return h[i].at.Before(h[j].at)
+}
+func (h memoryEventHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *memoryEventHeap) Push(x interface{}) { *h = append(*h, x.(*memoryEvent)) }
+func (h *memoryEventHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	ev := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return ev
+}
+
+// MemoryNetwork simulates a network between MemoryTransports in a single
+// process. Messages are not delivered as soon as they are sent: each one is
+// scheduled on a virtual clock according to the conditions of its link
+// (latency, jitter, bandwidth and loss), and delivered to the receiving
+// reactor when the clock is advanced past its arrival time.
+//
+// Given the same seed and the same sequence of sends, deliveries happen in
+// the same order at the same virtual times. Every peer passes the messages it
+// receives to its reactors in a routine of its own, and the clock only moves
+// on once they are all idle. Reactors keep using wall-clock timers, so tests
+// usually advance the clock from a background routine with RunClock.
+type MemoryNetwork struct {
+	advanceMtx cmtsync.Mutex // serializes Advance
+
+	// number of messages handed to the started peers and not yet passed to
+	// their reactors, idle is signaled when it drops to 0
+	busyMtx sync.Mutex
+	idle    *sync.Cond
+	busy    int
+
+	mtx         cmtsync.Mutex
+	now         time.Time
+	seq         uint64
+	events      memoryEventHeap
+	rng         *rand.Rand
+	defaultLink LinkConfig
+	linkConfigs map[memoryLinkKey]LinkConfig
+	links       map[memoryLinkKey]*memoryLink
+	partitions  map[ID]int // partition of every node, nil when not partitioned
+	transports  map[ID]*MemoryTransport
+	nextIP      uint32
+}
+
+// NewMemoryNetwork returns an empty network whose links all have the
+// conditions of defaultLink, with seed driving jitter and loss.
+func NewMemoryNetwork(seed int64, defaultLink LinkConfig) *MemoryNetwork {
+	n := &MemoryNetwork{
+		now:         time.Unix(0, 0).UTC(),
+		rng:         rand.New(rand.NewSource(seed)), //nolint:gosec
+		defaultLink: defaultLink,
+		linkConfigs: make(map[memoryLinkKey]LinkConfig),
+		links:       make(map[memoryLinkKey]*memoryLink),
+		transports:  make(map[ID]*MemoryTransport),
+	}
+	n.idle = sync.NewCond(&n.busyMtx)
+	return n
+}
+
+// NewAddress returns a new address on the network for the node with the
+// given ID. Every address has its own IP.
+func (n *MemoryNetwork) NewAddress(id ID) *NetAddress {
+	n.mtx.Lock()
+	n.nextIP++
+	ipn := n.nextIP
+	n.mtx.Unlock()
+
+	addr := NewNetAddressIPPort(net.IPv4(10, byte(ipn>>16), byte(ipn>>8), byte(ipn)), memoryListenPort)
+	addr.ID = id
+	return addr
+}
+
+// Now returns the current virtual time.
+func (n *MemoryNetwork) Now() time.Time {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.now
+}
+
+// Advance moves the virtual clock forward by d, delivering the messages
+// arriving until then in order. The messages arriving at the same time are
+// handed to their peers together, and the clock moves on once the peers have
+// passed them all to their reactors, so that the messages the reactors send
+// in response are scheduled at the time of arrival.
+func (n *MemoryNetwork) Advance(d time.Duration) {
+	n.advanceMtx.Lock()
+	defer n.advanceMtx.Unlock()
+
+	n.mtx.Lock()
+	target := n.now.Add(d)
+	n.mtx.Unlock()
+
+	for {
+		n.mtx.Lock()
+		if len(n.events) == 0 || n.events[0].at.After(target) {
+			n.now = target
+			n.mtx.Unlock()
+			return
+		}
+		var batch []*memoryEvent
+		at := n.events[0].at
+		for len(n.events) > 0 && n.events[0].at.Equal(at) {
+			batch = append(batch, heap.Pop(&n.events).(*memoryEvent))
+		}
+		n.now = at
+		n.mtx.Unlock()
+
+		for _, ev := range batch {
+			ev.fn()
+		}
+		n.waitIdle()
+	}
+}
+
+// addBusy adds delta to the number of messages handed to the started peers
+// and not yet passed to their reactors.
+func (n *MemoryNetwork) addBusy(delta int) {
+	n.busyMtx.Lock()
+	defer n.busyMtx.Unlock()
+	n.busy += delta
+	if n.busy == 0 {
+		n.idle.Broadcast()
+	}
+}
+
+// waitIdle waits until the peers have passed all the messages handed to them
+// to their reactors.
+func (n *MemoryNetwork) waitIdle() {
+	n.busyMtx.Lock()
+	defer n.busyMtx.Unlock()
+	for n.busy > 0 {
+		n.idle.Wait()
+	}
+}
+
+// RunClock advances the virtual clock by step every interval of wall-clock
+// time, until the returned function is called.
+func (n *MemoryNetwork) RunClock(step, interval time.Duration) (stop func()) {
+	quit := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				n.Advance(step)
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(quit)
+			<-done
+		})
+	}
+}
+
+// SetDefaultLink sets the conditions of the links which were not configured
+// with SetLink.
+func (n *MemoryNetwork) SetDefaultLink(cfg LinkConfig) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.defaultLink = cfg
+	for key, link := range n.links {
+		if _, ok := n.linkConfigs[key]; !ok {
+			link.cfg = cfg
+		}
+	}
+}
+
+// SetLink sets the conditions of the link between a and b, in both
+// directions.
+func (n *MemoryNetwork) SetLink(a, b ID, cfg LinkConfig) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	for _, key := range []memoryLinkKey{{a, b}, {b, a}} {
+		n.linkConfigs[key] = cfg
+		if link, ok := n.links[key]; ok {
+			link.cfg = cfg
+		}
+	}
+}
+
+// Partition splits the network: nodes of different groups can neither dial
+// each other nor exchange messages, which are silently dropped. Nodes in no
+// group form a group of their own. Existing connections are kept, like with
+// a real partition until the peers time out.
+func (n *MemoryNetwork) Partition(groups ...[]ID) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.partitions = make(map[ID]int)
+	for i, group := range groups {
+		for _, id := range group {
+			n.partitions[id] = i + 1
+		}
+	}
+}
+
+// Heal removes the partitions set by Partition.
+func (n *MemoryNetwork) Heal() {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.partitions = nil
+}
+
+// Must be called with n.mtx held.
+func (n *MemoryNetwork) reachable(from, to ID) bool {
+	return n.partitions == nil || n.partitions[from] == n.partitions[to]
+}
+
+// Must be called with n.mtx held.
+func (n *MemoryNetwork) link(from, to ID) *memoryLink {
+	key := memoryLinkKey{from, to}
+	link, ok := n.links[key]
+	if !ok {
+		cfg, ok := n.linkConfigs[key]
+		if !ok {
+			cfg = n.defaultLink
+		}
+		link = &memoryLink{cfg: cfg}
+		n.links[key] = link
+	}
+	return link
+}
+
+// linkLatency returns the configured latency from one node to the other.
+func (n *MemoryNetwork) linkLatency(from, to ID) time.Duration {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	return n.link(from, to).cfg.Latency
+}
+
+// schedule schedules deliver for when a message of the given size sent now
+// arrives, unless it is lost. Messages on a link arrive in the order they
+// were sent.
+func (n *MemoryNetwork) schedule(from, to ID, size int, deliver func()) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	if !n.reachable(from, to) {
+		return
+	}
+	link := n.link(from, to)
+	if link.cfg.Loss > 0 && n.rng.Float64() < link.cfg.Loss {
+		return
+	}
+
+	depart := n.now
+	if link.busyUntil.After(depart) {
+		depart = link.busyUntil
+	}
+	if link.cfg.Bandwidth > 0 {
+		depart = depart.Add(time.Duration(int64(size) * int64(time.Second) / link.cfg.Bandwidth))
+	}
+	link.busyUntil = depart
+
+	arrive := depart.Add(link.cfg.Latency)
+	if link.cfg.Jitter > 0 {
+		arrive = arrive.Add(time.Duration(n.rng.Int63n(int64(link.cfg.Jitter))))
+	}
+	if arrive.Before(link.lastArrival) {
+		arrive = link.lastArrival
+	}
+	link.lastArrival = arrive
+
+	n.seq++
+	heap.Push(&n.events, &memoryEvent{at: arrive, seq: n.seq, fn: deliver})
+}
+
+//-------------------------------------------------------------------------
+
+// memoryAccept carries the dialing end of a connection to the Accept method
+// of the dialed transport, which replies with the accepting end, or nil if
+// it rejected the connection.
+type memoryAccept struct {
+	dialer   *memoryPeer
+	addr     NetAddress
+	nodeInfo NodeInfo
+	reply    chan *memoryPeer
+}
+
+// MemoryTransport is a Transport connecting to the other transports of a
+// MemoryNetwork, without any socket. Connections are established
+// immediately, whatever the conditions of the links, and the messages of
+// their peers are delivered through the network.
+type MemoryTransport struct {
+	network  *MemoryNetwork
+	netAddr  NetAddress
+	nodeInfo NodeInfo
+	nodeKey  NodeKey
+
+	acceptc   chan memoryAccept
+	closec    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ Transport = (*MemoryTransport)(nil)
+var _ transportLifecycle = (*MemoryTransport)(nil)
+
+// NewMemoryTransport returns a transport on the network for the given node.
+func NewMemoryTransport(network *MemoryNetwork, nodeInfo NodeInfo, nodeKey NodeKey) *MemoryTransport {
+	return &MemoryTransport{
+		network:  network,
+		nodeInfo: nodeInfo,
+		nodeKey:  nodeKey,
+		acceptc:  make(chan memoryAccept),
+		closec:   make(chan struct{}),
+	}
+}
+
+// NetAddress implements Transport.
+func (mt *MemoryTransport) NetAddress() NetAddress {
+	return mt.netAddr
+}
+
+// Listen implements transportLifecycle. It makes the transport reachable at
+// addr, whose ID must be the node's.
+func (mt *MemoryTransport) Listen(addr NetAddress) error {
+	if addr.ID != mt.nodeKey.ID() {
+		return fmt.Errorf("address ID %v does not match node ID %v", addr.ID, mt.nodeKey.ID())
+	}
+
+	mt.network.mtx.Lock()
+	defer mt.network.mtx.Unlock()
+	if _, ok := mt.network.transports[addr.ID]; ok {
+		return fmt.Errorf("node %v is already listening", addr.ID)
+	}
+	mt.netAddr = addr
+	mt.network.transports[addr.ID] = mt
+	return nil
+}
+
+// Close implements transportLifecycle.
+func (mt *MemoryTransport) Close() error {
+	mt.closeOnce.Do(func() {
+		close(mt.closec)
+		mt.network.mtx.Lock()
+		if mt.network.transports[mt.netAddr.ID] == mt {
+			delete(mt.network.transports, mt.netAddr.ID)
+		}
+		mt.network.mtx.Unlock()
+	})
+	return nil
+}
+
+// Accept implements Transport.
+func (mt *MemoryTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	case a := <-mt.acceptc:
+		if err := mt.checkNodeInfo(a.addr.ID, a.nodeInfo); err != nil {
+			a.reply <- nil
+			return nil, err
+		}
+		cfg.outbound = false
+		p := mt.wrapPeer(a.nodeInfo, cfg, &a.addr)
+		p.remote = a.dialer
+		a.reply <- p
+		return p, nil
+	case <-mt.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (mt *MemoryTransport) Dial(addr NetAddress, cfg peerConfig) (Peer, error) {
+	mt.network.mtx.Lock()
+	target, ok := mt.network.transports[addr.ID]
+	reachable := mt.network.reachable(mt.netAddr.ID, addr.ID)
+	mt.network.mtx.Unlock()
+	if !ok || target.netAddr.DialString() != addr.DialString() {
+		return nil, fmt.Errorf("dial %v: connection refused", addr)
+	}
+	if !reachable {
+		return nil, fmt.Errorf("dial %v: network is unreachable", addr)
+	}
+	if err := mt.checkNodeInfo(addr.ID, target.nodeInfo); err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+	p := mt.wrapPeer(target.nodeInfo, cfg, &addr)
+	a := memoryAccept{
+		dialer:   p,
+		addr:     mt.netAddr,
+		nodeInfo: mt.nodeInfo,
+		reply:    make(chan *memoryPeer, 1),
+	}
+	select {
+	case target.acceptc <- a:
+	case <-target.closec:
+		return nil, fmt.Errorf("dial %v: connection refused", addr)
+	case <-mt.closec:
+		return nil, ErrTransportClosed{}
+	case <-time.After(defaultDialTimeout):
+		return nil, fmt.Errorf("dial %v: timeout", addr)
+	}
+
+	remote := <-a.reply
+	if remote == nil {
+		return nil, fmt.Errorf("dial %v: connection rejected", addr)
+	}
+	p.remote = remote
+	return p, nil
+}
+
+// Cleanup implements Transport.
+func (mt *MemoryTransport) Cleanup(p Peer) {
+	_ = p.CloseConn()
+}
+
+// checkNodeInfo performs the checks done on the NodeInfo of the remote node
+// during the handshake of a MultiplexTransport.
+func (mt *MemoryTransport) checkNodeInfo(connID ID, nodeInfo NodeInfo) error {
+	if err := nodeInfo.Validate(); err != nil {
+		return ErrRejected{err: err, isNodeInfoInvalid: true}
+	}
+	if connID != nodeInfo.ID() {
+		return ErrRejected{
+			id:            connID,
+			err:           fmt.Errorf("conn.ID (%v) NodeInfo.ID (%v) mismatch", connID, nodeInfo.ID()),
+			isAuthFailure: true,
+		}
+	}
+	if mt.nodeInfo.ID() == nodeInfo.ID() {
+		return ErrRejected{addr: mt.netAddr, id: nodeInfo.ID(), isSelf: true}
+	}
+	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
+		return ErrRejected{err: err, id: nodeInfo.ID(), isIncompatible: true}
+	}
+	return nil
+}
+
+func (mt *MemoryTransport) wrapPeer(ni NodeInfo, cfg peerConfig, socketAddr *NetAddress) *memoryPeer {
+	persistent := false
+	if cfg.isPersistent != nil {
+		if cfg.outbound {
+			persistent = cfg.isPersistent(socketAddr)
+		} else {
+			selfReportedAddr, err := ni.NetAddress()
+			if err == nil {
+				persistent = cfg.isPersistent(selfReportedAddr)
+			}
+		}
+	}
+
+	return newMemoryPeer(mt.network, mt.nodeKey.ID(), cfg.outbound, persistent, socketAddr, ni, cfg)
+}
+
+// errMemoryConnClosed is reported to a peer when the remote end of the
+// connection is closed.
+var errMemoryConnClosed = errors.New("connection closed by remote peer")
@@ -0,0 +1,167 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	p2pproto "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+func TestMemoryNetworkSchedule(t *testing.T) {
+	network := NewMemoryNetwork(1, LinkConfig{Latency: 100 * time.Millisecond})
+	start := network.Now()
+
+	var arrivals []time.Duration
+	record := func() { arrivals = append(arrivals, network.Now().Sub(start)) }
+
+	// latency
+	network.schedule("a", "b", 10, record)
+	network.Advance(99 * time.Millisecond)
+	assert.Empty(t, arrivals)
+	network.Advance(time.Millisecond)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond}, arrivals)
+
+	// bandwidth: messages queue behind each other
+	arrivals = nil
+	network.SetLink("a", "b", LinkConfig{Latency: 10 * time.Millisecond, Bandwidth: 1000})
+	network.schedule("a", "b", 100, record)
+	network.schedule("a", "b", 100, record)
+	network.Advance(time.Second)
+	assert.Equal(t, []time.Duration{210 * time.Millisecond, 310 * time.Millisecond}, arrivals)
+
+	// jitter keeps messages in order
+	arrivals = nil
+	network.SetLink("a", "b", LinkConfig{Latency: 10 * time.Millisecond, Jitter: 50 * time.Millisecond})
+	for i := 0; i < 100; i++ {
+		network.schedule("a", "b", 1, record)
+	}
+	network.Advance(time.Second)
+	require.Len(t, arrivals, 100)
+	for i := 1; i < len(arrivals); i++ {
+		assert.GreaterOrEqual(t, arrivals[i], arrivals[i-1])
+	}
+
+	// partitions drop messages until healed
+	arrivals = nil
+	network.Partition([]ID{"a"})
+	network.schedule("a", "b", 1, record)
+	network.schedule("a", "c", 1, record)
+	network.schedule("b", "c", 1, record)
+	network.Advance(time.Second)
+	assert.Len(t, arrivals, 1)
+	network.Heal()
+	network.schedule("a", "b", 1, record)
+	network.Advance(time.Second)
+	assert.Len(t, arrivals, 2)
+}
+
+func TestMemoryNetworkLossIsDeterministic(t *testing.T) {
+	delivered := func(seed int64) []int {
+		network := NewMemoryNetwork(seed, LinkConfig{Loss: 0.3, Jitter: time.Millisecond})
+		var ids []int
+		for i := 0; i < 1000; i++ {
+			i := i
+			network.schedule("a", "b", 1, func() { ids = append(ids, i) })
+		}
+		network.Advance(time.Second)
+		return ids
+	}
+
+	ids := delivered(1)
+	assert.InDelta(t, 700, len(ids), 60)
+	assert.Equal(t, ids, delivered(1))
+	assert.NotEqual(t, ids, delivered(2))
+}
+
+func TestMemoryNetworkAdvanceWaitsForReactors(t *testing.T) {
+	network := NewMemoryNetwork(1, LinkConfig{Latency: 10 * time.Millisecond})
+	cfg := config.DefaultP2PConfig()
+	switches := MakeConnectedMemorySwitches(network, cfg, 2, initSwitchFunc, ConnectMemorySwitches)
+	t.Cleanup(func() {
+		for _, sw := range switches {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	// a slow reactor holds the clock back until it is done
+	reactor := switches[1].Reactor("foo").(*TestReactor)
+	reactor.mtx.Lock()
+	msg := &p2pproto.PexAddrs{Addrs: []p2pproto.NetAddress{{ID: "1"}}}
+	for range switches[0].BroadcastEnvelope(Envelope{ChannelID: 0x00, Message: msg}) {
+	}
+	advanced := make(chan struct{})
+	go func() {
+		network.Advance(time.Second)
+		close(advanced)
+	}()
+	select {
+	case <-advanced:
+		t.Fatal("Advance returned before the reactor received the message")
+	case <-time.After(100 * time.Millisecond):
+	}
+	reactor.mtx.Unlock()
+	<-advanced
+	assert.Len(t, reactor.getMsgs(0x00), 1)
+}
+
+func TestMemorySwitches(t *testing.T) {
+	const n = 50
+
+	network := NewMemoryNetwork(1, LinkConfig{Latency: 50 * time.Millisecond})
+	cfg := config.DefaultP2PConfig()
+	cfg.MaxNumInboundPeers = n
+	switches := MakeConnectedMemorySwitches(network, cfg, n, initSwitchFunc, ConnectMemorySwitches)
+	t.Cleanup(func() {
+		for _, sw := range switches {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	for _, sw := range switches {
+		require.Equal(t, n-1, sw.Peers().Size())
+	}
+
+	msg := &p2pproto.PexAddrs{Addrs: []p2pproto.NetAddress{{ID: "1"}}}
+	for range switches[0].BroadcastEnvelope(Envelope{ChannelID: 0x00, Message: msg}) {
+	}
+
+	// nothing arrives before the latency of the links has elapsed
+	network.Advance(49 * time.Millisecond)
+	for _, sw := range switches[1:] {
+		assert.Empty(t, sw.Reactor("foo").(*TestReactor).getMsgs(0x00))
+	}
+	// Advance returns once the reactors have received the messages
+	network.Advance(time.Millisecond)
+	for _, sw := range switches[1:] {
+		assert.Len(t, sw.Reactor("foo").(*TestReactor).getMsgs(0x00), 1)
+	}
+	assert.True(t, proto.Equal(msg, switches[1].Reactor("foo").(*TestReactor).getMsgs(0x00)[0].Contents))
+
+	p := switches[0].Peers().Get(switches[1].NodeInfo().ID())
+	require.NotNil(t, p)
+	assert.Equal(t, 100*time.Millisecond, p.Status().RTT)
+
+	// messages sent across a partition are lost
+	network.Partition([]ID{switches[0].NodeInfo().ID()})
+	for range switches[0].BroadcastEnvelope(Envelope{ChannelID: 0x01, Message: msg}) {
+	}
+	for range switches[1].BroadcastEnvelope(Envelope{ChannelID: 0x01, Message: msg}) {
+	}
+	network.Advance(time.Second)
+	assert.Len(t, switches[0].Reactor("foo").(*TestReactor).getMsgs(0x01), 0)
+	assert.Len(t, switches[2].Reactor("foo").(*TestReactor).getMsgs(0x01), 1)
+
+	// stopping a peer disconnects the remote end
+	switches[0].StopPeerGracefully(p)
+	assert.Eventually(t, func() bool {
+		return !switches[1].Peers().Has(switches[0].NodeInfo().ID())
+	}, 5*time.Second, 10*time.Millisecond)
+}
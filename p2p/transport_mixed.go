@@ -0,0 +1,57 @@
+package p2p
+
+// MixedTransport combines a TCP MultiplexTransport with a QUICTransport.
+// Peers are accepted on both, while addresses are dialed over QUIC if they
+// carry the quic:// scheme and over TCP otherwise.
+//
+// The underlying transports are started and stopped by their owner, not by
+// MixedTransport.
+type MixedTransport struct {
+	tcp  *MultiplexTransport
+	quic *QUICTransport
+}
+
+var _ Transport = (*MixedTransport)(nil)
+
+// NewMixedTransport returns a transport that accepts and dials peers over both
+// tcp and quic.
+func NewMixedTransport(tcp *MultiplexTransport, quic *QUICTransport) *MixedTransport {
+	return &MixedTransport{tcp: tcp, quic: quic}
+}
+
+// NetAddress implements Transport. It returns the TCP listening address, which
+// is the one advertised to other peers.
+func (mt *MixedTransport) NetAddress() NetAddress {
+	return mt.tcp.NetAddress()
+}
+
+// Accept implements Transport.
+func (mt *MixedTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	case a := <-mt.tcp.acceptc:
+		return mt.tcp.acceptPeer(a, cfg)
+	case a := <-mt.quic.acceptc:
+		return mt.quic.acceptPeer(a, cfg)
+	case <-mt.tcp.closec:
+		return nil, ErrTransportClosed{}
+	case <-mt.quic.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (mt *MixedTransport) Dial(addr NetAddress, cfg peerConfig) (Peer, error) {
+	if addr.IsQUIC() {
+		return mt.quic.Dial(addr, cfg)
+	}
+	return mt.tcp.Dial(addr, cfg)
+}
+
+// Cleanup implements Transport.
+func (mt *MixedTransport) Cleanup(p Peer) {
+	if _, ok := p.(*quicPeer); ok {
+		mt.quic.Cleanup(p)
+		return
+	}
+	mt.tcp.Cleanup(p)
+}
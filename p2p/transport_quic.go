@@ -0,0 +1,640 @@
+package p2p
+
+import (
+	"context"
+	stded25519 "crypto/ed25519"
+	crand "crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+// quicALPN is the TLS application protocol negotiated by QUIC peers.
+const quicALPN = "cometbft-p2p/1"
+
+// quicAccept carries an upgraded QUIC connection and NodeInfo from an
+// asynchronously running routine to the Accept method.
+type quicAccept struct {
+	netAddr  *NetAddress
+	conn     *quicConn
+	nodeInfo NodeInfo
+	err      error
+}
+
+// QUICTransportOption sets an optional parameter on the QUICTransport.
+type QUICTransportOption func(*QUICTransport)
+
+// QUICTransportConnFilters sets the filters for rejection new connections.
+func QUICTransportConnFilters(filters ...ConnFilterFunc) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.connFilters = filters }
+}
+
+// QUICTransportBanList sets the BanList checked against the IP addresses and
+// node IDs of incoming and outgoing connections.
+func QUICTransportBanList(bl *BanList) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.banList = bl }
+}
+
+// QUICTransportMaxIncomingConnections sets the maximum number of simultaneous
+// incoming connections. Default: 0 (unlimited)
+func QUICTransportMaxIncomingConnections(n int) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.maxIncomingConnections = int32(n) }
+}
+
+// QUICTransport accepts and dials QUIC connections and turns them into peers.
+// Unlike MultiplexTransport, which multiplexes all channels over a single
+// MConnection, every reactor channel is mapped to its own QUIC stream, so that
+// a slow channel cannot head-of-line block the others.
+//
+// Peers are authenticated with their node key: each node presents a
+// self-signed TLS certificate for its ed25519 node key, and the peer ID is
+// derived from the certificate's public key. Once the QUIC handshake is done,
+// NodeInfo is exchanged over a dedicated control stream exactly like over a
+// SecretConnection.
+type QUICTransport struct {
+	netAddr                NetAddress
+	pconn                  net.PacketConn
+	listener               quic.Listener
+	maxIncomingConnections int32 // see QUICTransportMaxIncomingConnections
+	numIncomingConnections int32 // atomic
+
+	acceptc chan quicAccept
+	closec  chan struct{}
+
+	// Lookup table for duplicate ip and id checks.
+	conns       ConnSet
+	connFilters []ConnFilterFunc
+	banList     *BanList
+
+	dialTimeout      time.Duration
+	filterTimeout    time.Duration
+	handshakeTimeout time.Duration
+	nodeInfo         NodeInfo
+	nodeKey          NodeKey
+	resolver         IPResolver
+	tlsConfig        *tls.Config
+
+	mConfig conn.MConnConfig
+}
+
+var _ Transport = (*QUICTransport)(nil)
+var _ transportLifecycle = (*QUICTransport)(nil)
+
+// NewQUICTransport returns a QUIC transport for the given node. It fails if
+// the node key is not an ed25519 key.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+	options ...QUICTransportOption,
+) (*QUICTransport, error) {
+	tlsConfig, err := quicTLSConfig(nodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	qt := &QUICTransport{
+		acceptc:          make(chan quicAccept),
+		closec:           make(chan struct{}),
+		dialTimeout:      defaultDialTimeout,
+		filterTimeout:    defaultFilterTimeout,
+		handshakeTimeout: defaultHandshakeTimeout,
+		mConfig:          mConfig,
+		nodeInfo:         nodeInfo,
+		nodeKey:          nodeKey,
+		conns:            NewConnSet(),
+		resolver:         net.DefaultResolver,
+		tlsConfig:        tlsConfig,
+	}
+	for _, option := range options {
+		option(qt)
+	}
+	return qt, nil
+}
+
+// NetAddress implements Transport.
+func (qt *QUICTransport) NetAddress() NetAddress {
+	return qt.netAddr
+}
+
+// Accept implements Transport.
+func (qt *QUICTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	case a := <-qt.acceptc:
+		return qt.acceptPeer(a, cfg)
+	case <-qt.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// acceptPeer turns an upgraded inbound connection into a Peer.
+func (qt *QUICTransport) acceptPeer(a quicAccept, cfg peerConfig) (Peer, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	cfg.outbound = false
+
+	return qt.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+}
+
+// Dial implements Transport.
+func (qt *QUICTransport) Dial(addr NetAddress, cfg peerConfig) (Peer, error) {
+	udpAddr, err := net.ResolveUDPAddr("udp", addr.DialString())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.dialTimeout+qt.handshakeTimeout)
+	defer cancel()
+
+	var qc quic.Connection
+	if qt.pconn != nil {
+		// Dial from the listening socket, so that peers see our listening port.
+		qc, err = quic.DialContext(ctx, qt.pconn, udpAddr, addr.DialString(), qt.tlsConfig, qt.quicConfig())
+	} else {
+		qc, err = quic.DialAddrContext(ctx, addr.DialString(), qt.tlsConfig, qt.quicConfig())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := newQUICConn(qc)
+	if err := qt.filterConn(c); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := qt.upgrade(c, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return qt.wrapPeer(c, nodeInfo, cfg, &addr), nil
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	close(qt.closec)
+
+	if qt.listener != nil {
+		if err := qt.listener.Close(); err != nil {
+			return err
+		}
+	}
+	if qt.pconn != nil {
+		return qt.pconn.Close()
+	}
+
+	return nil
+}
+
+// Listen implements transportLifecycle.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	pconn, err := net.ListenPacket("udp", addr.DialString())
+	if err != nil {
+		return err
+	}
+
+	ln, err := quic.Listen(pconn, qt.tlsConfig, qt.quicConfig())
+	if err != nil {
+		_ = pconn.Close()
+		return err
+	}
+
+	addr.Protocol = ProtocolQUIC
+	qt.netAddr = addr
+	qt.pconn = pconn
+	qt.listener = ln
+
+	go qt.acceptPeers()
+
+	return nil
+}
+
+// AddChannel registers a channel to nodeInfo.
+// NOTE: NodeInfo must be of type DefaultNodeInfo else channels won't be updated
+func (qt *QUICTransport) AddChannel(chID byte) {
+	if ni, ok := qt.nodeInfo.(DefaultNodeInfo); ok {
+		if !ni.HasChannel(chID) {
+			ni.Channels = append(ni.Channels, chID)
+		}
+		qt.nodeInfo = ni
+	}
+}
+
+// Cleanup removes the given peer's connection from the connections set and
+// closes it.
+func (qt *QUICTransport) Cleanup(p Peer) {
+	qt.conns.RemoveAddr(p.RemoteAddr())
+	if qp, ok := p.(*quicPeer); ok && !qp.outbound {
+		qt.releaseIncoming(qp.conn)
+	}
+	_ = p.CloseConn()
+}
+
+func (qt *QUICTransport) acceptPeers() {
+	for {
+		qc, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			qt.acceptc <- quicAccept{err: err}
+			return
+		}
+
+		c := newQUICConn(qc)
+		if max := qt.maxIncomingConnections; max > 0 {
+			if atomic.AddInt32(&qt.numIncomingConnections, 1) > max {
+				atomic.AddInt32(&qt.numIncomingConnections, -1)
+				_ = qc.CloseWithError(0, "too many connections")
+				continue
+			}
+			atomic.StoreInt32(&c.countedIncoming, 1)
+		}
+
+		// Connection upgrade and filtering are asynchronous to avoid
+		// head-of-line blocking, see MultiplexTransport.acceptPeers.
+		go func(c *quicConn) {
+			defer func() {
+				if r := recover(); r != nil {
+					err := ErrRejected{
+						conn:          c,
+						err:           fmt.Errorf("recovered from panic: %v", r),
+						isAuthFailure: true,
+					}
+					select {
+					case qt.acceptc <- quicAccept{err: err}:
+					case <-qt.closec:
+						// Give up if the transport was closed.
+						_ = c.Close()
+						qt.releaseIncoming(c)
+						return
+					}
+				}
+			}()
+
+			var (
+				nodeInfo NodeInfo
+				netAddr  *NetAddress
+			)
+
+			err := qt.filterConn(c)
+			if err == nil {
+				nodeInfo, err = qt.upgrade(c, nil)
+				if err == nil {
+					netAddr = NewNetAddress(nodeInfo.ID(), c.RemoteAddr())
+				}
+			}
+			if err != nil {
+				qt.releaseIncoming(c)
+			}
+
+			select {
+			case qt.acceptc <- quicAccept{netAddr, c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = c.Close()
+				qt.releaseIncoming(c)
+				return
+			}
+		}(c)
+	}
+}
+
+// releaseIncoming frees the incoming connection slot taken by c, if any.
+func (qt *QUICTransport) releaseIncoming(c *quicConn) {
+	// the accept routine and Cleanup may both release the slot
+	if atomic.CompareAndSwapInt32(&c.countedIncoming, 1, 0) {
+		atomic.AddInt32(&qt.numIncomingConnections, -1)
+	}
+}
+
+func (qt *QUICTransport) cleanup(c *quicConn) error {
+	qt.conns.Remove(c)
+
+	return c.Close()
+}
+
+func (qt *QUICTransport) filterConn(c *quicConn) (err error) {
+	defer func() {
+		if err != nil {
+			_ = c.Close()
+		}
+	}()
+
+	// Reject if connection is already present.
+	if qt.conns.Has(c) {
+		return ErrRejected{conn: c, isDuplicate: true}
+	}
+
+	// Resolve ips for incoming conn.
+	ips, err := resolveIPs(qt.resolver, c)
+	if err != nil {
+		return err
+	}
+
+	if err := checkBanned(qt.banList, c, "", ips); err != nil {
+		return err
+	}
+
+	errc := make(chan error, len(qt.connFilters))
+
+	for _, f := range qt.connFilters {
+		go func(f ConnFilterFunc, c net.Conn, ips []net.IP, errc chan<- error) {
+			errc <- f(qt.conns, c, ips)
+		}(f, c, ips, errc)
+	}
+
+	for i := 0; i < cap(errc); i++ {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return ErrRejected{conn: c, err: err, isFiltered: true}
+			}
+		case <-time.After(qt.filterTimeout):
+			return ErrFilterTimeout{}
+		}
+	}
+
+	qt.conns.Set(c, ips)
+
+	return nil
+}
+
+// upgrade authenticates the remote node and exchanges NodeInfo with it over
+// the control stream, which is opened by the dialing side.
+func (qt *QUICTransport) upgrade(
+	c *quicConn,
+	dialedAddr *NetAddress,
+) (nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			_ = qt.cleanup(c)
+		}
+	}()
+
+	remotePubKey, err := quicRemotePubKey(c.qconn)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("quic auth failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	// For outgoing conns, ensure connection key matches dialed key.
+	connID := PubKeyToID(remotePubKey)
+	if dialedAddr != nil {
+		if dialedID := dialedAddr.ID; connID != dialedID {
+			return nil, ErrRejected{
+				conn: c,
+				id:   connID,
+				err: fmt.Errorf(
+					"conn.ID (%v) dialed ID (%v) mismatch",
+					connID,
+					dialedID,
+				),
+				isAuthFailure: true,
+			}
+		}
+	}
+
+	if err := checkBanned(qt.banList, c, connID, nil); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.handshakeTimeout)
+	defer cancel()
+
+	var ctrl quic.Stream
+	if dialedAddr != nil {
+		ctrl, err = c.qconn.OpenStreamSync(ctx)
+	} else {
+		ctrl, err = c.qconn.AcceptStream(ctx)
+	}
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("opening control stream failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+	c.ctrl = ctrl
+
+	nodeInfo, err = handshake(c, qt.handshakeTimeout, qt.nodeInfo)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("handshake failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	if err := checkPeerNodeInfo(c, connID, qt.nodeInfo, nodeInfo); err != nil {
+		return nil, err
+	}
+
+	return nodeInfo, nil
+}
+
+func (qt *QUICTransport) wrapPeer(
+	c *quicConn,
+	ni NodeInfo,
+	cfg peerConfig,
+	socketAddr *NetAddress,
+) Peer {
+
+	persistent := false
+	if cfg.isPersistent != nil {
+		if cfg.outbound {
+			persistent = cfg.isPersistent(socketAddr)
+		} else {
+			selfReportedAddr, err := ni.NetAddress()
+			if err == nil {
+				persistent = cfg.isPersistent(selfReportedAddr)
+			}
+		}
+	}
+
+	return newQUICPeer(
+		c,
+		cfg.outbound,
+		persistent,
+		socketAddr,
+		ni,
+		cfg.reactorsByCh,
+		cfg.msgTypeByChID,
+		cfg.chDescs,
+		cfg.onPeerError,
+		cfg.mlc,
+		cfg.metrics,
+		cfg.capture,
+		qt.mConfig,
+	)
+}
+
+// quicConfig derives the QUIC connection parameters from the MConnection
+// config, so that dead peers are detected within the same delays.
+func (qt *QUICTransport) quicConfig() *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout: qt.handshakeTimeout,
+		MaxIdleTimeout:       qt.mConfig.PingInterval + qt.mConfig.PongTimeout,
+		KeepAlivePeriod:      qt.mConfig.PingInterval,
+		// one stream per channel, plus the control stream.
+		MaxIncomingStreams: 256 + 1,
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+// quicTLSConfig returns a TLS configuration presenting a self-signed
+// certificate for the node key, and accepting any peer presenting a valid
+// self-signed ed25519 certificate. Peers are authenticated by their ID (i.e.
+// their key) afterwards, not by a certificate chain.
+func quicTLSConfig(nodeKey NodeKey) (*tls.Config, error) {
+	privKey, ok := nodeKey.PrivKey.(ed25519.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("QUIC transport requires an ed25519 node key, got %T", nodeKey.PrivKey)
+	}
+	stdPrivKey := stded25519.PrivateKey(privKey)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: string(nodeKey.ID())},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(100 * 365 * 24 * time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(crand.Reader, template, template, stdPrivKey.Public(), stdPrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("creating QUIC certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{certDER},
+			PrivateKey:  stdPrivKey,
+		}},
+		ClientAuth: tls.RequireAnyClientCert,
+		// The certificate is not signed by a CA, so we verify it ourselves in
+		// VerifyPeerCertificate.
+		InsecureSkipVerify:    true, //nolint:gosec
+		VerifyPeerCertificate: verifyQUICPeerCertificate,
+		NextProtos:            []string{quicALPN},
+		MinVersion:            tls.VersionTLS13,
+	}, nil
+}
+
+// verifyQUICPeerCertificate checks that the peer presented a single
+// self-signed certificate for an ed25519 key. TLS 1.3 then proves that the
+// peer holds the corresponding private key.
+func verifyQUICPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) != 1 {
+		return fmt.Errorf("expected exactly one certificate, got %d", len(rawCerts))
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := cert.PublicKey.(stded25519.PublicKey); !ok {
+		return fmt.Errorf("expected an ed25519 certificate, got %T", cert.PublicKey)
+	}
+	return cert.CheckSignatureFrom(cert)
+}
+
+// quicRemotePubKey returns the node key of the remote end of qc.
+func quicRemotePubKey(qc quic.Connection) (crypto.PubKey, error) {
+	certs := qc.ConnectionState().TLS.PeerCertificates
+	if len(certs) == 0 {
+		return nil, errors.New("no peer certificate")
+	}
+	pubKey, ok := certs[0].PublicKey.(stded25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an ed25519 certificate, got %T", certs[0].PublicKey)
+	}
+	return ed25519.PubKey(pubKey), nil
+}
+
+//-----------------------------------------------------------------------------
+
+// quicConn adapts a QUIC connection and its control stream to net.Conn, so
+// that it can go through the same filters and NodeInfo handshake as TCP
+// connections. Reads and writes go to the control stream.
+type quicConn struct {
+	qconn quic.Connection
+	ctrl  quic.Stream
+
+	// countedIncoming is 1 if the connection takes an incoming slot (atomic).
+	countedIncoming int32
+}
+
+var _ net.Conn = (*quicConn)(nil)
+
+func newQUICConn(qc quic.Connection) *quicConn {
+	return &quicConn{qconn: qc}
+}
+
+func (c *quicConn) Read(b []byte) (int, error) {
+	if c.ctrl == nil {
+		return 0, errors.New("quic control stream is not open")
+	}
+	return c.ctrl.Read(b)
+}
+
+func (c *quicConn) Write(b []byte) (int, error) {
+	if c.ctrl == nil {
+		return 0, errors.New("quic control stream is not open")
+	}
+	return c.ctrl.Write(b)
+}
+
+// Close closes the whole QUIC connection, not only the control stream.
+func (c *quicConn) Close() error {
+	return c.qconn.CloseWithError(0, "closed")
+}
+
+func (c *quicConn) LocalAddr() net.Addr  { return c.qconn.LocalAddr() }
+func (c *quicConn) RemoteAddr() net.Addr { return c.qconn.RemoteAddr() }
+
+func (c *quicConn) SetDeadline(t time.Time) error {
+	if c.ctrl == nil {
+		return nil
+	}
+	return c.ctrl.SetDeadline(t)
+}
+
+func (c *quicConn) SetReadDeadline(t time.Time) error {
+	if c.ctrl == nil {
+		return nil
+	}
+	return c.ctrl.SetReadDeadline(t)
+}
+
+func (c *quicConn) SetWriteDeadline(t time.Time) error {
+	if c.ctrl == nil {
+		return nil
+	}
+	return c.ctrl.SetWriteDeadline(t)
+}
@@ -0,0 +1,164 @@
+package p2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p/conn"
+	p2pproto "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+func testSetupQUICTransport(t *testing.T, name string) *QUICTransport {
+	pv := ed25519.GenPrivKey()
+	id := PubKeyToID(pv.PubKey())
+	qt, err := NewQUICTransport(testNodeInfo(id, name), NodeKey{PrivKey: pv}, conn.DefaultMConnConfig())
+	require.NoError(t, err)
+
+	addr, err := NewNetAddressString(IDAddressString(id, "quic://127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, qt.Listen(*addr))
+	t.Cleanup(func() { _ = qt.Close() })
+
+	return qt
+}
+
+func TestQUICTransportRequiresEd25519Key(t *testing.T) {
+	pv := secp256k1.GenPrivKey()
+	_, err := NewQUICTransport(testNodeInfo(PubKeyToID(pv.PubKey()), "quic"), NodeKey{PrivKey: pv}, conn.DefaultMConnConfig())
+	require.Error(t, err)
+}
+
+func TestQUICTransportDialAccept(t *testing.T) {
+	var (
+		listener = testSetupQUICTransport(t, "listener")
+		dialer   = testSetupQUICTransport(t, "dialer")
+	)
+
+	addr := NewNetAddress(listener.nodeInfo.ID(), listener.listener.Addr())
+	require.True(t, addr.IsQUIC())
+
+	errc := make(chan error, 1)
+	go func() {
+		p, err := dialer.Dial(*addr, peerConfig{})
+		if err == nil {
+			assert.True(t, p.IsOutbound())
+			assert.Equal(t, listener.nodeInfo.ID(), p.ID())
+		}
+		errc <- err
+	}()
+
+	p, err := listener.Accept(peerConfig{})
+	require.NoError(t, err)
+	require.NoError(t, <-errc)
+
+	assert.False(t, p.IsOutbound())
+	assert.Equal(t, dialer.nodeInfo.ID(), p.ID())
+	assert.Equal(t, dialer.nodeInfo.(DefaultNodeInfo).Moniker, p.NodeInfo().(DefaultNodeInfo).Moniker)
+}
+
+func TestQUICTransportDialRejectWrongID(t *testing.T) {
+	var (
+		listener = testSetupQUICTransport(t, "listener")
+		dialer   = testSetupQUICTransport(t, "dialer")
+	)
+
+	wrongID := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	addr := NewNetAddress(wrongID, listener.listener.Addr())
+
+	_, err := dialer.Dial(*addr, peerConfig{})
+	require.Error(t, err)
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsAuthFailure())
+}
+
+// makeQUICSwitch returns a started switch accepting and dialing peers over
+// QUIC only, with the reactors of initSwitchFunc.
+func makeQUICSwitch(t *testing.T, i int) (*Switch, *QUICTransport) {
+	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
+	qt, err := NewQUICTransport(testNodeInfo(nodeKey.ID(), fmt.Sprintf("node%d", i)), nodeKey, MConnConfig(cfg))
+	require.NoError(t, err)
+
+	addr, err := NewNetAddressString(IDAddressString(nodeKey.ID(), "quic://127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, qt.Listen(*addr))
+
+	sw := initSwitchFunc(i, NewSwitch(cfg, qt))
+	sw.SetLogger(log.TestingLogger().With("switch", i))
+	sw.SetNodeKey(&nodeKey)
+	for ch := range sw.reactorsByCh {
+		qt.AddChannel(ch)
+	}
+	sw.SetNodeInfo(qt.nodeInfo)
+
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	return sw, qt
+}
+
+func TestQUICSwitchesChannelStreams(t *testing.T) {
+	s1, _ := makeQUICSwitch(t, 0)
+	s2, qt2 := makeQUICSwitch(t, 1)
+
+	addr := NewNetAddress(s2.NodeInfo().ID(), qt2.listener.Addr())
+	require.NoError(t, s1.DialPeerWithAddress(addr))
+	require.Eventually(t, func() bool {
+		return s1.Peers().Size() == 1 && s2.Peers().Size() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// interleave the messages of the channels, each channel having its own
+	// stream
+	const msgsPerChannel = 50
+	channels := []byte{0x00, 0x01, 0x02, 0x03}
+	for i := 0; i < msgsPerChannel; i++ {
+		for _, ch := range channels {
+			msg := &p2pproto.PexAddrs{Addrs: []p2pproto.NetAddress{{ID: fmt.Sprintf("%d-%d", ch, i), Port: uint32(i)}}}
+			require.True(t, s1.Peers().List()[0].SendEnvelope(Envelope{ChannelID: ch, Message: msg}))
+		}
+	}
+
+	for _, ch := range channels {
+		reactor := s2.Reactor("foo").(*TestReactor)
+		if ch >= 0x02 {
+			reactor = s2.Reactor("bar").(*TestReactor)
+		}
+		require.Eventually(t, func() bool {
+			return len(reactor.getMsgs(ch)) == msgsPerChannel
+		}, 5*time.Second, 10*time.Millisecond, "channel %X", ch)
+
+		// the messages of a channel are received in order
+		for i, msg := range reactor.getMsgs(ch) {
+			addrs := msg.Contents.(*p2pproto.PexAddrs).Addrs
+			require.Len(t, addrs, 1)
+			assert.Equal(t, fmt.Sprintf("%d-%d", ch, i), addrs[0].ID)
+		}
+	}
+}
+
+func TestQUICPeerChannelSendRates(t *testing.T) {
+	mConfig := conn.DefaultMConnConfig()
+	mConfig.ChannelSendRates = map[byte]int64{0x01: 1000}
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: 0x01, Priority: 1},
+		{ID: 0x02, Priority: 1},
+	}
+	p := newQUICPeer(nil, true, false, nil, testNodeInfo(PubKeyToID(ed25519.GenPrivKey().PubKey()), "quic"),
+		nil, nil, chDescs, nil, newMetricsLabelCache(), nil, nil, mConfig)
+
+	limits := make(map[byte]int64)
+	for _, ch := range p.Status().Channels {
+		limits[ch.ID] = ch.SendRateLimit
+	}
+	assert.Equal(t, map[byte]int64{0x01: 1000, 0x02: 0}, limits)
+}
@@ -5,17 +5,22 @@ package p2p
 
 import (
 	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -59,15 +64,94 @@ func (m *PexRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PexRequest proto.InternalMessageInfo
 
+// SignedAddress is a node's address signed with the node's key, so that it
+// can be relayed by other peers without being tampered with.
+type SignedAddress struct {
+	Addr      NetAddress       `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr"`
+	Sequence  uint64           `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Expires   time.Time        `protobuf:"bytes,3,opt,name=expires,proto3,stdtime" json:"expires"`
+	PubKey    crypto.PublicKey `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"`
+	Signature []byte           `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedAddress) Reset()         { *m = SignedAddress{} }
+func (m *SignedAddress) String() string { return proto.CompactTextString(m) }
+func (*SignedAddress) ProtoMessage()    {}
+func (*SignedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81c2f011fd13be57, []int{1}
+}
+func (m *SignedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedAddress.Merge(m, src)
+}
+func (m *SignedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedAddress proto.InternalMessageInfo
+
+func (m *SignedAddress) GetAddr() NetAddress {
+	if m != nil {
+		return m.Addr
+	}
+	return NetAddress{}
+}
+
+func (m *SignedAddress) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *SignedAddress) GetExpires() time.Time {
+	if m != nil {
+		return m.Expires
+	}
+	return time.Time{}
+}
+
+func (m *SignedAddress) GetPubKey() crypto.PublicKey {
+	if m != nil {
+		return m.PubKey
+	}
+	return crypto.PublicKey{}
+}
+
+func (m *SignedAddress) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type PexAddrs struct {
-	Addrs []NetAddress `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs"`
+	Addrs       []NetAddress    `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs"`
+	SignedAddrs []SignedAddress `protobuf:"bytes,2,rep,name=signed_addrs,json=signedAddrs,proto3" json:"signed_addrs"`
 }
 
 func (m *PexAddrs) Reset()         { *m = PexAddrs{} }
 func (m *PexAddrs) String() string { return proto.CompactTextString(m) }
 func (*PexAddrs) ProtoMessage()    {}
 func (*PexAddrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81c2f011fd13be57, []int{1}
+	return fileDescriptor_81c2f011fd13be57, []int{2}
 }
 func (m *PexAddrs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -103,6 +187,13 @@ func (m *PexAddrs) GetAddrs() []NetAddress {
 	return nil
 }
 
+func (m *PexAddrs) GetSignedAddrs() []SignedAddress {
+	if m != nil {
+		return m.SignedAddrs
+	}
+	return nil
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Message_PexRequest
@@ -114,7 +205,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81c2f011fd13be57, []int{2}
+	return fileDescriptor_81c2f011fd13be57, []int{3}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,6 +281,7 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 
 func init() {
 	proto.RegisterType((*PexRequest)(nil), "tendermint.p2p.PexRequest")
+	proto.RegisterType((*SignedAddress)(nil), "tendermint.p2p.SignedAddress")
 	proto.RegisterType((*PexAddrs)(nil), "tendermint.p2p.PexAddrs")
 	proto.RegisterType((*Message)(nil), "tendermint.p2p.Message")
 }
@@ -197,25 +289,36 @@ func init() {
 func init() { proto.RegisterFile("tendermint/p2p/pex.proto", fileDescriptor_81c2f011fd13be57) }
 
 var fileDescriptor_81c2f011fd13be57 = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x49, 0xcd, 0x4b,
-	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x30, 0x2a, 0xd0, 0x2f, 0x48, 0xad, 0xd0, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x43, 0xc8, 0xe8, 0x15, 0x18, 0x15, 0x48, 0x49, 0xa1, 0xa9,
-	0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x86, 0xa8, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5,
-	0x41, 0x2c, 0x88, 0xa8, 0x12, 0x0f, 0x17, 0x57, 0x40, 0x6a, 0x45, 0x50, 0x6a, 0x61, 0x69, 0x6a,
-	0x71, 0x89, 0x92, 0x13, 0x17, 0x47, 0x40, 0x6a, 0x85, 0x63, 0x4a, 0x4a, 0x51, 0xb1, 0x90, 0x19,
-	0x17, 0x6b, 0x22, 0x88, 0x21, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0x6a, 0x97,
-	0x9e, 0x5f, 0x6a, 0x09, 0x48, 0x61, 0x6a, 0x71, 0xb1, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x10, 0xe5, 0x4a, 0x1d, 0x8c, 0x5c, 0xec, 0xbe, 0xa9, 0xc5, 0xc5, 0x89, 0xe9, 0xa9, 0x42, 0xb6,
-	0x5c, 0xdc, 0x05, 0xa9, 0x15, 0xf1, 0x45, 0x10, 0xe3, 0x25, 0x18, 0x15, 0x18, 0xb1, 0x99, 0x84,
-	0x70, 0x80, 0x07, 0x43, 0x10, 0x57, 0x01, 0x9c, 0x27, 0x64, 0xce, 0xc5, 0x09, 0xd2, 0x0e, 0x71,
-	0x06, 0x13, 0x58, 0xb3, 0x04, 0x16, 0xcd, 0x60, 0xf7, 0x7a, 0x30, 0x04, 0x71, 0x14, 0x40, 0xd9,
-	0x4e, 0xac, 0x5c, 0xcc, 0xc5, 0xa5, 0xb9, 0x4e, 0xde, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0x65, 0x98, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f,
-	0x9c, 0x9f, 0x9b, 0x5a, 0x92, 0x94, 0x56, 0x82, 0x60, 0x40, 0x42, 0x09, 0x35, 0x2c, 0x93, 0xd8,
-	0xc0, 0xa2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0xad, 0x52, 0xe1, 0x8e, 0x01, 0x00,
-	0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x3d, 0x8f, 0xd3, 0x40,
+	0x10, 0xf5, 0x5e, 0x92, 0x4b, 0x6e, 0x12, 0x28, 0x56, 0x14, 0x96, 0x15, 0x9c, 0x28, 0x55, 0x2a,
+	0x5b, 0x04, 0x04, 0x05, 0x02, 0x89, 0x14, 0xe8, 0xa4, 0x08, 0x14, 0x19, 0x2a, 0x9a, 0x28, 0xb6,
+	0xe7, 0x8c, 0x75, 0x67, 0xef, 0xe2, 0x59, 0x4b, 0xf6, 0x3f, 0x40, 0x54, 0xf7, 0xb3, 0xae, 0xbc,
+	0x92, 0x0a, 0x50, 0xf2, 0x37, 0x28, 0x90, 0xbf, 0xe2, 0xcb, 0x09, 0x24, 0xba, 0xf9, 0x78, 0xef,
+	0xed, 0x9b, 0x99, 0x05, 0x5d, 0x61, 0xec, 0x63, 0x12, 0x85, 0xb1, 0xb2, 0xe5, 0x42, 0xda, 0x12,
+	0x33, 0x4b, 0x26, 0x42, 0x09, 0xfe, 0xb0, 0xed, 0x58, 0x72, 0x21, 0x0d, 0xe3, 0x1e, 0x52, 0xe5,
+	0x12, 0xa9, 0xc2, 0x1a, 0xe3, 0x3b, 0x3d, 0x2f, 0xc9, 0xa5, 0x12, 0xf6, 0x25, 0xe6, 0x4d, 0xf7,
+	0x51, 0x20, 0x02, 0x51, 0x86, 0x76, 0x11, 0xd5, 0xd5, 0x49, 0x20, 0x44, 0x70, 0x85, 0x76, 0x99,
+	0xb9, 0xe9, 0x85, 0xad, 0xc2, 0x08, 0x49, 0x6d, 0x23, 0x59, 0x01, 0x66, 0x23, 0x80, 0x35, 0x66,
+	0x0e, 0x7e, 0x49, 0x91, 0xd4, 0xec, 0x37, 0x83, 0x07, 0x1f, 0xc2, 0x20, 0x46, 0xff, 0x8d, 0xef,
+	0x27, 0x48, 0xc4, 0x9f, 0x41, 0x77, 0xeb, 0xfb, 0x89, 0xce, 0xa6, 0x6c, 0x3e, 0x5c, 0x18, 0xd6,
+	0xb1, 0x5f, 0xeb, 0x3d, 0xaa, 0x1a, 0xb9, 0xec, 0xde, 0xfc, 0x98, 0x68, 0x4e, 0x89, 0xe6, 0x06,
+	0x0c, 0xa8, 0x90, 0x8c, 0x3d, 0xd4, 0x4f, 0xa6, 0x6c, 0xde, 0x75, 0x0e, 0x39, 0x7f, 0x0d, 0x7d,
+	0xcc, 0x64, 0x98, 0x20, 0xe9, 0x9d, 0x5a, 0xb4, 0x32, 0x69, 0x35, 0x26, 0xad, 0x8f, 0x8d, 0xc9,
+	0xe5, 0xa0, 0x10, 0xbd, 0xfe, 0x39, 0x61, 0x4e, 0x43, 0xe2, 0x2f, 0xa1, 0x2f, 0x53, 0x77, 0x73,
+	0x89, 0xb9, 0xde, 0x2d, 0xf9, 0xe3, 0xbb, 0xa6, 0xaa, 0xc5, 0x58, 0xeb, 0xd4, 0xbd, 0x0a, 0xbd,
+	0x15, 0xe6, 0xb5, 0xad, 0x53, 0x99, 0xba, 0x2b, 0xcc, 0xf9, 0x18, 0xce, 0x28, 0x0c, 0xe2, 0xad,
+	0x4a, 0x13, 0xd4, 0x7b, 0x53, 0x36, 0x1f, 0x39, 0x6d, 0x61, 0xf6, 0x8d, 0xc1, 0x60, 0x8d, 0x59,
+	0x31, 0x11, 0xf1, 0xe7, 0xd0, 0x2b, 0x66, 0x21, 0x9d, 0x4d, 0x3b, 0xff, 0x35, 0x7a, 0x05, 0xe7,
+	0x6f, 0x61, 0x44, 0xe5, 0x0a, 0x37, 0x15, 0xfd, 0xa4, 0xa4, 0x3f, 0xbe, 0x4f, 0x3f, 0x5a, 0x73,
+	0xad, 0x30, 0xa4, 0x43, 0x91, 0x66, 0x5f, 0x19, 0xf4, 0xdf, 0x21, 0xd1, 0x36, 0x40, 0xfe, 0x0a,
+	0x86, 0x12, 0xb3, 0x4d, 0x52, 0x9d, 0xe9, 0x5f, 0xc7, 0x68, 0x0f, 0x79, 0xae, 0x39, 0x20, 0x0f,
+	0x19, 0x7f, 0x01, 0x67, 0x05, 0xbd, 0xf1, 0x53, 0x90, 0xf5, 0xbf, 0x90, 0xcb, 0x77, 0xcf, 0x35,
+	0x67, 0x20, 0xeb, 0x78, 0xd9, 0x83, 0x0e, 0xa5, 0xd1, 0x72, 0x75, 0xb3, 0x33, 0xd9, 0xed, 0xce,
+	0x64, 0xbf, 0x76, 0x26, 0xbb, 0xde, 0x9b, 0xda, 0xed, 0xde, 0xd4, 0xbe, 0xef, 0x4d, 0xed, 0xd3,
+	0x93, 0x20, 0x54, 0x9f, 0x53, 0xd7, 0xf2, 0x44, 0x64, 0x7b, 0x22, 0x42, 0xe5, 0x5e, 0xa8, 0x36,
+	0xa8, 0xbe, 0xe3, 0xf1, 0x97, 0x76, 0x4f, 0xcb, 0xea, 0xd3, 0x3f, 0x03, 0x00, 0xcd, 0x2a, 0x54,
+	0x67, 0x15, 0x03, 0x00, 0x00,
 }
 
 func (m *PexRequest) Marshal() (dAtA []byte, err error) {
@@ -241,6 +344,69 @@ func (m *PexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintPex(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expires, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expires):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPex(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintPex(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Addr.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PexAddrs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -261,6 +427,20 @@ func (m *PexAddrs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SignedAddrs) > 0 {
+		for iNdEx := len(m.SignedAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignedAddrs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Addrs) > 0 {
 		for iNdEx := len(m.Addrs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -372,6 +552,28 @@ func (m *PexRequest) Size() (n int) {
 	return n
 }
 
+func (m *SignedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Addr.Size()
+	n += 1 + l + sovPex(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovPex(uint64(m.Sequence))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expires)
+	n += 1 + l + sovPex(uint64(l))
+	l = m.PubKey.Size()
+	n += 1 + l + sovPex(uint64(l))
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovPex(uint64(l))
+	}
+	return n
+}
+
 func (m *PexAddrs) Size() (n int) {
 	if m == nil {
 		return 0
@@ -384,6 +586,12 @@ func (m *PexAddrs) Size() (n int) {
 			n += 1 + l + sovPex(uint64(l))
 		}
 	}
+	if len(m.SignedAddrs) > 0 {
+		for _, e := range m.SignedAddrs {
+			l = e.Size()
+			n += 1 + l + sovPex(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -480,6 +688,208 @@ func (m *PexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SignedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Addr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expires, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PexAddrs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -543,6 +953,40 @@ func (m *PexAddrs) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedAddrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedAddrs = append(m.SignedAddrs, SignedAddress{})
+			if err := m.SignedAddrs[len(m.SignedAddrs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPex(dAtA[iNdEx:])
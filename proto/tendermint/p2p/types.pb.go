@@ -27,6 +27,8 @@ type NetAddress struct {
 	ID   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	IP   string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
 	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// "quic" for the addresses of the QUIC transport, empty for TCP ones.
+	Protocol string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
 }
 
 func (m *NetAddress) Reset()         { *m = NetAddress{} }
@@ -83,6 +85,13 @@ func (m *NetAddress) GetPort() uint32 {
 	return 0
 }
 
+func (m *NetAddress) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
 type ProtocolVersion struct {
 	P2P   uint64 `protobuf:"varint,1,opt,name=p2p,proto3" json:"p2p,omitempty"`
 	Block uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
@@ -305,38 +314,38 @@ func init() {
 func init() { proto.RegisterFile("tendermint/p2p/types.proto", fileDescriptor_c8a29e659aeca578) }
 
 var fileDescriptor_c8a29e659aeca578 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x3d, 0x8f, 0xda, 0x40,
-	0x10, 0xc5, 0xc6, 0x7c, 0xdc, 0x10, 0x8e, 0xcb, 0x0a, 0x45, 0x3e, 0x0a, 0x1b, 0xa1, 0x14, 0x54,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xb1, 0x8e, 0xda, 0x40,
+	0x10, 0xc5, 0xc6, 0x07, 0xdc, 0x10, 0x8e, 0xcb, 0x0a, 0x45, 0x3e, 0x0a, 0x1b, 0xa1, 0x14, 0x54,
 	0xa0, 0x90, 0x2a, 0x5d, 0x42, 0x68, 0x50, 0xa4, 0x8b, 0xb5, 0x8a, 0x52, 0xa4, 0x41, 0xe0, 0x5d,
-	0x60, 0x85, 0xd9, 0x5d, 0xad, 0xf7, 0x12, 0xf2, 0x2f, 0xf2, 0xb3, 0xae, 0xbc, 0x32, 0x95, 0x15,
-	0x99, 0x32, 0x7f, 0x22, 0xf2, 0xae, 0x2f, 0xc7, 0xa1, 0xeb, 0xe6, 0xcd, 0x9b, 0x99, 0x37, 0xf3,
-	0x34, 0xd0, 0xd3, 0x94, 0x13, 0xaa, 0xf6, 0x8c, 0xeb, 0xb1, 0x9c, 0xc8, 0xb1, 0xfe, 0x29, 0x69,
-	0x3a, 0x92, 0x4a, 0x68, 0x81, 0x2e, 0x1f, 0xb9, 0x91, 0x9c, 0xc8, 0x5e, 0x77, 0x23, 0x36, 0xc2,
-	0x50, 0xe3, 0x22, 0xb2, 0x55, 0x83, 0x08, 0xe0, 0x86, 0xea, 0x0f, 0x84, 0x28, 0x9a, 0xa6, 0xe8,
-	0x15, 0xb8, 0x8c, 0xf8, 0x4e, 0xdf, 0x19, 0x5e, 0x4c, 0xeb, 0x79, 0x16, 0xba, 0xf3, 0x19, 0x76,
-	0x19, 0x31, 0x79, 0xe9, 0xbb, 0x27, 0xf9, 0x08, 0xbb, 0x4c, 0x22, 0x04, 0x9e, 0x14, 0x4a, 0xfb,
-	0xd5, 0xbe, 0x33, 0x6c, 0x63, 0x13, 0x0f, 0xbe, 0x40, 0x27, 0x2a, 0x46, 0xc7, 0x22, 0xf9, 0x4a,
-	0x55, 0xca, 0x04, 0x47, 0xd7, 0x50, 0x95, 0x13, 0x69, 0xe6, 0x7a, 0xd3, 0x46, 0x9e, 0x85, 0xd5,
-	0x68, 0x12, 0xe1, 0x22, 0x87, 0xba, 0x50, 0x5b, 0x25, 0x22, 0xde, 0x99, 0xe1, 0x1e, 0xb6, 0x00,
-	0x5d, 0x41, 0x75, 0x29, 0xa5, 0x19, 0xeb, 0xe1, 0x22, 0x1c, 0xfc, 0x75, 0xa1, 0x33, 0xa3, 0xeb,
-	0xe5, 0x6d, 0xa2, 0x6f, 0x04, 0xa1, 0x73, 0xbe, 0x16, 0x28, 0x82, 0x2b, 0x59, 0x2a, 0x2d, 0xbe,
-	0x5b, 0x29, 0xa3, 0xd1, 0x9a, 0x84, 0xa3, 0xa7, 0xc7, 0x8f, 0xce, 0x36, 0x9a, 0x7a, 0x77, 0x59,
-	0x58, 0xc1, 0x1d, 0x79, 0xb6, 0xe8, 0x3b, 0xe8, 0x10, 0x2b, 0xb2, 0xe0, 0x82, 0xd0, 0x05, 0x23,
-	0xe5, 0xd1, 0x2f, 0xf3, 0x2c, 0x6c, 0x9f, 0xea, 0xcf, 0x70, 0x9b, 0x9c, 0x40, 0x82, 0x42, 0x68,
-	0x25, 0x2c, 0xd5, 0x94, 0x2f, 0x96, 0x84, 0x28, 0xb3, 0xfa, 0x05, 0x06, 0x9b, 0x2a, 0xec, 0x45,
-	0x3e, 0x34, 0x38, 0xd5, 0x3f, 0x84, 0xda, 0xf9, 0x9e, 0x21, 0x1f, 0x60, 0xc1, 0x3c, 0xac, 0x5f,
-	0xb3, 0x4c, 0x09, 0x51, 0x0f, 0x9a, 0xf1, 0x76, 0xc9, 0x39, 0x4d, 0x52, 0xbf, 0xde, 0x77, 0x86,
-	0x2f, 0xf0, 0x7f, 0x5c, 0x74, 0xed, 0x05, 0x67, 0x3b, 0xaa, 0xfc, 0x86, 0xed, 0x2a, 0x21, 0x7a,
-	0x0f, 0x35, 0xa1, 0xb7, 0x54, 0xf9, 0x4d, 0x63, 0xc6, 0xeb, 0x73, 0x33, 0xce, 0x7c, 0xfc, 0x5c,
-	0xd4, 0x96, 0x8e, 0xd8, 0xc6, 0xc1, 0x0a, 0xba, 0xcf, 0x15, 0xa1, 0x6b, 0x68, 0xea, 0xc3, 0x82,
-	0x71, 0x42, 0x0f, 0xf6, 0x4b, 0x70, 0x43, 0x1f, 0xe6, 0x05, 0x44, 0x63, 0x68, 0x29, 0x19, 0x9b,
-	0xe3, 0x69, 0x9a, 0x96, 0xb6, 0x5d, 0xe6, 0x59, 0x08, 0x38, 0xfa, 0x58, 0xfe, 0x17, 0x06, 0x25,
-	0xe3, 0x32, 0x9e, 0x7e, 0xba, 0xcb, 0x03, 0xe7, 0x3e, 0x0f, 0x9c, 0x3f, 0x79, 0xe0, 0xfc, 0x3a,
-	0x06, 0x95, 0xfb, 0x63, 0x50, 0xf9, 0x7d, 0x0c, 0x2a, 0xdf, 0xde, 0x6c, 0x98, 0xde, 0xde, 0xae,
-	0x46, 0xb1, 0xd8, 0x8f, 0x63, 0xb1, 0xa7, 0x7a, 0xb5, 0xd6, 0x8f, 0x81, 0x7d, 0xe1, 0xa7, 0x8f,
-	0xbf, 0xaa, 0x9b, 0xec, 0xdb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0xdb, 0x56, 0x6d, 0x11,
-	0x03, 0x00, 0x00,
+	0xc0, 0xc2, 0xec, 0xae, 0xd6, 0x7b, 0x09, 0xf9, 0x8b, 0x7c, 0xd6, 0x95, 0x57, 0xa6, 0xb2, 0x22,
+	0x53, 0xe6, 0x27, 0xa2, 0xdd, 0x35, 0x77, 0x1c, 0x4a, 0xf7, 0xde, 0x9b, 0x19, 0xbf, 0xf1, 0xd3,
+	0x2c, 0x74, 0x15, 0x65, 0x84, 0xca, 0x5d, 0xc2, 0xd4, 0x48, 0x8c, 0xc5, 0x48, 0xfd, 0x14, 0x34,
+	0x1b, 0x0a, 0xc9, 0x15, 0x47, 0x57, 0x4f, 0xb5, 0xa1, 0x18, 0x8b, 0x6e, 0x67, 0xcd, 0xd7, 0xdc,
+	0x94, 0x46, 0x1a, 0xd9, 0xae, 0x7e, 0x0a, 0x70, 0x4b, 0xd5, 0x07, 0x42, 0x24, 0xcd, 0x32, 0xf4,
+	0x0a, 0xdc, 0x84, 0xf8, 0x4e, 0xcf, 0x19, 0x5c, 0x4e, 0x6a, 0x45, 0x1e, 0xba, 0xb3, 0x29, 0x76,
+	0x13, 0x62, 0x74, 0xe1, 0xbb, 0x27, 0x7a, 0x84, 0xdd, 0x44, 0x20, 0x04, 0x9e, 0xe0, 0x52, 0xf9,
+	0xd5, 0x9e, 0x33, 0x68, 0x61, 0x83, 0x51, 0x17, 0x1a, 0xe6, 0xd3, 0x31, 0x4f, 0x7d, 0x4f, 0x4f,
+	0xe0, 0x47, 0xde, 0xff, 0x02, 0xed, 0xa8, 0xc4, 0x5f, 0xa9, 0xcc, 0x12, 0xce, 0xd0, 0x0d, 0x54,
+	0xc5, 0x58, 0x18, 0x4f, 0x6f, 0x52, 0x2f, 0xf2, 0xb0, 0x1a, 0x8d, 0x23, 0xac, 0x35, 0xd4, 0x81,
+	0x8b, 0x65, 0xca, 0xe3, 0xad, 0x31, 0xf6, 0xb0, 0x25, 0xe8, 0x1a, 0xaa, 0x0b, 0x21, 0x8c, 0xa5,
+	0x87, 0x35, 0xec, 0xff, 0x75, 0xa1, 0x3d, 0xa5, 0xab, 0xc5, 0x5d, 0xaa, 0x6e, 0x39, 0xa1, 0x33,
+	0xb6, 0xe2, 0x28, 0x82, 0xeb, 0xa3, 0xeb, 0xfc, 0xbb, 0xb5, 0x32, 0x1e, 0xcd, 0x71, 0x38, 0x7c,
+	0x1e, 0xcc, 0xf0, 0x6c, 0xa3, 0x89, 0x77, 0x9f, 0x87, 0x15, 0xdc, 0x16, 0x67, 0x8b, 0xbe, 0x83,
+	0x36, 0xb1, 0x26, 0x73, 0xc6, 0x09, 0x9d, 0x27, 0xa4, 0x0c, 0xe4, 0x65, 0x91, 0x87, 0xad, 0x53,
+	0xff, 0x29, 0x6e, 0x91, 0x13, 0x4a, 0x50, 0x08, 0xcd, 0x34, 0xc9, 0x14, 0x65, 0xf3, 0x05, 0x21,
+	0xd2, 0xac, 0x7e, 0x89, 0xc1, 0x4a, 0x3a, 0x7a, 0xe4, 0x43, 0x9d, 0x51, 0xf5, 0x83, 0xcb, 0x6d,
+	0x19, 0xd9, 0x91, 0xea, 0xca, 0x71, 0xfd, 0x0b, 0x5b, 0x29, 0xa9, 0xce, 0x39, 0xde, 0x2c, 0x18,
+	0xa3, 0x69, 0xe6, 0xd7, 0x7a, 0xce, 0xe0, 0x05, 0x7e, 0xe4, 0x7a, 0x6a, 0xc7, 0x59, 0xb2, 0xa5,
+	0xd2, 0xaf, 0xdb, 0xa9, 0x92, 0xa2, 0xf7, 0x70, 0xc1, 0xd5, 0x86, 0x4a, 0xbf, 0x61, 0xc2, 0x78,
+	0x7d, 0x1e, 0xc6, 0x59, 0x8e, 0x9f, 0x75, 0x6f, 0x99, 0x88, 0x1d, 0xec, 0x2f, 0xa1, 0xf3, 0xbf,
+	0x26, 0x74, 0x03, 0x0d, 0xb5, 0x9f, 0x27, 0x8c, 0xd0, 0xbd, 0xbd, 0x20, 0x5c, 0x57, 0xfb, 0x99,
+	0xa6, 0x68, 0x04, 0x4d, 0x29, 0x62, 0xf3, 0xf3, 0x34, 0xcb, 0xca, 0xd8, 0xae, 0x8a, 0x3c, 0x04,
+	0x1c, 0x7d, 0x2c, 0x6f, 0x0f, 0x83, 0x14, 0x71, 0x89, 0x27, 0x9f, 0xee, 0x8b, 0xc0, 0x79, 0x28,
+	0x02, 0xe7, 0x4f, 0x11, 0x38, 0xbf, 0x0e, 0x41, 0xe5, 0xe1, 0x10, 0x54, 0x7e, 0x1f, 0x82, 0xca,
+	0xb7, 0x37, 0xeb, 0x44, 0x6d, 0xee, 0x96, 0xc3, 0x98, 0xef, 0x46, 0x31, 0xdf, 0x51, 0xb5, 0x5c,
+	0xa9, 0x27, 0x60, 0xcf, 0xfb, 0xf9, 0xa3, 0x58, 0xd6, 0x8c, 0xfa, 0xf6, 0xdf, 0x00, 0x2a, 0x0e,
+	0xed, 0x05, 0x2d, 0x03, 0x00, 0x00,
 }
 
 func (m *NetAddress) Marshal() (dAtA []byte, err error) {
@@ -359,6 +368,13 @@ func (m *NetAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Port != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Port))
 		i--
@@ -569,6 +585,10 @@ func (m *NetAddress) Size() (n int) {
 	if m.Port != 0 {
 		n += 1 + sovTypes(uint64(m.Port))
 	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -762,6 +782,38 @@ func (m *NetAddress) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,9 +1,13 @@
 package client_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,9 +15,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -179,3 +186,217 @@ func TestHTTPReturnsErrorIfClientIsNotRunning(t *testing.T) {
 	err = c.UnsubscribeAll(context.Background(), "TestHeaderEvents")
 	assert.Error(t, err)
 }
+
+func TestEventLog(t *testing.T) {
+	httpClient := getHTTPClient()
+	for _, c := range []interface {
+		client.Client
+		Events(context.Context, string, string, *int, time.Duration) (*ctypes.ResultEvents, error)
+	}{httpClient, getLocalClient()} {
+		c := c
+		t.Run(reflect.TypeOf(c).String(), func(t *testing.T) {
+			// the cursor of the newest event, to only read the events of the test
+			res, err := c.Events(context.Background(), "", "", nil, 0)
+			require.NoError(t, err)
+			for res.More {
+				res, err = c.Events(context.Background(), "", res.Cursor, nil, 0)
+				require.NoError(t, err)
+			}
+			start := res.Cursor
+
+			var txs [][]byte
+			for i := 0; i < 3; i++ {
+				_, _, tx := MakeTxKV()
+				_, err := c.BroadcastTxCommit(context.Background(), tx)
+				require.NoError(t, err)
+				txs = append(txs, tx)
+			}
+
+			// read the txs two by two
+			var (
+				got    [][]byte
+				cursor = start
+				two    = 2
+			)
+			for {
+				res, err := c.Events(context.Background(), types.EventQueryTx.String(), cursor, &two, time.Second)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(res.Items), 2)
+				for _, item := range res.Items {
+					require.NotEmpty(t, item.Cursor)
+					got = append(got, item.Data.(types.EventDataTx).Tx)
+				}
+				cursor = res.Cursor
+				if len(got) >= len(txs) && !res.More {
+					break
+				}
+			}
+			assert.Equal(t, txs, got)
+
+			// waiting for new events
+			res, err = c.Events(context.Background(), types.EventQueryNewBlock.String(), cursor, nil, 5*time.Second)
+			require.NoError(t, err)
+			require.Len(t, res.Items, 1)
+			_, ok := res.Items[0].Data.(types.EventDataNewBlock)
+			assert.True(t, ok)
+
+			// cursors of another log
+			_, err = c.Events(context.Background(), "", "1-1", nil, 0)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "cursor has expired")
+		})
+	}
+}
+
+func TestSubscribeAfterCursor(t *testing.T) {
+	c := getHTTPClient()
+	res, err := c.Events(context.Background(), "", "", nil, 0)
+	require.NoError(t, err)
+	for res.More {
+		res, err = c.Events(context.Background(), "", res.Cursor, nil, 0)
+		require.NoError(t, err)
+	}
+
+	// txs committed while "disconnected"
+	var txs [][]byte
+	for i := 0; i < 2; i++ {
+		_, _, tx := MakeTxKV()
+		_, err := c.BroadcastTxCommit(context.Background(), tx)
+		require.NoError(t, err)
+		txs = append(txs, tx)
+	}
+
+	ws, err := rpcclient.NewWS(rpctest.GetConfig().RPC.ListenAddress, "/websocket")
+	require.NoError(t, err)
+	require.NoError(t, ws.Start())
+	t.Cleanup(func() {
+		if err := ws.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	err = ws.Call(context.Background(), "subscribe", map[string]interface{}{
+		"query": types.EventQueryTx.String(),
+		"after": res.Cursor,
+	})
+	require.NoError(t, err)
+
+	// the subscription response, then the missed txs, then a new one
+	_, _, tx := MakeTxKV()
+	txs = append(txs, tx)
+	var got [][]byte
+	for len(got) < len(txs) {
+		select {
+		case resp := <-ws.ResponsesCh:
+			require.Nil(t, resp.Error)
+			var event ctypes.ResultEvent
+			require.NoError(t, cmtjson.Unmarshal(resp.Result, &event))
+			if event.Data == nil {
+				// subscribed
+				_, err := c.BroadcastTxCommit(context.Background(), tx)
+				require.NoError(t, err)
+				continue
+			}
+			require.NotEmpty(t, event.Cursor)
+			got = append(got, event.Data.(types.EventDataTx).Tx)
+		case <-time.After(waitForEventTimeout):
+			t.Fatal("timed out waiting for events")
+		}
+	}
+	assert.Equal(t, txs, got)
+
+	// an expired cursor is an error
+	err = ws.Call(context.Background(), "subscribe", map[string]interface{}{
+		"query": types.EventQueryNewBlock.String(),
+		"after": "1-1",
+	})
+	require.NoError(t, err)
+	for {
+		select {
+		case resp := <-ws.ResponsesCh:
+			if resp.Error == nil {
+				continue // events of the first subscription
+			}
+			assert.Contains(t, resp.Error.Data, "cursor has expired")
+			return
+		case <-time.After(waitForEventTimeout):
+			t.Fatal("timed out waiting for the error")
+		}
+	}
+}
+
+// readEventStream returns the id and the ResultEvent of the next server-sent
+// event.
+func readEventStream(t *testing.T, r *bufio.Reader) (string, ctypes.ResultEvent) {
+	var (
+		id    string
+		event ctypes.ResultEvent
+	)
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "id: "):
+			id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, cmtjson.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+		case line == "" && event.Data != nil:
+			return id, event
+		}
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	remote := strings.ReplaceAll(rpctest.GetConfig().RPC.ListenAddress, "tcp", "http")
+	stream := func(query, lastEventID string) *http.Response {
+		q := url.Values{"query": []string{query}}
+		req, err := http.NewRequest(http.MethodGet, remote+"/event_stream?"+q.Encode(), nil)
+		require.NoError(t, err)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { res.Body.Close() })
+		return res
+	}
+
+	res := stream(types.EventQueryTx.String(), "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	c := getHTTPClient()
+	var txs [][]byte
+	for i := 0; i < 2; i++ {
+		_, _, tx := MakeTxKV()
+		_, err := c.BroadcastTxCommit(context.Background(), tx)
+		require.NoError(t, err)
+		txs = append(txs, tx)
+	}
+
+	id, event := readEventStream(t, bufio.NewReader(res.Body))
+	require.NotEmpty(t, id)
+	assert.Equal(t, id, event.Cursor)
+	assert.EqualValues(t, txs[0], event.Data.(types.EventDataTx).Tx)
+
+	// resuming after the first tx
+	res = stream(types.EventQueryTx.String(), id)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	_, event = readEventStream(t, bufio.NewReader(res.Body))
+	assert.EqualValues(t, txs[1], event.Data.(types.EventDataTx).Tx)
+
+	// cursors of another log
+	res = stream(types.EventQueryTx.String(), "1-1")
+	assert.Equal(t, http.StatusGone, res.StatusCode)
+
+	// the events which aren't logged are streamed from the subscription
+	res = stream(types.EventQueryNewBlockHeader.String(), "")
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	id, event = readEventStream(t, bufio.NewReader(res.Body))
+	assert.Empty(t, id)
+	assert.IsType(t, types.EventDataNewBlockHeader{}, event.Data)
+
+	res = stream(types.EventQueryNewBlockHeader.String(), "1-1")
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
@@ -0,0 +1,712 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const defaultHealthCheckInterval = 5 * time.Second
+
+/*
+Failover is a Client implementation that spreads over several CometBFT nodes,
+so that it keeps working while some of them are down, e.g. restarting.
+
+Once started, it checks the health of the nodes periodically. Reads go to the
+healthy node with the highest block, and are retried on the other nodes when
+the node can't be reached. Transactions and evidence are only sent again to
+another node if the first one could not be connected to, since it may have
+received them otherwise. Errors returned by a node, e.g. for an invalid
+request, are returned as is.
+
+Subscriptions are made on a single node. If it becomes unhealthy, they are
+moved to another node. As with HTTP, delivery is best-effort: events may be
+missed, or delivered twice, when moving. Use Events with a cursor to read the
+missed events from the event log, if enabled.
+
+Example:
+
+	c, err := NewFailover([]string{"http://10.0.0.1:26657", "http://10.0.0.2:26657"}, "/websocket")
+	if err != nil {
+		// handle error
+	}
+
+	// call Start/Stop to check the health of the nodes, and to subscribe to
+	// events
+	err = c.Start()
+	if err != nil {
+		// handle error
+	}
+	defer c.Stop()
+
+	res, err := c.Status(ctx)
+*/
+type Failover struct {
+	service.BaseService
+
+	wsEndpoint          string
+	healthCheckInterval time.Duration
+
+	mtx       cmtsync.RWMutex
+	endpoints []*failoverEndpoint // in the order given
+
+	evMtx          cmtsync.Mutex
+	events         *WSEvents // nil until the first subscription
+	eventsEndpoint *failoverEndpoint
+	subscriptions  map[string]chan ctypes.ResultEvent // query -> chan
+}
+
+type failoverEndpoint struct {
+	remote string
+	client *baseRPCClient
+
+	// protected by Failover.mtx
+	healthy bool
+	height  int64 // latest block height, as of the last health check
+}
+
+// FailoverOption sets an optional parameter on the Failover client.
+type FailoverOption func(*Failover)
+
+// HealthCheckInterval sets how often the nodes are checked, and how long a
+// node has to respond to a check. The default is 5s.
+func HealthCheckInterval(interval time.Duration) FailoverOption {
+	return func(c *Failover) {
+		c.healthCheckInterval = interval
+	}
+}
+
+var _ rpcclient.RemoteClient = (*Failover)(nil)
+
+// NewFailover takes the remote endpoints of the nodes in the form
+// <protocol>://<host>:<port>, in order of preference, and the websocket path
+// (which always seems to be "/websocket"). An error is returned on invalid
+// remotes.
+func NewFailover(remotes []string, wsEndpoint string, options ...FailoverOption) (*Failover, error) {
+	if len(remotes) == 0 {
+		return nil, errors.New("at least one remote is required")
+	}
+
+	c := &Failover{
+		wsEndpoint:          wsEndpoint,
+		healthCheckInterval: defaultHealthCheckInterval,
+		subscriptions:       make(map[string]chan ctypes.ResultEvent),
+	}
+	for _, remote := range remotes {
+		rc, err := jsonrpcclient.New(remote)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote %s: %w", remote, err)
+		}
+		c.endpoints = append(c.endpoints, &failoverEndpoint{
+			remote: remote,
+			client: &baseRPCClient{caller: rc},
+			// until checked
+			healthy: true,
+		})
+	}
+	for _, option := range options {
+		option(c)
+	}
+	if c.healthCheckInterval <= 0 {
+		return nil, errors.New("health check interval must be positive")
+	}
+	c.BaseService = *service.NewBaseService(nil, "Failover", c)
+
+	return c, nil
+}
+
+// OnStart implements service.Service by checking the health of the nodes,
+// and then starting to check it periodically.
+func (c *Failover) OnStart() error {
+	c.checkHealth()
+	go c.healthRoutine()
+	return nil
+}
+
+// OnStop implements service.Service by stopping the subscriptions.
+func (c *Failover) OnStop() {
+	c.evMtx.Lock()
+	defer c.evMtx.Unlock()
+	if c.events != nil {
+		if err := c.events.Stop(); err != nil {
+			c.Logger.Error("Can't stop ws client", "err", err)
+		}
+		c.events, c.eventsEndpoint = nil, nil
+	}
+}
+
+// Remote returns the remote network address of the node reads currently go
+// to.
+func (c *Failover) Remote() string {
+	return c.candidates()[0].remote
+}
+
+func (c *Failover) healthRoutine() {
+	ticker := time.NewTicker(c.healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.checkHealth()
+			c.failoverEvents()
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+// checkHealth gets the status of all the nodes concurrently. A node is
+// healthy if it responds in time.
+func (c *Failover) checkHealth() {
+	var wg sync.WaitGroup
+	for _, ep := range c.endpoints {
+		wg.Add(1)
+		go func(ep *failoverEndpoint) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), c.healthCheckInterval)
+			defer cancel()
+			status, err := ep.client.Status(ctx)
+
+			c.mtx.Lock()
+			defer c.mtx.Unlock()
+			if err != nil {
+				if ep.healthy {
+					c.Logger.Info("Node is unhealthy", "remote", ep.remote, "err", err)
+				}
+				ep.healthy = false
+				return
+			}
+			if !ep.healthy {
+				c.Logger.Info("Node is healthy again", "remote", ep.remote)
+			}
+			ep.healthy = true
+			ep.height = status.SyncInfo.LatestBlockHeight
+		}(ep)
+	}
+	wg.Wait()
+}
+
+func (c *Failover) markUnhealthy(ep *failoverEndpoint, err error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if ep.healthy {
+		c.Logger.Info("Node is unhealthy", "remote", ep.remote, "err", err)
+	}
+	ep.healthy = false
+}
+
+func (c *Failover) isHealthy(ep *failoverEndpoint) bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return ep.healthy
+}
+
+// candidates returns all the endpoints in order of preference: the healthy
+// ones first, the most up-to-date first, then the unhealthy ones, as a last
+// resort.
+func (c *Failover) candidates() []*failoverEndpoint {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	eps := make([]*failoverEndpoint, len(c.endpoints))
+	copy(eps, c.endpoints)
+	sort.SliceStable(eps, func(i, j int) bool {
+		if eps[i].healthy != eps[j].healthy {
+			return eps[i].healthy
+		}
+		return eps[i].healthy && eps[i].height > eps[j].height
+	})
+	return eps
+}
+
+// read calls f on the endpoints, in order of preference, until a node
+// responds.
+func (c *Failover) read(ctx context.Context, f func(*baseRPCClient) error) error {
+	return c.call(ctx, true, f)
+}
+
+// write calls f on the endpoints, in order of preference, until one can be
+// connected to.
+func (c *Failover) write(ctx context.Context, f func(*baseRPCClient) error) error {
+	return c.call(ctx, false, f)
+}
+
+func (c *Failover) call(ctx context.Context, idempotent bool, f func(*baseRPCClient) error) error {
+	var err error
+	for _, ep := range c.candidates() {
+		err = f(ep.client)
+		if err == nil || ctx.Err() != nil || isRPCError(err) {
+			return err
+		}
+		c.markUnhealthy(ep, err)
+		if !idempotent && !isDialError(err) {
+			return err
+		}
+		c.Logger.Debug("Call failed, trying the next node", "remote", ep.remote, "err", err)
+	}
+	return err
+}
+
+// isRPCError returns true if the error was returned by the node, which is
+// reachable then.
+func isRPCError(err error) bool {
+	var rpcErr *rpctypes.RPCError
+	return errors.As(err, &rpcErr)
+}
+
+// isDialError returns true if the node could not be connected to, and so has
+// not received the request.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+//-----------------------------------------------------------------------------
+// EventsClient
+
+// Subscribe implements EventsClient by subscribing on the preferred node. See
+// WSEvents.Subscribe.
+//
+// It returns an error if Failover is not running.
+func (c *Failover) Subscribe(ctx context.Context, subscriber, query string,
+	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
+
+	if !c.IsRunning() {
+		return nil, errNotRunning
+	}
+
+	c.evMtx.Lock()
+	defer c.evMtx.Unlock()
+
+	if c.events == nil {
+		for _, ep := range c.candidates() {
+			if err = c.startEvents(ep); err == nil {
+				break
+			}
+			c.Logger.Error("Failed to connect to the node for events", "remote", ep.remote, "err", err)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	outCap := 1
+	if len(outCapacity) > 0 {
+		outCap = outCapacity[0]
+	}
+
+	outc := make(chan ctypes.ResultEvent, outCap)
+	if err := c.events.subscribe(ctx, query, outc); err != nil {
+		return nil, err
+	}
+	c.subscriptions[query] = outc
+
+	return outc, nil
+}
+
+// Unsubscribe implements EventsClient by unsubscribing given subscriber from
+// query.
+//
+// It returns an error if Failover is not running.
+func (c *Failover) Unsubscribe(ctx context.Context, subscriber, query string) error {
+	if !c.IsRunning() {
+		return errNotRunning
+	}
+
+	c.evMtx.Lock()
+	defer c.evMtx.Unlock()
+
+	if c.events != nil {
+		if err := c.events.Unsubscribe(ctx, subscriber, query); err != nil {
+			return err
+		}
+	}
+	delete(c.subscriptions, query)
+
+	return nil
+}
+
+// UnsubscribeAll implements EventsClient by unsubscribing given subscriber
+// from all the queries.
+//
+// It returns an error if Failover is not running.
+func (c *Failover) UnsubscribeAll(ctx context.Context, subscriber string) error {
+	if !c.IsRunning() {
+		return errNotRunning
+	}
+
+	c.evMtx.Lock()
+	defer c.evMtx.Unlock()
+
+	if c.events != nil {
+		if err := c.events.UnsubscribeAll(ctx, subscriber); err != nil {
+			return err
+		}
+	}
+	c.subscriptions = make(map[string]chan ctypes.ResultEvent)
+
+	return nil
+}
+
+// failoverEvents moves the subscriptions to the preferred node if the node
+// they are on is unhealthy.
+func (c *Failover) failoverEvents() {
+	c.evMtx.Lock()
+	defer c.evMtx.Unlock()
+
+	if c.events == nil || c.isHealthy(c.eventsEndpoint) {
+		return
+	}
+	ep := c.candidates()[0]
+	if ep == c.eventsEndpoint || !c.isHealthy(ep) {
+		return
+	}
+	if err := c.startEvents(ep); err != nil {
+		c.Logger.Error("Failed to move the subscriptions", "remote", ep.remote, "err", err)
+		return
+	}
+	c.Logger.Info("Moved the subscriptions", "remote", ep.remote)
+}
+
+// startEvents connects to the node of ep, redoes the subscriptions there, and
+// then stops the previous connection, if any. evMtx must be held.
+func (c *Failover) startEvents(ep *failoverEndpoint) error {
+	w, err := newWSEvents(ep.remote, c.wsEndpoint)
+	if err != nil {
+		return err
+	}
+	w.SetLogger(c.Logger.With("remote", ep.remote))
+	if err := w.Start(); err != nil {
+		return err
+	}
+
+	for query, out := range c.subscriptions {
+		ctx, cancel := context.WithTimeout(context.Background(), c.healthCheckInterval)
+		err := w.subscribe(ctx, query, out)
+		cancel()
+		if err != nil {
+			if err := w.Stop(); err != nil {
+				c.Logger.Error("Can't stop ws client", "err", err)
+			}
+			return fmt.Errorf("failed to resubscribe to %q: %w", query, err)
+		}
+	}
+
+	if c.events != nil {
+		if err := c.events.Stop(); err != nil {
+			c.Logger.Error("Can't stop ws client", "err", err)
+		}
+	}
+	c.events, c.eventsEndpoint = w, ep
+
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// Reads and writes
+
+func (c *Failover) Status(ctx context.Context) (res *ctypes.ResultStatus, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Status(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) ABCIInfo(ctx context.Context) (res *ctypes.ResultABCIInfo, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.ABCIInfo(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) ABCIQuery(
+	ctx context.Context,
+	path string,
+	data bytes.HexBytes,
+) (*ctypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+func (c *Failover) ABCIQueryWithOptions(
+	ctx context.Context,
+	path string,
+	data bytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions) (res *ctypes.ResultABCIQuery, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.ABCIQueryWithOptions(ctx, path, data, opts)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) BroadcastTxCommit(
+	ctx context.Context,
+	tx types.Tx,
+) (res *ctypes.ResultBroadcastTxCommit, err error) {
+	err = c.write(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.BroadcastTxCommit(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) BroadcastTxAsync(
+	ctx context.Context,
+	tx types.Tx,
+) (res *ctypes.ResultBroadcastTx, err error) {
+	err = c.write(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.BroadcastTxAsync(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) BroadcastTxSync(
+	ctx context.Context,
+	tx types.Tx,
+) (res *ctypes.ResultBroadcastTx, err error) {
+	err = c.write(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.BroadcastTxSync(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) UnconfirmedTxs(
+	ctx context.Context,
+	limit *int,
+) (res *ctypes.ResultUnconfirmedTxs, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.UnconfirmedTxs(ctx, limit)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) NumUnconfirmedTxs(ctx context.Context) (res *ctypes.ResultUnconfirmedTxs, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.NumUnconfirmedTxs(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) CheckTx(ctx context.Context, tx types.Tx) (res *ctypes.ResultCheckTx, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.CheckTx(ctx, tx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) NetInfo(ctx context.Context) (res *ctypes.ResultNetInfo, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.NetInfo(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) DumpConsensusState(ctx context.Context) (res *ctypes.ResultDumpConsensusState, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.DumpConsensusState(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) ConsensusState(ctx context.Context) (res *ctypes.ResultConsensusState, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.ConsensusState(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) ConsensusParams(
+	ctx context.Context,
+	height *int64,
+) (res *ctypes.ResultConsensusParams, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.ConsensusParams(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) Health(ctx context.Context) (res *ctypes.ResultHealth, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Health(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) BlockchainInfo(
+	ctx context.Context,
+	minHeight,
+	maxHeight int64,
+) (res *ctypes.ResultBlockchainInfo, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.BlockchainInfo(ctx, minHeight, maxHeight)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) Genesis(ctx context.Context) (res *ctypes.ResultGenesis, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Genesis(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) GenesisChunked(ctx context.Context, id uint) (res *ctypes.ResultGenesisChunk, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.GenesisChunked(ctx, id)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) Block(ctx context.Context, height *int64) (res *ctypes.ResultBlock, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Block(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) BlockByHash(ctx context.Context, hash []byte) (res *ctypes.ResultBlock, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.BlockByHash(ctx, hash)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) BlockResults(
+	ctx context.Context,
+	height *int64,
+) (res *ctypes.ResultBlockResults, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.BlockResults(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) Header(ctx context.Context, height *int64) (res *ctypes.ResultHeader, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Header(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) HeaderByHash(ctx context.Context, hash bytes.HexBytes) (res *ctypes.ResultHeader, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.HeaderByHash(ctx, hash)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) Commit(ctx context.Context, height *int64) (res *ctypes.ResultCommit, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Commit(ctx, height)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) Tx(ctx context.Context, hash []byte, prove bool) (res *ctypes.ResultTx, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Tx(ctx, hash, prove)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) TxSearch(
+	ctx context.Context,
+	query string,
+	prove bool,
+	page,
+	perPage *int,
+	orderBy string,
+) (res *ctypes.ResultTxSearch, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.TxSearch(ctx, query, prove, page, perPage, orderBy)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) BlockSearch(
+	ctx context.Context,
+	query string,
+	page, perPage *int,
+	orderBy string,
+) (res *ctypes.ResultBlockSearch, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.BlockSearch(ctx, query, page, perPage, orderBy)
+		return err
+	})
+	return res, err
+}
+
+// Events returns the events of the event log of the preferred node. Note the
+// cursors of a node are not valid for the other nodes.
+func (c *Failover) Events(
+	ctx context.Context,
+	query, after string,
+	maxItems *int,
+	waitTime time.Duration,
+) (res *ctypes.ResultEvents, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Events(ctx, query, after, maxItems, waitTime)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) Validators(
+	ctx context.Context,
+	height *int64,
+	page,
+	perPage *int,
+) (res *ctypes.ResultValidators, err error) {
+	err = c.read(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.Validators(ctx, height, page, perPage)
+		return err
+	})
+	return res, err
+}
+
+func (c *Failover) BroadcastEvidence(
+	ctx context.Context,
+	ev types.Evidence,
+) (res *ctypes.ResultBroadcastEvidence, err error) {
+	err = c.write(ctx, func(rc *baseRPCClient) (err error) {
+		res, err = rc.BroadcastEvidence(ctx, ev)
+		return err
+	})
+	return res, err
+}
@@ -556,6 +556,36 @@ func (c *baseRPCClient) BlockSearch(
 	return result, nil
 }
 
+// Events returns up to maxItems events of the event log after the cursor,
+// which match the query, waiting up to waitTime for some if there are none.
+func (c *baseRPCClient) Events(
+	ctx context.Context,
+	query, after string,
+	maxItems *int,
+	waitTime time.Duration,
+) (*ctypes.ResultEvents, error) {
+
+	result := new(ctypes.ResultEvents)
+	params := map[string]interface{}{
+		"query": query,
+		"after": after,
+	}
+
+	if maxItems != nil {
+		params["max_items"] = maxItems
+	}
+	if waitTime > 0 {
+		params["wait_time"] = waitTime.String()
+	}
+
+	_, err := c.caller.Call(ctx, "events", params, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (c *baseRPCClient) Validators(
 	ctx context.Context,
 	height *int64,
@@ -661,25 +691,34 @@ func (w *WSEvents) Subscribe(ctx context.Context, subscriber, query string,
 		return nil, errNotRunning
 	}
 
-	if err := w.ws.Subscribe(ctx, query); err != nil {
-		return nil, err
-	}
-
 	outCap := 1
 	if len(outCapacity) > 0 {
 		outCap = outCapacity[0]
 	}
 
 	outc := make(chan ctypes.ResultEvent, outCap)
-	w.mtx.Lock()
 	// subscriber param is ignored because CometBFT will override it with
 	// remote IP anyway.
-	w.subscriptions[query] = outc
-	w.mtx.Unlock()
+	if err := w.subscribe(ctx, query, outc); err != nil {
+		return nil, err
+	}
 
 	return outc, nil
 }
 
+// subscribe subscribes to query, publishing the events onto out.
+func (w *WSEvents) subscribe(ctx context.Context, query string, out chan ctypes.ResultEvent) error {
+	if err := w.ws.Subscribe(ctx, query); err != nil {
+		return err
+	}
+
+	w.mtx.Lock()
+	w.subscriptions[query] = out
+	w.mtx.Unlock()
+
+	return nil
+}
+
 // Unsubscribe implements EventsClient by using WSClient to unsubscribe given
 // subscriber from query.
 //
@@ -197,7 +197,7 @@ func (c *Local) TxSearch(
 	perPage *int,
 	orderBy string,
 ) (*ctypes.ResultTxSearch, error) {
-	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy)
+	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy, nil)
 }
 
 func (c *Local) BlockSearch(
@@ -206,7 +206,22 @@ func (c *Local) BlockSearch(
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return core.BlockSearch(c.ctx, query, page, perPage, orderBy)
+	return core.BlockSearch(c.ctx, query, page, perPage, orderBy, nil)
+}
+
+// Events returns up to maxItems events of the event log after the cursor,
+// which match the query, waiting up to waitTime for some if there are none.
+func (c *Local) Events(
+	_ context.Context,
+	query, after string,
+	maxItems *int,
+	waitTime time.Duration,
+) (*ctypes.ResultEvents, error) {
+	var wait string
+	if waitTime > 0 {
+		wait = waitTime.String()
+	}
+	return core.Events(c.ctx, query, after, maxItems, wait)
 }
 
 func (c *Local) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
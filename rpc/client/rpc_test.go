@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"math"
+	"net"
 	"net/http"
 	"strings"
 	"sync"
@@ -762,3 +764,141 @@ func TestConcurrentJSONRPCBatching(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestFailover(t *testing.T) {
+	// nothing listens on the first remote
+	remote := rpctest.GetConfig().RPC.ListenAddress
+	c, err := rpchttp.NewFailover([]string{"tcp://127.0.0.1:1", remote}, "/websocket",
+		rpchttp.HealthCheckInterval(100*time.Millisecond))
+	require.NoError(t, err)
+	c.SetLogger(log.TestingLogger())
+
+	// reads are retried on the next node
+	status, err := c.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, rpctest.GetConfig().Moniker, status.NodeInfo.Moniker)
+	assert.Equal(t, remote, c.Remote())
+
+	// writes too, since the unhealthy node could not be connected to
+	_, _, tx := MakeTxKV()
+	_, err = c.BroadcastTxSync(ctx, tx)
+	require.NoError(t, err)
+
+	// errors of the node are not retried
+	_, err = c.Block(ctx, &[]int64{math.MaxInt64}[0])
+	require.Error(t, err)
+
+	require.NoError(t, c.Start())
+	t.Cleanup(func() {
+		if err := c.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	assert.Equal(t, remote, c.Remote())
+
+	out, err := c.Subscribe(ctx, "failover", types.EventQueryNewBlock.String())
+	require.NoError(t, err)
+	select {
+	case event := <-out:
+		_, ok := event.Data.(types.EventDataNewBlock)
+		assert.True(t, ok)
+	case <-time.After(waitForEventTimeout):
+		t.Fatal("timed out waiting for a block")
+	}
+	require.NoError(t, c.UnsubscribeAll(ctx, "failover"))
+}
+
+// tcpProxy forwards the connections it accepts to a remote address, standing
+// for a node which can be killed.
+type tcpProxy struct {
+	net.Listener
+
+	mtx   sync.Mutex
+	conns []net.Conn
+}
+
+func newTCPProxy(t *testing.T, remote string) *tcpProxy {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	p := &tcpProxy{Listener: ln}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			remoteConn, err := net.Dial("tcp", remote)
+			if err != nil {
+				conn.Close()
+				continue
+			}
+			p.mtx.Lock()
+			p.conns = append(p.conns, conn, remoteConn)
+			p.mtx.Unlock()
+			go func() {
+				_, _ = io.Copy(remoteConn, conn)
+				remoteConn.Close()
+			}()
+			go func() {
+				_, _ = io.Copy(conn, remoteConn)
+				conn.Close()
+			}()
+		}
+	}()
+	return p
+}
+
+// Close stops accepting connections, and closes the accepted ones.
+func (p *tcpProxy) Close() error {
+	err := p.Listener.Close()
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, conn := range p.conns {
+		conn.Close()
+	}
+	return err
+}
+
+func TestFailoverEvents(t *testing.T) {
+	// the first node is the one behind the proxy
+	remote := rpctest.GetConfig().RPC.ListenAddress
+	proxy := newTCPProxy(t, strings.TrimPrefix(remote, "tcp://"))
+	proxyRemote := "tcp://" + proxy.Addr().String()
+	c, err := rpchttp.NewFailover([]string{proxyRemote, remote}, "/websocket",
+		rpchttp.HealthCheckInterval(100*time.Millisecond))
+	require.NoError(t, err)
+	c.SetLogger(log.TestingLogger())
+	require.NoError(t, c.Start())
+	t.Cleanup(func() {
+		if err := c.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	require.Equal(t, proxyRemote, c.Remote())
+
+	out, err := c.Subscribe(ctx, "failover", types.EventQueryNewBlock.String())
+	require.NoError(t, err)
+	nextHeight := func() int64 {
+		select {
+		case event := <-out:
+			block, ok := event.Data.(types.EventDataNewBlock)
+			require.True(t, ok)
+			return block.Block.Height
+		case <-time.After(waitForEventTimeout):
+			t.Fatal("timed out waiting for a block")
+			return 0
+		}
+	}
+	height := nextHeight()
+
+	require.NoError(t, proxy.Close())
+
+	// the subscription moves to the second node, and the blocks keep coming
+	for i := 0; i < 3; i++ {
+		next := nextHeight()
+		require.Greater(t, next, height)
+		height = next
+	}
+	assert.Equal(t, remote, c.Remote())
+	require.NoError(t, c.UnsubscribeAll(ctx, "failover"))
+}
@@ -10,6 +10,7 @@ import (
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/indexer"
 	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
 	"github.com/cometbft/cometbft/types"
 )
@@ -165,6 +166,54 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 	return ctypes.NewResultCommit(&header, commit, true), nil
 }
 
+var (
+	// ErrLightBlockNotAvailable is returned by LightBlock for the heights
+	// below the base of the block store, or whose data was pruned.
+	ErrLightBlockNotAvailable = errors.New("light block is not available")
+	// ErrLightBlockTooHigh is returned by LightBlock for the heights above
+	// the latest committed one.
+	ErrLightBlockTooHigh = errors.New("light block height is above the latest height")
+)
+
+// LightBlock gets the signed header and the validator set at a given height,
+// i.e. what the light clients verify. If no height is provided, it will fetch
+// the light block of the latest block, signed by its non-canonical commit.
+// It isn't served over JSON-RPC, where the light clients use /commit and
+// /validators.
+func LightBlock(ctx *rpctypes.Context, heightPtr *int64) (*types.LightBlock, error) {
+	latestHeight := env.BlockStore.Height()
+	height := latestHeight
+	if heightPtr != nil {
+		height = *heightPtr
+		if height <= 0 {
+			return nil, fmt.Errorf("height must be greater than 0, but got %d", height)
+		}
+	}
+	if height > latestHeight || latestHeight == 0 {
+		return nil, fmt.Errorf("%w: height %d, latest height %d", ErrLightBlockTooHigh, height, latestHeight)
+	}
+	if base := env.BlockStore.Base(); height < base {
+		return nil, fmt.Errorf("%w: height %d, lowest height %d", ErrLightBlockNotAvailable, height, base)
+	}
+
+	res, err := Commit(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil || res.Commit == nil {
+		return nil, fmt.Errorf("%w: no commit at height %d", ErrLightBlockNotAvailable, height)
+	}
+	validators, err := env.StateStore.LoadValidators(height)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrLightBlockNotAvailable, err)
+	}
+
+	return &types.LightBlock{
+		SignedHeader: &res.SignedHeader,
+		ValidatorSet: validators,
+	}, nil
+}
+
 // BlockResults gets ABCIResults at a given height.
 // If no height is provided, it will fetch results for the latest block.
 //
@@ -194,12 +243,15 @@ func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockR
 }
 
 // BlockSearch searches for a paginated set of blocks matching BeginBlock and
-// EndBlock event search criteria.
+// EndBlock event search criteria, up to ?limit if set. The blocks can be
+// ordered by an attribute with a range index, e.g.
+// ?order_by="rewards.total desc", rather than by height.
 func BlockSearch(
 	ctx *rpctypes.Context,
 	query string,
 	pagePtr, perPagePtr *int,
 	orderBy string,
+	limitPtr *int,
 ) (*ctypes.ResultBlockSearch, error) {
 	// skip if block indexing is disabled
 	if _, ok := env.BlockIndexer.(*blockidxnull.BlockerIndexer); ok {
@@ -208,41 +260,88 @@ func BlockSearch(
 
 	q, err := cmtquery.New(query)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
 	}
 
-	results, err := env.BlockIndexer.Search(ctx.Context(), q)
+	order, err := parseOrderBy(orderBy, types.BlockHeightKey, true)
 	if err != nil {
 		return nil, err
 	}
+	limit, err := validateLimit(limitPtr)
+	if err != nil {
+		return nil, err
+	}
+	perPage := validatePerPage(perPagePtr)
 
-	// sort results (must be done before pagination)
-	switch orderBy {
-	case "desc", "":
-		sort.Slice(results, func(i, j int) bool { return results[i] > results[j] })
-
-	case "asc":
-		sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+	var (
+		results    []int64
+		totalCount int
+	)
+	if searcher, ok := env.BlockIndexer.(indexer.BlockPageSearcher); ok && order.Key == "" {
+		// the indexer sorts and paginates the results
+		page := 1
+		if pagePtr != nil {
+			page = *pagePtr
+		}
+		skipCount := validateSkipCount(page, perPage)
+		results, totalCount, err = searcher.SearchPage(ctx.Context(), q, indexer.Page{
+			Desc:   order.Desc,
+			Offset: skipCount,
+			Limit:  perPage,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if limit > 0 && totalCount > limit {
+			totalCount = limit
+			results = results[:cmtmath.MaxInt(0, cmtmath.MinInt(len(results), limit-skipCount))]
+		}
+		if _, err := validatePage(pagePtr, perPage, totalCount); err != nil {
+			return nil, err
+		}
+	} else {
+		if order.Key != "" {
+			// the indexer sorts the results by the attribute
+			searcher, ok := env.BlockIndexer.(indexer.BlockOrderSearcher)
+			if !ok {
+				return nil, fmt.Errorf("the indexer can't order by %s", order.Key)
+			}
+			results, err = searcher.SearchOrder(ctx.Context(), q, order, limit)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			results, err = env.BlockIndexer.Search(ctx.Context(), q)
+			if err != nil {
+				return nil, err
+			}
 
-	default:
-		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
-	}
+			// sort results (must be done before pagination)
+			if order.Desc {
+				sort.Slice(results, func(i, j int) bool { return results[i] > results[j] })
+			} else {
+				sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+			}
+		}
+		if limit > 0 && len(results) > limit {
+			results = results[:limit]
+		}
 
-	// paginate results
-	totalCount := len(results)
-	perPage := validatePerPage(perPagePtr)
+		// paginate results
+		totalCount = len(results)
+		page, err := validatePage(pagePtr, perPage, totalCount)
+		if err != nil {
+			return nil, err
+		}
 
-	page, err := validatePage(pagePtr, perPage, totalCount)
-	if err != nil {
-		return nil, err
+		skipCount := validateSkipCount(page, perPage)
+		pageSize := cmtmath.MinInt(perPage, totalCount-skipCount)
+		results = results[skipCount : skipCount+pageSize]
 	}
 
-	skipCount := validateSkipCount(page, perPage)
-	pageSize := cmtmath.MinInt(perPage, totalCount-skipCount)
-
-	apiResults := make([]*ctypes.ResultBlock, 0, pageSize)
-	for i := skipCount; i < skipCount+pageSize; i++ {
-		block := env.BlockStore.LoadBlock(results[i])
+	apiResults := make([]*ctypes.ResultBlock, 0, len(results))
+	for _, height := range results {
+		block := env.BlockStore.LoadBlock(height)
 		if block != nil {
 			blockMeta := env.BlockStore.LoadBlockMeta(block.Height)
 			if blockMeta != nil {
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -15,6 +16,7 @@ import (
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestBlockchainInfo(t *testing.T) {
@@ -118,3 +120,48 @@ func TestBlockResults(t *testing.T) {
 		}
 	}
 }
+
+func TestLightBlock(t *testing.T) {
+	vals, _ := types.RandValidatorSet(2, 10)
+	header := types.Header{ChainID: "test", Height: 50}
+	commit := &types.Commit{Height: 50}
+
+	env = &Environment{}
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Height").Return(int64(100))
+	blockStore.On("Base").Return(int64(10))
+	blockStore.On("LoadBlockMeta", int64(50)).Return(&types.BlockMeta{Header: header})
+	blockStore.On("LoadBlockCommit", int64(50)).Return(commit)
+	blockStore.On("LoadBlockMeta", int64(60)).Return(&types.BlockMeta{Header: types.Header{Height: 60}})
+	blockStore.On("LoadBlockCommit", int64(60)).Return(&types.Commit{Height: 60})
+	env.BlockStore = blockStore
+	stateStore := &mocks.Store{}
+	stateStore.On("LoadValidators", int64(50)).Return(vals, nil)
+	stateStore.On("LoadValidators", int64(60)).Return(nil, errors.New("pruned"))
+	env.StateStore = stateStore
+
+	testCases := []struct {
+		height  int64
+		wantErr error
+	}{
+		{101, ErrLightBlockTooHigh},
+		{5, ErrLightBlockNotAvailable},
+		{60, ErrLightBlockNotAvailable},
+		{50, nil},
+	}
+	for _, tc := range testCases {
+		lb, err := LightBlock(&rpctypes.Context{}, &tc.height)
+		if tc.wantErr != nil {
+			assert.ErrorIs(t, err, tc.wantErr, tc.height)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, &header, lb.Header)
+		assert.Equal(t, commit, lb.Commit)
+		assert.Equal(t, vals, lb.ValidatorSet)
+	}
+
+	height := int64(0)
+	_, err := LightBlock(&rpctypes.Context{}, &height)
+	assert.Error(t, err)
+}
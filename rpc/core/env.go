@@ -2,12 +2,15 @@ package core
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/consensus"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/libs/eventlog"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	mempl "github.com/cometbft/cometbft/mempool"
@@ -67,6 +70,9 @@ type peers interface {
 	AddPrivatePeerIDs([]string) error
 	DialPeersAsync([]string) error
 	Peers() p2p.IPeerSet
+	BanPeer(rule, reason string, duration time.Duration) (p2p.BanEntry, error)
+	UnbanPeer(rule string) error
+	BannedPeers() []p2p.BanEntry
 }
 
 // ----------------------------------------------
@@ -92,6 +98,7 @@ type Environment struct {
 	BlockIndexer     indexer.BlockIndexer
 	ConsensusReactor *consensus.Reactor
 	EventBus         *types.EventBus // thread safe
+	EventLog         *eventlog.Log   // nil if disabled
 	Mempool          mempl.Mempool
 
 	Logger log.Logger
@@ -139,6 +146,49 @@ func validatePerPage(perPagePtr *int) int {
 	return perPage
 }
 
+// parseOrderBy parses the order_by parameter of the searches: "asc", "desc",
+// empty for the order by height defaulting to descending if defaultDesc, or
+// the composite key of an attribute with a range index, optionally followed
+// by "asc" (the default) or "desc", e.g. "transfer.amount desc". The key of
+// the returned order is empty for the order by height, including when the
+// key is heightKey.
+func parseOrderBy(orderBy, heightKey string, defaultDesc bool) (indexer.Order, error) {
+	fields := strings.Fields(orderBy)
+	if len(fields) == 0 {
+		return indexer.Order{Desc: defaultDesc}, nil
+	}
+	if len(fields) == 1 {
+		switch fields[0] {
+		case "asc":
+			return indexer.Order{}, nil
+		case "desc":
+			return indexer.Order{Desc: true}, nil
+		}
+		fields = append(fields, "asc")
+	}
+	if len(fields) != 2 || (fields[1] != "asc" && fields[1] != "desc") {
+		return indexer.Order{}, errors.New(
+			"expected order_by to be either `asc`, `desc`, empty or an attribute followed by `asc` or `desc`")
+	}
+	order := indexer.Order{Key: fields[0], Desc: fields[1] == "desc"}
+	if order.Key == heightKey {
+		order.Key = ""
+	}
+	return order, nil
+}
+
+// validateLimit returns the limit on the number of results of a search, 0
+// for no limit.
+func validateLimit(limitPtr *int) (int, error) {
+	if limitPtr == nil {
+		return 0, nil
+	}
+	if *limitPtr < 0 {
+		return 0, fmt.Errorf("limit should be non-negative, given %d", *limitPtr)
+	}
+	return *limitPtr, nil
+}
+
 // InitGenesisChunks configures the environment and should be called on service
 // startup.
 func InitGenesisChunks() error {
@@ -177,6 +227,30 @@ func validateSkipCount(page, perPage int) int {
 	return skipCount
 }
 
+// ErrHeightTooHigh is returned for the heights above the latest one. The
+// light client HTTP providers match its message.
+type ErrHeightTooHigh struct {
+	Height       int64
+	LatestHeight int64
+}
+
+func (e ErrHeightTooHigh) Error() string {
+	return fmt.Sprintf("height %d must be less than or equal to the current blockchain height %d",
+		e.Height, e.LatestHeight)
+}
+
+// ErrHeightNotAvailable is returned for the heights below the base of the
+// block store, i.e. pruned or not synced. The light client HTTP providers
+// match its message.
+type ErrHeightNotAvailable struct {
+	Height int64
+	Base   int64
+}
+
+func (e ErrHeightNotAvailable) Error() string {
+	return fmt.Sprintf("height %d is not available, lowest height is %d", e.Height, e.Base)
+}
+
 // latestHeight can be either latest committed or uncommitted (+1) height.
 func getHeight(latestHeight int64, heightPtr *int64) (int64, error) {
 	if heightPtr != nil {
@@ -185,13 +259,11 @@ func getHeight(latestHeight int64, heightPtr *int64) (int64, error) {
 			return 0, fmt.Errorf("height must be greater than 0, but got %d", height)
 		}
 		if height > latestHeight {
-			return 0, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d",
-				height, latestHeight)
+			return 0, ErrHeightTooHigh{Height: height, LatestHeight: latestHeight}
 		}
 		base := env.BlockStore.Base()
 		if height < base {
-			return 0, fmt.Errorf("height %d is not available, lowest height is %d",
-				height, base)
+			return 0, ErrHeightNotAvailable{Height: height, Base: base}
 		}
 		return height, nil
 	}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/cometbft/cometbft/state/indexer"
 )
 
 func TestPaginationPage(t *testing.T) {
@@ -80,3 +82,48 @@ func TestPaginationPerPage(t *testing.T) {
 	p := validatePerPage(nil)
 	assert.Equal(t, defaultPerPage, p)
 }
+
+func TestParseOrderBy(t *testing.T) {
+	cases := []struct {
+		orderBy string
+		order   indexer.Order
+		expErr  bool
+	}{
+		{"", indexer.Order{Desc: true}, false},
+		{"asc", indexer.Order{}, false},
+		{"desc", indexer.Order{Desc: true}, false},
+		{"transfer.amount", indexer.Order{Key: "transfer.amount"}, false},
+		{"transfer.amount asc", indexer.Order{Key: "transfer.amount"}, false},
+		{" transfer.amount  desc ", indexer.Order{Key: "transfer.amount", Desc: true}, false},
+		{"tx.height desc", indexer.Order{Desc: true}, false},
+		{"transfer.amount down", indexer.Order{}, true},
+		{"transfer.amount asc desc", indexer.Order{}, true},
+	}
+
+	for _, c := range cases {
+		order, err := parseOrderBy(c.orderBy, "tx.height", true)
+		if c.expErr {
+			assert.Error(t, err, c.orderBy)
+			continue
+		}
+		if assert.NoError(t, err, c.orderBy) {
+			assert.Equal(t, c.order, order, c.orderBy)
+		}
+	}
+}
+
+func TestValidateLimit(t *testing.T) {
+	limit, err := validateLimit(nil)
+	assert.NoError(t, err)
+	assert.Zero(t, limit)
+
+	for _, l := range []int{0, 1, 1000} {
+		limit, err := validateLimit(&l)
+		assert.NoError(t, err)
+		assert.Equal(t, l, limit)
+	}
+
+	negative := -1
+	_, err = validateLimit(&negative)
+	assert.Error(t, err)
+}
@@ -6,44 +6,63 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cometbft/cometbft/libs/eventlog"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
 )
 
 const (
 	// maxQueryLength is the maximum length of a query string that will be
 	// accepted. This is just a safety check to avoid outlandish queries.
 	maxQueryLength = 512
+
+	// maxEventsWaitTime is the maximum time /events waits for new events. It
+	// must be less than the server's write timeout.
+	maxEventsWaitTime = 5 * time.Second
+)
+
+var (
+	errEventLogDisabled = errors.New("event log is disabled")
+
+	// ErrInvalidQuery is returned for the queries which are too long or fail
+	// to parse.
+	ErrInvalidQuery = errors.New("invalid query")
 )
 
-// Subscribe for events via WebSocket.
+// Subscribe for events via WebSocket. If after is set, the subscription
+// starts with the events after that cursor of the event log, and the events
+// are read from the event log.
 // More: https://docs.cometbft.com/v0.37/rpc/#/Websocket/subscribe
-func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+func Subscribe(ctx *rpctypes.Context, query, after string) (*ctypes.ResultSubscribe, error) {
 	addr := ctx.RemoteAddr()
 
-	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
-		return nil, fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
-	} else if env.EventBus.NumClientSubscriptions(addr) >= env.Config.MaxSubscriptionsPerClient {
-		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
-	} else if len(query) > maxQueryLength {
-		return nil, errors.New("maximum query length exceeded")
+	var cursor eventlog.Cursor
+	if after != "" {
+		if env.EventLog == nil {
+			return nil, errEventLogDisabled
+		}
+		var err error
+		if cursor, err = eventlog.ParseCursor(after); err != nil {
+			return nil, err
+		}
 	}
 
-	env.Logger.Info("Subscribe to query", "remote", addr, "query", query)
-
-	q, err := cmtquery.New(query)
+	sub, err := SubscribeClient(ctx.Context(), addr, query)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse query: %w", err)
+		return nil, err
 	}
 
-	subCtx, cancel := context.WithTimeout(ctx.Context(), SubscribeTimeout)
-	defer cancel()
-
-	sub, err := env.EventBus.Subscribe(subCtx, addr, q, env.Config.SubscriptionBufferSize)
-	if err != nil {
-		return nil, err
+	if after != "" {
+		if err := streamEventLog(ctx, sub, query, cursor); err != nil {
+			if err := UnsubscribeClient(addr, query); err != nil {
+				env.Logger.Error("Failed to unsubscribe", "remote", addr, "query", query, "err", err)
+			}
+			return nil, err
+		}
+		return &ctypes.ResultSubscribe{}, nil
 	}
 
 	closeIfSlow := env.Config.CloseOnSlowClient
@@ -77,17 +96,8 @@ func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, er
 					}
 				}
 			case <-sub.Cancelled():
-				if sub.Err() != cmtpubsub.ErrUnsubscribed {
-					var reason string
-					if sub.Err() == nil {
-						reason = "CometBFT exited"
-					} else {
-						reason = sub.Err().Error()
-					}
-					var (
-						err  = fmt.Errorf("subscription was canceled (reason: %s)", reason)
-						resp = rpctypes.RPCServerError(subscriptionID, err)
-					)
+				if err := canceledSubscriptionError(sub); err != nil {
+					resp := rpctypes.RPCServerError(subscriptionID, err)
 					if !ctx.WSConn.TryWriteRPCResponse(resp) {
 						env.Logger.Info("Can't write response (slow client)",
 							"to", addr, "subscriptionID", subscriptionID, "err", err)
@@ -101,6 +111,159 @@ func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, er
 	return &ctypes.ResultSubscribe{}, nil
 }
 
+// streamEventLog writes the events of the event log after the cursor, and
+// then the new ones as they are logged, matching the query of the
+// subscription, to the WebSocket connection. The subscription only serves to
+// apply the limits on subscriptions, and to know when the client
+// unsubscribes. Its events are drained independently of the writes, so that
+// a burst of events doesn't cancel it while the client is catching up.
+//
+// It returns an error if the cursor has expired already. If the client falls
+// so far behind that its cursor expires later on, the subscription is
+// canceled with an error.
+func streamEventLog(ctx *rpctypes.Context, sub types.Subscription, query string, cursor eventlog.Cursor) error {
+	addr := ctx.RemoteAddr()
+	q, err := cmtquery.New(query)
+	if err != nil {
+		return fmt.Errorf("failed to parse query: %w", err)
+	}
+	if _, _, _, err := env.EventLog.Scan(cursor, q, 0); err != nil {
+		return err
+	}
+
+	// Capture the current ID, since it can change in the future.
+	subscriptionID := ctx.JSONReq.ID
+	cancelSubscription := func(err error) {
+		resp := rpctypes.RPCServerError(subscriptionID, err)
+		if !ctx.WSConn.TryWriteRPCResponse(resp) {
+			env.Logger.Info("Can't write response (slow client)",
+				"to", addr, "subscriptionID", subscriptionID, "err", err)
+		}
+		if err := UnsubscribeClient(addr, query); err != nil {
+			env.Logger.Error("Failed to unsubscribe", "remote", addr, "query", query, "err", err)
+		}
+	}
+
+	// the events are read from the event log
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		for {
+			select {
+			case <-sub.Out():
+			case <-sub.Cancelled():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			changed := env.EventLog.Changed()
+			items, next, more, err := env.EventLog.Scan(cursor, q, maxPerPage)
+			if err != nil {
+				cancelSubscription(fmt.Errorf("subscription was canceled (reason: %w)", err))
+				return
+			}
+			for _, item := range items {
+				var (
+					resultEvent = &ctypes.ResultEvent{
+						Query:  query,
+						Data:   item.Data,
+						Events: item.Events,
+						Cursor: item.Cursor.String(),
+					}
+					resp = rpctypes.NewRPCSuccessResponse(subscriptionID, resultEvent)
+				)
+				writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				err := ctx.WSConn.WriteRPCResponse(writeCtx, resp)
+				cancel()
+				if err != nil {
+					env.Logger.Info("Can't write response (slow client)",
+						"to", addr, "subscriptionID", subscriptionID, "err", err)
+					// the client can resume after the last event it received
+					cancelSubscription(errors.New("subscription was canceled (reason: slow client)"))
+					return
+				}
+			}
+			cursor = next
+			if more {
+				continue
+			}
+
+			select {
+			case <-changed:
+			case <-drained:
+				if err := canceledSubscriptionError(sub); err != nil {
+					resp := rpctypes.RPCServerError(subscriptionID, err)
+					if !ctx.WSConn.TryWriteRPCResponse(resp) {
+						env.Logger.Info("Can't write response (slow client)",
+							"to", addr, "subscriptionID", subscriptionID, "err", err)
+					}
+				}
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// canceledSubscriptionError returns the error to report to the client whose
+// subscription was canceled, nil if the client unsubscribed.
+func canceledSubscriptionError(sub types.Subscription) error {
+	if sub.Err() == cmtpubsub.ErrUnsubscribed {
+		return nil
+	}
+	reason := "CometBFT exited"
+	if sub.Err() != nil {
+		reason = sub.Err().Error()
+	}
+	return fmt.Errorf("subscription was canceled (reason: %s)", reason)
+}
+
+// SubscribeClient subscribes the subscriber to the events matching the query,
+// within the same limits as the subscriptions made via WebSocket. It lets
+// other servers, like the gRPC one, stream events. The caller must
+// unsubscribe with UnsubscribeClient once done.
+func SubscribeClient(ctx context.Context, subscriber, query string) (types.Subscription, error) {
+	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
+		return nil, fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
+	} else if env.EventBus.NumClientSubscriptions(subscriber) >= env.Config.MaxSubscriptionsPerClient {
+		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
+	} else if len(query) > maxQueryLength {
+		return nil, fmt.Errorf("%w: maximum query length exceeded", ErrInvalidQuery)
+	}
+
+	env.Logger.Info("Subscribe to query", "remote", subscriber, "query", query)
+
+	q, err := cmtquery.New(query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
+	}
+
+	subCtx, cancel := context.WithTimeout(ctx, SubscribeTimeout)
+	defer cancel()
+
+	return env.EventBus.Subscribe(subCtx, subscriber, q, env.Config.SubscriptionBufferSize)
+}
+
+// MaxClientSubscriptions returns max_subscriptions_per_client, for the
+// servers subscribing each stream of a client separately, like the gRPC one,
+// to apply it to the client.
+func MaxClientSubscriptions() int {
+	return env.Config.MaxSubscriptionsPerClient
+}
+
+// UnsubscribeClient cancels the subscription of the subscriber to the query,
+// made with SubscribeClient.
+func UnsubscribeClient(subscriber, query string) error {
+	q, err := cmtquery.New(query)
+	if err != nil {
+		return fmt.Errorf("failed to parse query: %w", err)
+	}
+	return env.EventBus.Unsubscribe(context.Background(), subscriber, q)
+}
+
 // Unsubscribe from events via WebSocket.
 // More: https://docs.cometbft.com/v0.37/rpc/#/Websocket/unsubscribe
 func Unsubscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultUnsubscribe, error) {
@@ -128,3 +291,79 @@ func UnsubscribeAll(ctx *rpctypes.Context) (*ctypes.ResultUnsubscribe, error) {
 	}
 	return &ctypes.ResultUnsubscribe{}, nil
 }
+
+// Events returns up to max_items events of the event log after the given
+// cursor, oldest first, which match the query, all of them if it is empty.
+// Only the NewBlock and Tx events are logged. If there are no such events
+// yet, it waits up to wait_time (e.g. "2s") for some.
+//
+// It returns an error if events after the cursor were dropped from the log
+// already: the client missed them.
+func Events(
+	ctx *rpctypes.Context,
+	query, after string,
+	maxItemsPtr *int,
+	waitTime string,
+) (*ctypes.ResultEvents, error) {
+	if env.EventLog == nil {
+		return nil, errEventLogDisabled
+	}
+
+	var q cmtpubsub.Query = cmtquery.Empty{}
+	if query != "" {
+		if len(query) > maxQueryLength {
+			return nil, errors.New("maximum query length exceeded")
+		}
+		var err error
+		if q, err = cmtquery.New(query); err != nil {
+			return nil, fmt.Errorf("failed to parse query: %w", err)
+		}
+	}
+	cursor, err := eventlog.ParseCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	maxItems := validatePerPage(maxItemsPtr)
+	var wait time.Duration
+	if waitTime != "" {
+		if wait, err = time.ParseDuration(waitTime); err != nil {
+			return nil, fmt.Errorf("invalid wait_time: %w", err)
+		}
+		if wait > maxEventsWaitTime {
+			wait = maxEventsWaitTime
+		}
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx.Context(), wait)
+	defer cancel()
+	for {
+		changed := env.EventLog.Changed()
+		items, next, more, err := env.EventLog.Scan(cursor, q, maxItems)
+		if err != nil {
+			return nil, err
+		}
+		cursor = next
+		if len(items) > 0 || more {
+			result := &ctypes.ResultEvents{
+				Items:  make([]*ctypes.ResultEvent, len(items)),
+				Cursor: cursor.String(),
+				More:   more,
+			}
+			for i, item := range items {
+				result.Items[i] = &ctypes.ResultEvent{
+					Query:  query,
+					Data:   item.Data,
+					Events: item.Events,
+					Cursor: item.Cursor.String(),
+				}
+			}
+			return result, nil
+		}
+
+		select {
+		case <-changed:
+		case <-waitCtx.Done():
+			return &ctypes.ResultEvents{Items: []*ctypes.ResultEvent{}, Cursor: cursor.String()}, nil
+		}
+	}
+}
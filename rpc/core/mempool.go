@@ -20,7 +20,7 @@ import (
 // CheckTx nor DeliverTx results.
 // More: https://docs.cometbft.com/v0.37/rpc/#/Tx/broadcast_tx_async
 func BroadcastTxAsync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
-	err := env.Mempool.CheckTx(tx, nil, mempl.TxInfo{})
+	err := env.Mempool.CheckTx(tx, nil, mempl.TxInfo{SenderRPCAddr: ctx.RemoteAddr()})
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +37,7 @@ func BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcas
 		case <-ctx.Context().Done():
 		case resCh <- res:
 		}
-	}, mempl.TxInfo{})
+	}, mempl.TxInfo{SenderRPCAddr: ctx.RemoteAddr()})
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +91,7 @@ func BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadc
 		case <-ctx.Context().Done():
 		case checkTxResCh <- res:
 		}
-	}, mempl.TxInfo{})
+	}, mempl.TxInfo{SenderRPCAddr: ctx.RemoteAddr()})
 	if err != nil {
 		env.Logger.Error("Error on broadcastTxCommit", "err", err)
 		return nil, fmt.Errorf("error on broadcastTxCommit: %v", err)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/p2p"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -92,6 +93,40 @@ func UnsafeDialPeers(ctx *rpctypes.Context, peers []string, persistent, uncondit
 	return &ctypes.ResultDialPeers{Log: "Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// UnsafeBanPeer bans a node ID, an IP address or a CIDR range for duration
+// (e.g. "24h"), or forever if duration is empty, and disconnects the matching
+// peers.
+func UnsafeBanPeer(ctx *rpctypes.Context, rule, reason, duration string) (*ctypes.ResultBanPeer, error) {
+	var d time.Duration
+	if duration != "" {
+		var err error
+		d, err = time.ParseDuration(duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
+		}
+	}
+	env.Logger.Info("BanPeer", "rule", rule, "reason", reason, "duration", d)
+	entry, err := env.P2PPeers.BanPeer(rule, reason, d)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBanPeer{Entry: entry}, nil
+}
+
+// UnsafeUnbanPeer lifts the ban of a node ID, an IP address or a CIDR range.
+func UnsafeUnbanPeer(ctx *rpctypes.Context, rule string) (*ctypes.ResultUnbanPeer, error) {
+	env.Logger.Info("UnbanPeer", "rule", rule)
+	if err := env.P2PPeers.UnbanPeer(rule); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultUnbanPeer{}, nil
+}
+
+// UnsafeBanList returns the bans in effect.
+func UnsafeBanList(ctx *rpctypes.Context) (*ctypes.ResultBanList, error) {
+	return &ctypes.ResultBanList{Entries: env.P2PPeers.BannedPeers()}, nil
+}
+
 // Genesis returns genesis file.
 // More: https://docs.cometbft.com/v0.37/rpc/#/Info/genesis
 func Genesis(ctx *rpctypes.Context) (*ctypes.ResultGenesis, error) {
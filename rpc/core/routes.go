@@ -9,7 +9,7 @@ import (
 // Routes is a map of available routes.
 var Routes = map[string]*rpc.RPCFunc{
 	// subscribe/unsubscribe are reserved for websocket events.
-	"subscribe":       rpc.NewWSRPCFunc(Subscribe, "query"),
+	"subscribe":       rpc.NewWSRPCFunc(Subscribe, "query,after"),
 	"unsubscribe":     rpc.NewWSRPCFunc(Unsubscribe, "query"),
 	"unsubscribe_all": rpc.NewWSRPCFunc(UnsubscribeAll, ""),
 
@@ -28,8 +28,9 @@ var Routes = map[string]*rpc.RPCFunc{
 	"header_by_hash":       rpc.NewRPCFunc(HeaderByHash, "hash", rpc.Cacheable()),
 	"check_tx":             rpc.NewRPCFunc(CheckTx, "tx"),
 	"tx":                   rpc.NewRPCFunc(Tx, "hash,prove", rpc.Cacheable()),
-	"tx_search":            rpc.NewRPCFunc(TxSearch, "query,prove,page,per_page,order_by"),
-	"block_search":         rpc.NewRPCFunc(BlockSearch, "query,page,per_page,order_by"),
+	"tx_search":            rpc.NewRPCFunc(TxSearch, "query,prove,page,per_page,order_by,limit"),
+	"block_search":         rpc.NewRPCFunc(BlockSearch, "query,page,per_page,order_by,limit"),
+	"events":               rpc.NewRPCFunc(Events, "query,after,max_items,wait_time"),
 	"validators":           rpc.NewRPCFunc(Validators, "height,page,per_page", rpc.Cacheable("height")),
 	"dump_consensus_state": rpc.NewRPCFunc(DumpConsensusState, ""),
 	"consensus_state":      rpc.NewRPCFunc(ConsensusState, ""),
@@ -38,22 +39,25 @@ var Routes = map[string]*rpc.RPCFunc{
 	"num_unconfirmed_txs":  rpc.NewRPCFunc(NumUnconfirmedTxs, ""),
 
 	// tx broadcast API
-	"broadcast_tx_commit": rpc.NewRPCFunc(BroadcastTxCommit, "tx"),
-	"broadcast_tx_sync":   rpc.NewRPCFunc(BroadcastTxSync, "tx"),
-	"broadcast_tx_async":  rpc.NewRPCFunc(BroadcastTxAsync, "tx"),
+	"broadcast_tx_commit": rpc.NewRPCFunc(BroadcastTxCommit, "tx", rpc.WithScope(rpc.ScopeBroadcast)),
+	"broadcast_tx_sync":   rpc.NewRPCFunc(BroadcastTxSync, "tx", rpc.WithScope(rpc.ScopeBroadcast)),
+	"broadcast_tx_async":  rpc.NewRPCFunc(BroadcastTxAsync, "tx", rpc.WithScope(rpc.ScopeBroadcast)),
 
 	// abci API
 	"abci_query": rpc.NewRPCFunc(ABCIQuery, "path,data,height,prove"),
 	"abci_info":  rpc.NewRPCFunc(ABCIInfo, "", rpc.Cacheable()),
 
 	// evidence API
-	"broadcast_evidence": rpc.NewRPCFunc(BroadcastEvidence, "evidence"),
+	"broadcast_evidence": rpc.NewRPCFunc(BroadcastEvidence, "evidence", rpc.WithScope(rpc.ScopeBroadcast)),
 }
 
 // AddUnsafeRoutes adds unsafe routes.
 func AddUnsafeRoutes() {
 	// control API
-	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
-	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private")
-	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds", rpc.WithScope(rpc.ScopeUnsafe))
+	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private", rpc.WithScope(rpc.ScopeUnsafe))
+	Routes["ban_peer"] = rpc.NewRPCFunc(UnsafeBanPeer, "rule,reason,duration", rpc.WithScope(rpc.ScopeUnsafe))
+	Routes["unban_peer"] = rpc.NewRPCFunc(UnsafeUnbanPeer, "rule", rpc.WithScope(rpc.ScopeUnsafe))
+	Routes["ban_list"] = rpc.NewRPCFunc(UnsafeBanList, "", rpc.WithScope(rpc.ScopeUnsafe))
+	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "", rpc.WithScope(rpc.ScopeUnsafe))
 }
@@ -0,0 +1,257 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/eventlog"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpc "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// sseWriteTimeout is the maximum time to write an event to the stream,
+	// after which the client is considered too slow.
+	sseWriteTimeout = 10 * time.Second
+
+	// sseKeepAlivePeriod is how often a comment is written to an idle
+	// stream, so that proxies don't close it.
+	sseKeepAlivePeriod = 15 * time.Second
+)
+
+// EventStream streams the events matching the query (e.g.
+// /event_stream?query=tm.event%3D'NewBlock') as server-sent events, each
+// event being a ResultEvent, in JSON. It is meant for the clients which
+// can't use the websocket, like browsers using EventSource. The
+// subscriptions count towards the same limits as the websocket ones.
+//
+// If the event log is enabled and the query only matches the events it
+// records, the NewBlock and Tx events, the events are read from it, and their
+// id is their cursor. The stream then starts after the cursor in the
+// Last-Event-ID header, or in the last_event_id parameter, so that clients
+// resume where they left off when reconnecting, or with the new events if
+// there is none. If the client missed events, i.e. the cursor expired, it
+// fails with a 410. The other queries are served from the subscription.
+//
+// Once streaming, errors, like the subscription being canceled, are sent as
+// an "error" event before the stream ends.
+func EventStream(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		writeSSEError(w, http.StatusBadRequest, errors.New("missing query"))
+		return
+	} else if len(query) > maxQueryLength {
+		writeSSEError(w, http.StatusBadRequest, errors.New("maximum query length exceeded"))
+		return
+	}
+	q, err := cmtquery.New(query)
+	if err != nil {
+		writeSSEError(w, http.StatusBadRequest, fmt.Errorf("failed to parse query: %w", err))
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+	var cursor eventlog.Cursor
+	fromLog := env.EventLog != nil && isLoggedQuery(q)
+	if fromLog {
+		if cursor, err = eventlog.ParseCursor(lastEventID); err != nil {
+			writeSSEError(w, http.StatusBadRequest, err)
+			return
+		}
+		if cursor.IsZero() {
+			cursor = env.EventLog.Newest()
+		}
+		if _, _, _, err := env.EventLog.Scan(cursor, q, 0); err != nil {
+			code := http.StatusBadRequest
+			if errors.Is(err, eventlog.ErrCursorExpired) {
+				code = http.StatusGone
+			}
+			writeSSEError(w, code, err)
+			return
+		}
+	} else if lastEventID != "" {
+		err := errEventLogDisabled
+		if env.EventLog != nil {
+			err = errors.New("the event log only records the NewBlock and Tx events")
+		}
+		writeSSEError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	addr := r.RemoteAddr
+	sub, err := SubscribeClient(r.Context(), addr, query)
+	if err != nil {
+		writeSSEError(w, http.StatusServiceUnavailable, err)
+		return
+	}
+	defer func() {
+		err := UnsubscribeClient(addr, query)
+		if err != nil && err != cmtpubsub.ErrSubscriptionNotFound {
+			env.Logger.Error("Failed to unsubscribe", "remote", addr, "query", query, "err", err)
+		}
+	}()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// disable the buffering of nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	var buf bytes.Buffer
+	write := func() error {
+		// the deadline of the server only suits requests, not streams
+		if err := rc.SetWriteDeadline(time.Now().Add(sseWriteTimeout)); err != nil &&
+			!errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		defer buf.Reset()
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+	writeEvent := func(id string, event *ctypes.ResultEvent) error {
+		data, err := cmtjson.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			fmt.Fprintf(&buf, "id: %s\n", id)
+		}
+		fmt.Fprintf(&buf, "data: %s\n\n", data)
+		return write()
+	}
+	writeError := func(err error) {
+		fmt.Fprintf(&buf, "event: error\ndata: %s\n\n", err)
+		if err := write(); err != nil {
+			env.Logger.Info("Can't write event (slow client)", "to", addr, "err", err)
+		}
+	}
+	if err := write(); err != nil {
+		return
+	}
+
+	out := sub.Out()
+	if fromLog {
+		// nil, so never ready, as the events are read from the event log
+		out = nil
+		// The events of the subscription are drained independently of the
+		// writes, so that a burst of events doesn't cancel it while the
+		// client is catching up. The routine ends when the subscription is
+		// canceled, which the handler does on returning.
+		go func() {
+			for {
+				select {
+				case <-sub.Out():
+				case <-sub.Cancelled():
+					return
+				}
+			}
+		}()
+	}
+
+	keepAlive := time.NewTicker(sseKeepAlivePeriod)
+	defer keepAlive.Stop()
+	for {
+		// nil, so never ready, if the events are not read from the event log
+		var changed <-chan struct{}
+		if fromLog {
+			changed = env.EventLog.Changed()
+			items, next, more, err := env.EventLog.Scan(cursor, q, maxPerPage)
+			if err != nil {
+				writeError(fmt.Errorf("subscription was canceled (reason: %w)", err))
+				return
+			}
+			for _, item := range items {
+				err := writeEvent(item.Cursor.String(), &ctypes.ResultEvent{
+					Query:  query,
+					Data:   item.Data,
+					Events: item.Events,
+					Cursor: item.Cursor.String(),
+				})
+				if err != nil {
+					env.Logger.Info("Can't write event (slow client)", "to", addr, "err", err)
+					return
+				}
+			}
+			cursor = next
+			if more {
+				continue
+			}
+		}
+
+		select {
+		case <-changed:
+		case msg := <-out:
+			err := writeEvent("", &ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()})
+			if err != nil {
+				env.Logger.Info("Can't write event (slow client)", "to", addr, "err", err)
+				return
+			}
+		case <-sub.Cancelled():
+			if err := canceledSubscriptionError(sub); err != nil {
+				writeError(err)
+			}
+			return
+		case <-keepAlive.C:
+			buf.WriteString(": keep-alive\n\n")
+			if err := write(); err != nil {
+				return
+			}
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// isLoggedQuery returns true if q only matches the events recorded by the
+// event log, i.e. if it requires tm.event to be NewBlock or Tx.
+func isLoggedQuery(q *cmtquery.Query) bool {
+	var logged func(e *cmtquery.Expr) bool
+	logged = func(e *cmtquery.Expr) bool {
+		switch e.Op {
+		case cmtquery.ExprCondition:
+			c := e.Condition
+			return c.CompositeKey == types.EventTypeKey && c.Op == cmtquery.OpEqual &&
+				(c.Operand == types.EventNewBlock || c.Operand == types.EventTx)
+		case cmtquery.ExprAnd:
+			for _, arg := range e.Args {
+				if logged(arg) {
+					return true
+				}
+			}
+			return false
+		case cmtquery.ExprOr:
+			for _, arg := range e.Args {
+				if !logged(arg) {
+					return false
+				}
+			}
+			return true
+		default:
+			return false
+		}
+	}
+	return logged(q.Expr())
+}
+
+// writeSSEError writes the error which prevented the stream from starting as
+// a JSON-RPC error.
+func writeSSEError(w http.ResponseWriter, code int, err error) {
+	res := rpctypes.RPCInvalidRequestError(nil, err)
+	if wErr := rpc.WriteRPCResponseHTTPError(w, code, res); wErr != nil {
+		env.Logger.Error("failed to write response", "res", res, "err", wErr)
+	}
+}
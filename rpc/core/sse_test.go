@@ -0,0 +1,30 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+)
+
+func TestIsLoggedQuery(t *testing.T) {
+	testCases := []struct {
+		query  string
+		logged bool
+	}{
+		{`tm.event = 'NewBlock'`, true},
+		{`tm.event = 'Tx' AND tx.height > 5`, true},
+		{`tm.event IN ('NewBlock', 'Tx')`, true},
+		{`tx.height > 5 AND (tm.event = 'Tx' OR tm.event = 'NewBlock')`, true},
+		{`tm.event = 'NewRound'`, false},
+		{`tm.event IN ('NewBlock', 'NewRound')`, false},
+		{`tm.event = 'Tx' OR tx.height > 5`, false},
+		{`NOT tm.event = 'Tx'`, false},
+		{`tm.event CONTAINS 'Tx'`, false},
+		{`tx.height > 5`, false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.logged, isLoggedQuery(cmtquery.MustParse(tc.query)), tc.query)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -44,6 +46,11 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 		}
 	}
 
+	earliestIndexed, err := earliestIndexedHeight()
+	if err != nil {
+		return nil, err
+	}
+
 	// Return the very last voting power, not the voting power of this validator
 	// during the last block.
 	var votingPower int64
@@ -62,7 +69,10 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			EarliestAppHash:     earliestAppHash,
 			EarliestBlockHeight: earliestBlockHeight,
 			EarliestBlockTime:   time.Unix(0, earliestBlockTimeNano),
-			CatchingUp:          env.ConsensusReactor.WaitSync(),
+
+			EarliestIndexedHeight: earliestIndexed,
+
+			CatchingUp: env.ConsensusReactor.WaitSync(),
 		},
 		ValidatorInfo: ctypes.ValidatorInfo{
 			Address:     env.PubKey.Address(),
@@ -74,6 +84,45 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 	return result, nil
 }
 
+// earliestIndexedHeight returns the lowest height which can be searched: the
+// retain height of the pruned indexers, or else the first indexed height, or
+// 1. The indexes are pruned independently of the blocks, so it may be below
+// the base of the block store.
+func earliestIndexedHeight() (int64, error) {
+	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
+		return 0, nil
+	}
+
+	var height int64
+	for _, idxr := range []interface{}{env.TxIndexer, env.BlockIndexer} {
+		pruner, ok := idxr.(indexer.Pruner)
+		if !ok {
+			continue
+		}
+		retainHeight, err := pruner.RetainHeight()
+		if err != nil {
+			return 0, err
+		}
+		if retainHeight > height {
+			height = retainHeight
+		}
+	}
+	if height > 0 {
+		return height, nil
+	}
+
+	if fh, ok := env.BlockIndexer.(indexer.FirstHeighter); ok {
+		first, err := fh.FirstHeight()
+		if err != nil {
+			return 0, err
+		}
+		if first > 0 {
+			return first, nil
+		}
+	}
+	return 1, nil
+}
+
 func validatorAtHeight(h int64) *types.Validator {
 	vals, err := env.StateStore.LoadValidators(h)
 	if err != nil {
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/mocks"
+	txidxkv "github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+)
+
+type statusTransport struct{}
+
+func (statusTransport) Listeners() []string    { return nil }
+func (statusTransport) IsListening() bool      { return true }
+func (statusTransport) NodeInfo() p2p.NodeInfo { return p2p.DefaultNodeInfo{} }
+
+func TestStatusEarliestIndexedHeight(t *testing.T) {
+	env = &Environment{}
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Height").Return(int64(100))
+	blockStore.On("LoadBaseMeta").Return(&types.BlockMeta{Header: types.Header{Height: 50}})
+	blockStore.On("LoadBlockMeta", int64(100)).Return(&types.BlockMeta{Header: types.Header{Height: 100}})
+	env.BlockStore = blockStore
+	stateStore := &mocks.Store{}
+	stateStore.On("LoadValidators", mock.Anything).Return(nil, errors.New("pruned"))
+	env.StateStore = stateStore
+	env.ConsensusReactor = &consensus.Reactor{}
+	env.P2PTransport = statusTransport{}
+	env.PubKey = ed25519.GenPrivKey().PubKey()
+	txIndexer := txidxkv.NewTxIndex(dbm.NewMemDB())
+	blockIndexer := blockidxkv.New(dbm.NewMemDB())
+	env.TxIndexer = txIndexer
+	env.BlockIndexer = blockIndexer
+
+	// the blocks are retained for less heights than the indexes
+	res, err := Status(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 50, res.SyncInfo.EarliestBlockHeight)
+	assert.EqualValues(t, 1, res.SyncInfo.EarliestIndexedHeight)
+
+	for h := int64(20); h <= 100; h++ {
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{Header: types.Header{Height: h}}))
+	}
+	res, err = Status(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 20, res.SyncInfo.EarliestIndexedHeight)
+
+	require.NoError(t, txIndexer.Prune(context.Background(), 30))
+	require.NoError(t, blockIndexer.Prune(context.Background(), 30))
+	res, err = Status(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 50, res.SyncInfo.EarliestBlockHeight)
+	assert.EqualValues(t, 30, res.SyncInfo.EarliestIndexedHeight)
+}
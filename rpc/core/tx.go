@@ -5,14 +5,20 @@ import (
 	"fmt"
 	"sort"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/types"
 )
 
+// ErrTxNotFound is returned by Tx for the transactions which aren't indexed.
+var ErrTxNotFound = errors.New("tx not found")
+
 // Tx allows you to query the transaction results. `nil` could mean the
 // transaction is in the mempool, invalidated, or was not sent in the first
 // place.
@@ -29,7 +35,7 @@ func Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error
 	}
 
 	if r == nil {
-		return nil, fmt.Errorf("tx (%X) not found", hash)
+		return nil, fmt.Errorf("%w: %X", ErrTxNotFound, hash)
 	}
 
 	var proof types.TxProof
@@ -49,7 +55,9 @@ func Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error
 }
 
 // TxSearch allows you to query for multiple transactions results. It returns a
-// list of transactions (maximum ?per_page entries) and the total count.
+// list of transactions (maximum ?per_page entries) and the total count, up to
+// ?limit if set. The transactions can be ordered by an attribute with a range
+// index, e.g. ?order_by="transfer.amount desc", rather than by height.
 // More: https://docs.cometbft.com/v0.37/rpc/#/Info/tx_search
 func TxSearch(
 	ctx *rpctypes.Context,
@@ -57,60 +65,108 @@ func TxSearch(
 	prove bool,
 	pagePtr, perPagePtr *int,
 	orderBy string,
+	limitPtr *int,
 ) (*ctypes.ResultTxSearch, error) {
 	// if index is disabled, return error
 	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
 		return nil, errors.New("transaction indexing is disabled")
 	} else if len(query) > maxQueryLength {
-		return nil, errors.New("maximum query length exceeded")
+		return nil, fmt.Errorf("%w: maximum query length exceeded", ErrInvalidQuery)
 	}
 
 	q, err := cmtquery.New(query)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQuery, err)
 	}
 
-	results, err := env.TxIndexer.Search(ctx.Context(), q)
+	order, err := parseOrderBy(orderBy, types.TxHeightKey, false)
 	if err != nil {
 		return nil, err
 	}
+	limit, err := validateLimit(limitPtr)
+	if err != nil {
+		return nil, err
+	}
+	perPage := validatePerPage(perPagePtr)
 
-	// sort results (must be done before pagination)
-	switch orderBy {
-	case "desc":
-		sort.Slice(results, func(i, j int) bool {
-			if results[i].Height == results[j].Height {
-				return results[i].Index > results[j].Index
-			}
-			return results[i].Height > results[j].Height
+	var (
+		results    []*abci.TxResult
+		totalCount int
+	)
+	if searcher, ok := env.TxIndexer.(txindex.PageSearcher); ok && order.Key == "" {
+		// the indexer sorts and paginates the results
+		page := 1
+		if pagePtr != nil {
+			page = *pagePtr
+		}
+		skipCount := validateSkipCount(page, perPage)
+		results, totalCount, err = searcher.SearchPage(ctx.Context(), q, indexer.Page{
+			Desc:   order.Desc,
+			Offset: skipCount,
+			Limit:  perPage,
 		})
-	case "asc", "":
-		sort.Slice(results, func(i, j int) bool {
-			if results[i].Height == results[j].Height {
-				return results[i].Index < results[j].Index
+		if err != nil {
+			return nil, err
+		}
+		if limit > 0 && totalCount > limit {
+			totalCount = limit
+			results = results[:cmtmath.MaxInt(0, cmtmath.MinInt(len(results), limit-skipCount))]
+		}
+		if _, err := validatePage(pagePtr, perPage, totalCount); err != nil {
+			return nil, err
+		}
+	} else {
+		if order.Key != "" {
+			// the indexer sorts the results by the attribute
+			searcher, ok := env.TxIndexer.(txindex.OrderSearcher)
+			if !ok {
+				return nil, fmt.Errorf("the indexer can't order by %s", order.Key)
+			}
+			results, err = searcher.SearchOrder(ctx.Context(), q, order, limit)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			results, err = env.TxIndexer.Search(ctx.Context(), q)
+			if err != nil {
+				return nil, err
 			}
-			return results[i].Height < results[j].Height
-		})
-	default:
-		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
-	}
-
-	// paginate results
-	totalCount := len(results)
-	perPage := validatePerPage(perPagePtr)
 
-	page, err := validatePage(pagePtr, perPage, totalCount)
-	if err != nil {
-		return nil, err
-	}
+			// sort results (must be done before pagination)
+			if order.Desc {
+				sort.Slice(results, func(i, j int) bool {
+					if results[i].Height == results[j].Height {
+						return results[i].Index > results[j].Index
+					}
+					return results[i].Height > results[j].Height
+				})
+			} else {
+				sort.Slice(results, func(i, j int) bool {
+					if results[i].Height == results[j].Height {
+						return results[i].Index < results[j].Index
+					}
+					return results[i].Height < results[j].Height
+				})
+			}
+		}
+		if limit > 0 && len(results) > limit {
+			results = results[:limit]
+		}
 
-	skipCount := validateSkipCount(page, perPage)
-	pageSize := cmtmath.MinInt(perPage, totalCount-skipCount)
+		// paginate results
+		totalCount = len(results)
+		page, err := validatePage(pagePtr, perPage, totalCount)
+		if err != nil {
+			return nil, err
+		}
 
-	apiResults := make([]*ctypes.ResultTx, 0, pageSize)
-	for i := skipCount; i < skipCount+pageSize; i++ {
-		r := results[i]
+		skipCount := validateSkipCount(page, perPage)
+		pageSize := cmtmath.MinInt(perPage, totalCount-skipCount)
+		results = results[skipCount : skipCount+pageSize]
+	}
 
+	apiResults := make([]*ctypes.ResultTx, 0, len(results))
+	for _, r := range results {
 		var proof types.TxProof
 		if prove {
 			block := env.BlockStore.LoadBlock(r.Height)
@@ -86,6 +86,11 @@ type SyncInfo struct {
 	EarliestBlockHeight int64          `json:"earliest_block_height"`
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
+	// The lowest height whose transactions and block events can be searched:
+	// the retain height of the indexer if it was pruned, else the first
+	// indexed height, or 1. 0 if indexing is disabled.
+	EarliestIndexedHeight int64 `json:"earliest_indexed_height"`
+
 	CatchingUp bool `json:"catching_up"`
 }
 
@@ -101,6 +106,27 @@ type ResultStatus struct {
 	NodeInfo      p2p.DefaultNodeInfo `json:"node_info"`
 	SyncInfo      SyncInfo            `json:"sync_info"`
 	ValidatorInfo ValidatorInfo       `json:"validator_info"`
+
+	// Only set by the light client proxy.
+	LightClientInfo *LightClientInfo `json:"light_client_info,omitempty"`
+}
+
+// Info about the providers of a light client
+type LightClientInfo struct {
+	Providers []LightProviderInfo `json:"providers"`
+}
+
+// Health of a provider of a light client, as tracked from its responses
+type LightProviderInfo struct {
+	Address     string        `json:"address"`
+	Primary     bool          `json:"primary"`
+	Requests    int64         `json:"requests"`
+	Errors      int64         `json:"errors"`
+	Divergences int64         `json:"divergences"`
+	Latency     time.Duration `json:"latency"`
+	ErrorRate   float64       `json:"error_rate"`
+	Score       float64       `json:"score"`
+	LastError   string        `json:"last_error,omitempty"`
 }
 
 // Is TxIndexing enabled
@@ -129,6 +155,19 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// Log from banning peers
+type ResultBanPeer struct {
+	Entry p2p.BanEntry `json:"entry"`
+}
+
+// Log from lifting a ban
+type ResultUnbanPeer struct{}
+
+// Banned peers
+type ResultBanList struct {
+	Entries []p2p.BanEntry `json:"entries"`
+}
+
 // A peer
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
@@ -253,4 +292,16 @@ type ResultEvent struct {
 	Query  string              `json:"query"`
 	Data   types.TMEventData   `json:"data"`
 	Events map[string][]string `json:"events"`
+	// cursor of the event in the event log, only set for the events read
+	// from it
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// Events read from the event log
+type ResultEvents struct {
+	Items []*ResultEvent `json:"items"`
+	// cursor to read the next events after
+	Cursor string `json:"cursor"`
+	// whether there are more events after the cursor
+	More bool `json:"more"`
 }
@@ -14,24 +14,50 @@ type Config struct {
 	MaxOpenConnections int
 }
 
-// StartGRPCServer starts a new gRPC BroadcastAPIServer using the given
-// net.Listener.
+// StartGRPCServer starts a new gRPC server, serving the BroadcastAPI, QueryAPI,
+// EventAPI and LightBlockAPI, using the given net.Listener. The number of connections, and
+// so of clients streaming events, is limited by the listener.
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func StartGRPCServer(ln net.Listener) error {
 	grpcServer := grpc.NewServer()
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{})
+	RegisterQueryAPIServer(grpcServer, &queryAPI{})
+	RegisterEventAPIServer(grpcServer, &eventAPI{})
+	RegisterLightBlockAPIServer(grpcServer, &lightBlockAPI{})
 	return grpcServer.Serve(ln)
 }
 
 // StartGRPCClient dials the gRPC server using protoAddr and returns a new
 // BroadcastAPIClient.
 func StartGRPCClient(protoAddr string) BroadcastAPIClient {
+	return NewBroadcastAPIClient(dial(protoAddr))
+}
+
+// StartGRPCQueryClient dials the gRPC server using protoAddr and returns a new
+// QueryAPIClient.
+func StartGRPCQueryClient(protoAddr string) QueryAPIClient {
+	return NewQueryAPIClient(dial(protoAddr))
+}
+
+// StartGRPCEventClient dials the gRPC server using protoAddr and returns a new
+// EventAPIClient.
+func StartGRPCEventClient(protoAddr string) EventAPIClient {
+	return NewEventAPIClient(dial(protoAddr))
+}
+
+// StartGRPCLightBlockClient dials the gRPC server using protoAddr and returns
+// a new LightBlockAPIClient.
+func StartGRPCLightBlockClient(protoAddr string) LightBlockAPIClient {
+	return NewLightBlockAPIClient(dial(protoAddr))
+}
+
+func dial(protoAddr string) *grpc.ClientConn {
 	//nolint:staticcheck,nolintlint // SA1019 Existing use of deprecated but supported dial option.
 	conn, err := grpc.Dial(protoAddr, grpc.WithInsecure(), grpc.WithContextDialer(dialerFunc))
 	if err != nil {
 		panic(err)
 	}
-	return NewBroadcastAPIClient(conn)
+	return conn
 }
 
 func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
@@ -0,0 +1,130 @@
+package coregrpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	core "github.com/cometbft/cometbft/rpc/core"
+	"github.com/cometbft/cometbft/types"
+)
+
+// eventAPI streams events to gRPC clients. Subscriptions are made through
+// rpc/core, so they count against the same limits as the WebSocket ones.
+// The subscriber is the client's address suffixed with the ID of the stream,
+// since a client may open several streams with the same query. The streams
+// of a client are counted here, for max_subscriptions_per_client to apply to
+// the client rather than to each stream.
+type eventAPI struct {
+	// ID of the last stream subscribed (atomic)
+	lastStreamID uint64
+
+	mtx     sync.Mutex
+	streams map[string]int // number of streams by client address
+}
+
+func (eapi *eventAPI) SubscribeNewBlocks(req *RequestSubscribeNewBlocks, stream EventAPI_SubscribeNewBlocksServer) error {
+	return eapi.subscribe(stream.Context(), types.EventQueryNewBlock.String(), func(data types.TMEventData) error {
+		ev, ok := data.(types.EventDataNewBlock)
+		if !ok {
+			return status.Errorf(codes.Internal, "unexpected event data %T", data)
+		}
+		block, err := ev.Block.ToProto()
+		if err != nil {
+			return err
+		}
+		return stream.Send(&ResponseNewBlock{
+			Block:            block,
+			ResultBeginBlock: ev.ResultBeginBlock,
+			ResultEndBlock:   ev.ResultEndBlock,
+		})
+	})
+}
+
+func (eapi *eventAPI) SubscribeTxs(req *RequestSubscribeTxs, stream EventAPI_SubscribeTxsServer) error {
+	query := types.EventQueryTx.String()
+	if req.Query != "" {
+		// the query of the client may contain ORs, which bind looser than AND
+		query += " AND (" + req.Query + ")"
+	}
+	return eapi.subscribe(stream.Context(), query, func(data types.TMEventData) error {
+		ev, ok := data.(types.EventDataTx)
+		if !ok {
+			return status.Errorf(codes.Internal, "unexpected event data %T", data)
+		}
+		return stream.Send(&ResponseTxEvent{
+			Hash:     types.Tx(ev.Tx).Hash(),
+			TxResult: ev.TxResult,
+		})
+	})
+}
+
+// subscribe passes the events matching the query to send until the stream
+// is closed by the client, or the subscription is canceled.
+func (eapi *eventAPI) subscribe(ctx context.Context, query string, send func(types.TMEventData) error) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Internal, "unknown client address")
+	}
+	client := p.Addr.String()
+	if err := eapi.addStream(client); err != nil {
+		return err
+	}
+	defer eapi.removeStream(client)
+	subscriber := fmt.Sprintf("%s#%d", client, atomic.AddUint64(&eapi.lastStreamID, 1))
+
+	sub, err := core.SubscribeClient(ctx, subscriber, query)
+	if err != nil {
+		return coreError(err)
+	}
+	defer func() {
+		// the subscription may be gone already, if it was canceled
+		_ = core.UnsubscribeClient(subscriber, query)
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			if err := send(msg.Data()); err != nil {
+				return err
+			}
+		case <-sub.Cancelled():
+			reason := "CometBFT exited"
+			if sub.Err() != nil {
+				reason = sub.Err().Error()
+			}
+			return status.Errorf(codes.Aborted, "subscription was canceled (reason: %s)", reason)
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// addStream counts a new stream of the client, unless it has
+// max_subscriptions_per_client streams already.
+func (eapi *eventAPI) addStream(client string) error {
+	eapi.mtx.Lock()
+	defer eapi.mtx.Unlock()
+	if limit := core.MaxClientSubscriptions(); eapi.streams[client] >= limit {
+		return status.Errorf(codes.ResourceExhausted, "max_subscriptions_per_client %d reached", limit)
+	}
+	if eapi.streams == nil {
+		eapi.streams = make(map[string]int)
+	}
+	eapi.streams[client]++
+	return nil
+}
+
+// removeStream stops counting a stream of the client once it ends.
+func (eapi *eventAPI) removeStream(client string) {
+	eapi.mtx.Lock()
+	defer eapi.mtx.Unlock()
+	if eapi.streams[client]--; eapi.streams[client] <= 0 {
+		delete(eapi.streams, client)
+	}
+}
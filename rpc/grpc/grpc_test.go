@@ -2,14 +2,19 @@ package coregrpc_test
 
 import (
 	"context"
+	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cometbft/cometbft/abci/example/kvstore"
 	core_grpc "github.com/cometbft/cometbft/rpc/grpc"
 	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestMain(m *testing.M) {
@@ -33,3 +38,220 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.DeliverTx.Code)
 }
+
+func TestQueryAPI(t *testing.T) {
+	ctx := context.Background()
+	client := rpctest.GetGRPCQueryClient()
+
+	status, err := client.Status(ctx, &core_grpc.RequestStatus{})
+	require.NoError(t, err)
+	require.NotEmpty(t, status.NodeInfo.Network)
+	require.NotNil(t, status.ValidatorInfo.PubKey)
+
+	block, err := client.Block(ctx, &core_grpc.RequestBlock{Height: 1})
+	require.NoError(t, err)
+	require.NotNil(t, block.Block)
+	require.EqualValues(t, 1, block.Block.Header.Height)
+
+	byHash, err := client.BlockByHash(ctx, &core_grpc.RequestBlockByHash{Hash: block.BlockId.Hash})
+	require.NoError(t, err)
+	require.Equal(t, block.BlockId, byHash.BlockId)
+
+	commit, err := client.Commit(ctx, &core_grpc.RequestCommit{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, block.BlockId.Hash, commit.SignedHeader.Commit.BlockID.Hash)
+
+	vals, err := client.Validators(ctx, &core_grpc.RequestValidators{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, vals.Total)
+	require.Len(t, vals.Validators, 1)
+
+	params, err := client.ConsensusParams(ctx, &core_grpc.RequestConsensusParams{})
+	require.NoError(t, err)
+	require.Positive(t, params.ConsensusParams.Block.MaxBytes)
+
+	// a missing block is not an error
+	missing, err := client.BlockByHash(ctx, &core_grpc.RequestBlockByHash{Hash: []byte("missing")})
+	require.NoError(t, err)
+	require.Nil(t, missing.Block)
+}
+
+func TestQueryAPITx(t *testing.T) {
+	ctx := context.Background()
+	tx := []byte("grpc=query")
+	bres, err := rpctest.GetGRPCClient().BroadcastTx(ctx, &core_grpc.RequestBroadcastTx{Tx: tx})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, bres.DeliverTx.Code)
+
+	client := rpctest.GetGRPCQueryClient()
+	hash := types.Tx(tx).Hash()
+	res, err := client.Tx(ctx, &core_grpc.RequestTx{Hash: hash, Prove: true})
+	require.NoError(t, err)
+	require.Equal(t, tx, res.Tx)
+	require.NotNil(t, res.Proof)
+
+	results, err := client.BlockResults(ctx, &core_grpc.RequestBlockResults{Height: res.Height})
+	require.NoError(t, err)
+	require.Len(t, results.TxsResults, 1)
+
+	search, err := client.TxSearch(ctx, &core_grpc.RequestTxSearch{Query: "app.key = 'grpc'"})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, search.TotalCount)
+	require.Equal(t, hash, search.Txs[0].Hash)
+	require.Nil(t, search.Txs[0].Proof)
+}
+
+func TestQueryAPIErrors(t *testing.T) {
+	ctx := context.Background()
+	client := rpctest.GetGRPCQueryClient()
+
+	_, err := client.Block(ctx, &core_grpc.RequestBlock{Height: 1 << 40})
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	_, err = client.Tx(ctx, &core_grpc.RequestTx{Hash: types.Tx("grpc=missing").Hash()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.TxSearch(ctx, &core_grpc.RequestTxSearch{Query: "app.key ="})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	txs, err := rpctest.GetGRPCEventClient().SubscribeTxs(ctx, &core_grpc.RequestSubscribeTxs{Query: "app.key ="})
+	require.NoError(t, err)
+	_, err = txs.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestEventAPI(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := rpctest.GetGRPCEventClient()
+
+	blocks, err := client.SubscribeNewBlocks(ctx, &core_grpc.RequestSubscribeNewBlocks{})
+	require.NoError(t, err)
+	txs, err := client.SubscribeTxs(ctx, &core_grpc.RequestSubscribeTxs{Query: "app.key = 'grpc-event'"})
+	require.NoError(t, err)
+
+	first, err := blocks.Recv()
+	require.NoError(t, err)
+	second, err := blocks.Recv()
+	require.NoError(t, err)
+	require.Equal(t, first.Block.Header.Height+1, second.Block.Header.Height)
+
+	// not matching the query
+	_, err = rpctest.GetGRPCClient().BroadcastTx(ctx, &core_grpc.RequestBroadcastTx{Tx: []byte("other=event")})
+	require.NoError(t, err)
+	tx := []byte("grpc-event=1")
+	_, err = rpctest.GetGRPCClient().BroadcastTx(ctx, &core_grpc.RequestBroadcastTx{Tx: tx})
+	require.NoError(t, err)
+
+	ev, err := txs.Recv()
+	require.NoError(t, err)
+	require.Equal(t, types.Tx(tx).Hash(), ev.Hash)
+	require.Equal(t, tx, ev.TxResult.Tx)
+}
+
+func TestEventAPISubscribeTxsOr(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := rpctest.GetGRPCEventClient()
+
+	// the new blocks match the query, but aren't transactions
+	txs, err := client.SubscribeTxs(ctx, &core_grpc.RequestSubscribeTxs{
+		Query: "app.key = 'grpc-or' OR tm.event = 'NewBlock'",
+	})
+	require.NoError(t, err)
+
+	tx := []byte("grpc-or=1")
+	_, err = rpctest.GetGRPCClient().BroadcastTx(ctx, &core_grpc.RequestBroadcastTx{Tx: tx})
+	require.NoError(t, err)
+
+	ev, err := txs.Recv()
+	require.NoError(t, err)
+	require.Equal(t, types.Tx(tx).Hash(), ev.Hash)
+}
+
+func TestEventAPISameQueryStreams(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := rpctest.GetGRPCEventClient()
+
+	// both streams are on the same connection
+	req := &core_grpc.RequestSubscribeTxs{Query: "app.key = 'grpc-streams'"}
+	first, err := client.SubscribeTxs(ctx, req)
+	require.NoError(t, err)
+	second, err := client.SubscribeTxs(ctx, req)
+	require.NoError(t, err)
+
+	tx := []byte("grpc-streams=1")
+	_, err = rpctest.GetGRPCClient().BroadcastTx(ctx, &core_grpc.RequestBroadcastTx{Tx: tx})
+	require.NoError(t, err)
+
+	for _, stream := range []core_grpc.EventAPI_SubscribeTxsClient{first, second} {
+		ev, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, types.Tx(tx).Hash(), ev.Hash)
+	}
+}
+
+func TestEventAPIMaxSubscriptionsPerClient(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := rpctest.GetGRPCEventClient()
+
+	// the streams are subscribed once they receive a block
+	limit := rpctest.GetConfig().RPC.MaxSubscriptionsPerClient
+	for i := 0; i < limit; i++ {
+		blocks, err := client.SubscribeNewBlocks(ctx, &core_grpc.RequestSubscribeNewBlocks{})
+		require.NoError(t, err)
+		_, err = blocks.Recv()
+		require.NoError(t, err)
+	}
+
+	blocks, err := client.SubscribeNewBlocks(ctx, &core_grpc.RequestSubscribeNewBlocks{})
+	require.NoError(t, err)
+	_, err = blocks.Recv()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the limit is per client
+	blocks, err = rpctest.GetGRPCEventClient().SubscribeNewBlocks(ctx, &core_grpc.RequestSubscribeNewBlocks{})
+	require.NoError(t, err)
+	_, err = blocks.Recv()
+	require.NoError(t, err)
+}
+
+func TestLightBlockAPI(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := rpctest.GetGRPCLightBlockClient()
+
+	latest, err := client.LightBlock(ctx, &core_grpc.RequestLightBlock{})
+	require.NoError(t, err)
+	latestHeight := latest.LightBlock.SignedHeader.Header.Height
+	require.Greater(t, latestHeight, int64(1))
+
+	res, err := client.LightBlock(ctx, &core_grpc.RequestLightBlock{Height: 1})
+	require.NoError(t, err)
+	lb, err := types.LightBlockFromProto(res.LightBlock)
+	require.NoError(t, err)
+	require.NoError(t, lb.ValidateBasic(rpctest.GetConfig().ChainID()))
+	require.EqualValues(t, 1, lb.Height)
+
+	_, err = client.LightBlock(ctx, &core_grpc.RequestLightBlock{Height: latestHeight + 1000})
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	// the range is capped at the latest height
+	stream, err := client.LightBlocks(ctx, &core_grpc.RequestLightBlocks{FromHeight: 1, ToHeight: latestHeight + 1000})
+	require.NoError(t, err)
+	var heights []int64
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		heights = append(heights, res.LightBlock.SignedHeader.Header.Height)
+	}
+	require.GreaterOrEqual(t, int64(len(heights)), latestHeight)
+	for i, height := range heights {
+		require.EqualValues(t, i+1, height)
+	}
+}
@@ -0,0 +1,108 @@
+package coregrpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	core "github.com/cometbft/cometbft/rpc/core"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// maxStreamedLightBlocks is the maximum number of light blocks streamed for
+// a single LightBlocks request. Clients request the next ones afterwards.
+const maxStreamedLightBlocks = 1000
+
+// lightBlockAPI serves the light blocks, i.e. the signed headers and the
+// validator sets, to the light clients, and the evidence they report. The
+// errors carry the codes the light client providers rely on: NotFound for
+// the pruned heights and OutOfRange for those above the latest height.
+type lightBlockAPI struct {
+}
+
+func (lapi *lightBlockAPI) LightBlock(ctx context.Context, req *RequestLightBlock) (*ResponseLightBlock, error) {
+	lb, err := core.LightBlock(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, lightBlockError(err)
+	}
+	return responseLightBlock(lb)
+}
+
+func (lapi *lightBlockAPI) LightBlocks(req *RequestLightBlocks, stream LightBlockAPI_LightBlocksServer) error {
+	if req.FromHeight <= 0 {
+		return status.Errorf(codes.InvalidArgument, "from height must be greater than 0, but got %d", req.FromHeight)
+	}
+	if req.ToHeight != 0 && req.ToHeight < req.FromHeight {
+		return status.Errorf(codes.InvalidArgument, "to height %d is below from height %d", req.ToHeight, req.FromHeight)
+	}
+
+	toHeight := req.FromHeight + maxStreamedLightBlocks - 1
+	if req.ToHeight != 0 && req.ToHeight < toHeight {
+		toHeight = req.ToHeight
+	}
+
+	for height := req.FromHeight; height <= toHeight; height++ {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		h := height
+		lb, err := core.LightBlock(&rpctypes.Context{}, &h)
+		if errors.Is(err, core.ErrLightBlockTooHigh) && height > req.FromHeight {
+			// the range is capped at the latest height
+			return nil
+		}
+		if err != nil {
+			return lightBlockError(err)
+		}
+		res, err := responseLightBlock(lb)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (lapi *lightBlockAPI) BroadcastEvidence(
+	ctx context.Context,
+	req *RequestBroadcastEvidence,
+) (*ResponseBroadcastEvidence, error) {
+	if req.Evidence == nil {
+		return nil, status.Error(codes.InvalidArgument, "no evidence was provided")
+	}
+	ev, err := types.EvidenceFromProto(req.Evidence)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid evidence: %v", err)
+	}
+	res, err := core.BroadcastEvidence(&rpctypes.Context{}, ev)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &ResponseBroadcastEvidence{Hash: res.Hash}, nil
+}
+
+func responseLightBlock(lb *types.LightBlock) (*ResponseLightBlock, error) {
+	pb, err := lb.ToProto()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode light block: %v", err)
+	}
+	return &ResponseLightBlock{LightBlock: pb}, nil
+}
+
+// lightBlockError returns the gRPC status of the error of core.LightBlock.
+func lightBlockError(err error) error {
+	switch {
+	case errors.Is(err, core.ErrLightBlockTooHigh):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, core.ErrLightBlockNotAvailable):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+}
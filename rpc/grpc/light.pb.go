@@ -0,0 +1,1221 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/rpc/grpc/light.proto
+
+package coregrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RequestLightBlock struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RequestLightBlock) Reset()         { *m = RequestLightBlock{} }
+func (m *RequestLightBlock) String() string { return proto.CompactTextString(m) }
+func (*RequestLightBlock) ProtoMessage()    {}
+func (*RequestLightBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81c7003e986fee11, []int{0}
+}
+func (m *RequestLightBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestLightBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestLightBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestLightBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLightBlock.Merge(m, src)
+}
+func (m *RequestLightBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestLightBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLightBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLightBlock proto.InternalMessageInfo
+
+func (m *RequestLightBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type RequestLightBlocks struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *RequestLightBlocks) Reset()         { *m = RequestLightBlocks{} }
+func (m *RequestLightBlocks) String() string { return proto.CompactTextString(m) }
+func (*RequestLightBlocks) ProtoMessage()    {}
+func (*RequestLightBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81c7003e986fee11, []int{1}
+}
+func (m *RequestLightBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestLightBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestLightBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestLightBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLightBlocks.Merge(m, src)
+}
+func (m *RequestLightBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestLightBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLightBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLightBlocks proto.InternalMessageInfo
+
+func (m *RequestLightBlocks) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *RequestLightBlocks) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+type RequestBroadcastEvidence struct {
+	Evidence *types.Evidence `protobuf:"bytes,1,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *RequestBroadcastEvidence) Reset()         { *m = RequestBroadcastEvidence{} }
+func (m *RequestBroadcastEvidence) String() string { return proto.CompactTextString(m) }
+func (*RequestBroadcastEvidence) ProtoMessage()    {}
+func (*RequestBroadcastEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81c7003e986fee11, []int{2}
+}
+func (m *RequestBroadcastEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestBroadcastEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestBroadcastEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestBroadcastEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestBroadcastEvidence.Merge(m, src)
+}
+func (m *RequestBroadcastEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestBroadcastEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestBroadcastEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestBroadcastEvidence proto.InternalMessageInfo
+
+func (m *RequestBroadcastEvidence) GetEvidence() *types.Evidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+type ResponseLightBlock struct {
+	LightBlock *types.LightBlock `protobuf:"bytes,1,opt,name=light_block,json=lightBlock,proto3" json:"light_block,omitempty"`
+}
+
+func (m *ResponseLightBlock) Reset()         { *m = ResponseLightBlock{} }
+func (m *ResponseLightBlock) String() string { return proto.CompactTextString(m) }
+func (*ResponseLightBlock) ProtoMessage()    {}
+func (*ResponseLightBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81c7003e986fee11, []int{3}
+}
+func (m *ResponseLightBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseLightBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseLightBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseLightBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseLightBlock.Merge(m, src)
+}
+func (m *ResponseLightBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseLightBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseLightBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseLightBlock proto.InternalMessageInfo
+
+func (m *ResponseLightBlock) GetLightBlock() *types.LightBlock {
+	if m != nil {
+		return m.LightBlock
+	}
+	return nil
+}
+
+type ResponseBroadcastEvidence struct {
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *ResponseBroadcastEvidence) Reset()         { *m = ResponseBroadcastEvidence{} }
+func (m *ResponseBroadcastEvidence) String() string { return proto.CompactTextString(m) }
+func (*ResponseBroadcastEvidence) ProtoMessage()    {}
+func (*ResponseBroadcastEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81c7003e986fee11, []int{4}
+}
+func (m *ResponseBroadcastEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseBroadcastEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseBroadcastEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseBroadcastEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseBroadcastEvidence.Merge(m, src)
+}
+func (m *ResponseBroadcastEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseBroadcastEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseBroadcastEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseBroadcastEvidence proto.InternalMessageInfo
+
+func (m *ResponseBroadcastEvidence) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RequestLightBlock)(nil), "tendermint.rpc.grpc.RequestLightBlock")
+	proto.RegisterType((*RequestLightBlocks)(nil), "tendermint.rpc.grpc.RequestLightBlocks")
+	proto.RegisterType((*RequestBroadcastEvidence)(nil), "tendermint.rpc.grpc.RequestBroadcastEvidence")
+	proto.RegisterType((*ResponseLightBlock)(nil), "tendermint.rpc.grpc.ResponseLightBlock")
+	proto.RegisterType((*ResponseBroadcastEvidence)(nil), "tendermint.rpc.grpc.ResponseBroadcastEvidence")
+}
+
+func init() { proto.RegisterFile("tendermint/rpc/grpc/light.proto", fileDescriptor_81c7003e986fee11) }
+
+var fileDescriptor_81c7003e986fee11 = []byte{
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0x8e, 0xb3, 0x11, 0xb2, 0xe7, 0xed, 0x10, 0x0d, 0x46, 0xe6, 0x05, 0x67, 0xf8, 0xb0, 0x0c,
+	0xc6, 0xe4, 0x91, 0xc1, 0x2e, 0x63, 0x87, 0x05, 0x0a, 0x29, 0xf4, 0x50, 0xdc, 0x5b, 0xa1, 0x04,
+	0x5b, 0x51, 0x62, 0xd3, 0xd8, 0x72, 0x25, 0xa5, 0xd0, 0x7f, 0xd1, 0x3f, 0x55, 0xe8, 0x31, 0xc7,
+	0x1e, 0x4b, 0xf2, 0x47, 0x8a, 0x14, 0x3b, 0x76, 0x51, 0x1b, 0x72, 0x11, 0xef, 0xe9, 0x7d, 0xef,
+	0xfb, 0xde, 0xf7, 0x64, 0x43, 0x5f, 0xd2, 0x6c, 0x4a, 0x79, 0x9a, 0x64, 0xd2, 0xe7, 0x39, 0xf1,
+	0xe7, 0xea, 0x58, 0x24, 0xf3, 0x58, 0xe2, 0x9c, 0x33, 0xc9, 0xd0, 0xc7, 0x0a, 0x80, 0x79, 0x4e,
+	0xb0, 0x02, 0x38, 0xf5, 0x2e, 0x79, 0x93, 0x53, 0xe1, 0xd3, 0xeb, 0x64, 0x4a, 0x33, 0x42, 0xb7,
+	0x5d, 0x4e, 0xcf, 0x00, 0xe8, 0x73, 0x5b, 0xf5, 0x7e, 0x40, 0x27, 0xa0, 0x57, 0x4b, 0x2a, 0xe4,
+	0x89, 0x52, 0x1a, 0x2d, 0x18, 0xb9, 0x44, 0x9f, 0xa0, 0x15, 0x53, 0x95, 0x76, 0xad, 0xaf, 0xd6,
+	0xf7, 0x37, 0x41, 0x91, 0x79, 0x01, 0x20, 0x03, 0x2c, 0x50, 0x1f, 0xec, 0x19, 0x67, 0xe9, 0xe4,
+	0x59, 0x0b, 0xa8, 0xab, 0xb1, 0xbe, 0x41, 0x5f, 0xe0, 0x9d, 0x64, 0x65, 0xb9, 0xa9, 0xcb, 0x6d,
+	0xc9, 0xc6, 0x25, 0x67, 0xb7, 0xe0, 0x1c, 0x71, 0x16, 0x4e, 0x49, 0x28, 0xe4, 0x51, 0x61, 0x00,
+	0xfd, 0x81, 0x76, 0x69, 0x46, 0xd3, 0xda, 0x43, 0x07, 0xd7, 0x76, 0xb0, 0xf5, 0x51, 0xa2, 0x83,
+	0x1d, 0xd6, 0x3b, 0x53, 0x73, 0x8a, 0x9c, 0x65, 0x82, 0xd6, 0x5c, 0xfd, 0x03, 0x5b, 0x6f, 0x73,
+	0x12, 0xa9, 0xb4, 0x20, 0xec, 0x99, 0x84, 0x55, 0x4b, 0x00, 0x8b, 0x5d, 0xec, 0xf9, 0xf0, 0xb9,
+	0x24, 0x35, 0x27, 0x45, 0xf0, 0x36, 0x0e, 0x45, 0xac, 0x49, 0xdf, 0x07, 0x3a, 0x1e, 0xde, 0x35,
+	0xe1, 0x43, 0xc5, 0xf5, 0xff, 0xf4, 0x18, 0x5d, 0x00, 0xd4, 0xe6, 0xf9, 0x86, 0x5f, 0x78, 0x4f,
+	0x6c, 0x2c, 0xd8, 0x19, 0xbc, 0x82, 0x33, 0x0c, 0x86, 0x60, 0xd7, 0xdf, 0x65, 0x70, 0x18, 0xbf,
+	0x38, 0x58, 0xe0, 0x97, 0x85, 0x38, 0x74, 0x4c, 0xf3, 0x3f, 0xf7, 0x09, 0x19, 0x70, 0x07, 0xef,
+	0x95, 0x33, 0xf0, 0xa3, 0xf1, 0xfd, 0xda, 0xb5, 0x56, 0x6b, 0xd7, 0x7a, 0x5c, 0xbb, 0xd6, 0xed,
+	0xc6, 0x6d, 0xac, 0x36, 0x6e, 0xe3, 0x61, 0xe3, 0x36, 0xce, 0xf1, 0x3c, 0x91, 0xf1, 0x32, 0xc2,
+	0x84, 0xa5, 0x3e, 0x61, 0x29, 0x95, 0xd1, 0x4c, 0x56, 0x41, 0xf9, 0x0f, 0xfd, 0x25, 0x8c, 0x53,
+	0x15, 0x44, 0x2d, 0xfd, 0xcd, 0xff, 0x7e, 0x1a, 0x00, 0xa1, 0x29, 0x89, 0x62, 0x6a, 0x03, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LightBlockAPIClient is the client API for LightBlockAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LightBlockAPIClient interface {
+	LightBlock(ctx context.Context, in *RequestLightBlock, opts ...grpc.CallOption) (*ResponseLightBlock, error)
+	// LightBlocks streams the light blocks of a range of heights, in ascending
+	// order, e.g. to verify them sequentially.
+	LightBlocks(ctx context.Context, in *RequestLightBlocks, opts ...grpc.CallOption) (LightBlockAPI_LightBlocksClient, error)
+	BroadcastEvidence(ctx context.Context, in *RequestBroadcastEvidence, opts ...grpc.CallOption) (*ResponseBroadcastEvidence, error)
+}
+
+type lightBlockAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLightBlockAPIClient(cc *grpc.ClientConn) LightBlockAPIClient {
+	return &lightBlockAPIClient{cc}
+}
+
+func (c *lightBlockAPIClient) LightBlock(ctx context.Context, in *RequestLightBlock, opts ...grpc.CallOption) (*ResponseLightBlock, error) {
+	out := new(ResponseLightBlock)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.LightBlockAPI/LightBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightBlockAPIClient) LightBlocks(ctx context.Context, in *RequestLightBlocks, opts ...grpc.CallOption) (LightBlockAPI_LightBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LightBlockAPI_serviceDesc.Streams[0], "/tendermint.rpc.grpc.LightBlockAPI/LightBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightBlockAPILightBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LightBlockAPI_LightBlocksClient interface {
+	Recv() (*ResponseLightBlock, error)
+	grpc.ClientStream
+}
+
+type lightBlockAPILightBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightBlockAPILightBlocksClient) Recv() (*ResponseLightBlock, error) {
+	m := new(ResponseLightBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightBlockAPIClient) BroadcastEvidence(ctx context.Context, in *RequestBroadcastEvidence, opts ...grpc.CallOption) (*ResponseBroadcastEvidence, error) {
+	out := new(ResponseBroadcastEvidence)
+	err := c.cc.Invoke(ctx, "/tendermint.rpc.grpc.LightBlockAPI/BroadcastEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LightBlockAPIServer is the server API for LightBlockAPI service.
+type LightBlockAPIServer interface {
+	LightBlock(context.Context, *RequestLightBlock) (*ResponseLightBlock, error)
+	// LightBlocks streams the light blocks of a range of heights, in ascending
+	// order, e.g. to verify them sequentially.
+	LightBlocks(*RequestLightBlocks, LightBlockAPI_LightBlocksServer) error
+	BroadcastEvidence(context.Context, *RequestBroadcastEvidence) (*ResponseBroadcastEvidence, error)
+}
+
+// UnimplementedLightBlockAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedLightBlockAPIServer struct {
+}
+
+func (*UnimplementedLightBlockAPIServer) LightBlock(ctx context.Context, req *RequestLightBlock) (*ResponseLightBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LightBlock not implemented")
+}
+func (*UnimplementedLightBlockAPIServer) LightBlocks(req *RequestLightBlocks, srv LightBlockAPI_LightBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method LightBlocks not implemented")
+}
+func (*UnimplementedLightBlockAPIServer) BroadcastEvidence(ctx context.Context, req *RequestBroadcastEvidence) (*ResponseBroadcastEvidence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastEvidence not implemented")
+}
+
+func RegisterLightBlockAPIServer(s *grpc.Server, srv LightBlockAPIServer) {
+	s.RegisterService(&_LightBlockAPI_serviceDesc, srv)
+}
+
+func _LightBlockAPI_LightBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLightBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightBlockAPIServer).LightBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.LightBlockAPI/LightBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightBlockAPIServer).LightBlock(ctx, req.(*RequestLightBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LightBlockAPI_LightBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RequestLightBlocks)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightBlockAPIServer).LightBlocks(m, &lightBlockAPILightBlocksServer{stream})
+}
+
+type LightBlockAPI_LightBlocksServer interface {
+	Send(*ResponseLightBlock) error
+	grpc.ServerStream
+}
+
+type lightBlockAPILightBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightBlockAPILightBlocksServer) Send(m *ResponseLightBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LightBlockAPI_BroadcastEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestBroadcastEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightBlockAPIServer).BroadcastEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.rpc.grpc.LightBlockAPI/BroadcastEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightBlockAPIServer).BroadcastEvidence(ctx, req.(*RequestBroadcastEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LightBlockAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.rpc.grpc.LightBlockAPI",
+	HandlerType: (*LightBlockAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LightBlock",
+			Handler:    _LightBlockAPI_LightBlock_Handler,
+		},
+		{
+			MethodName: "BroadcastEvidence",
+			Handler:    _LightBlockAPI_BroadcastEvidence_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LightBlocks",
+			Handler:       _LightBlockAPI_LightBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tendermint/rpc/grpc/light.proto",
+}
+
+func (m *RequestLightBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestLightBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestLightBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintLight(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestLightBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestLightBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestLightBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintLight(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintLight(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestBroadcastEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestBroadcastEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestBroadcastEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLight(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseLightBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseLightBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseLightBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		{
+			size, err := m.LightBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLight(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseBroadcastEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseBroadcastEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseBroadcastEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintLight(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLight(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLight(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RequestLightBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovLight(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RequestLightBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovLight(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovLight(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *RequestBroadcastEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovLight(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseLightBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LightBlock != nil {
+		l = m.LightBlock.Size()
+		n += 1 + l + sovLight(uint64(l))
+	}
+	return n
+}
+
+func (m *ResponseBroadcastEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovLight(uint64(l))
+	}
+	return n
+}
+
+func sovLight(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLight(x uint64) (n int) {
+	return sovLight(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RequestLightBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLight
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestLightBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestLightBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLight(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLight
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestLightBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLight
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestLightBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestLightBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLight(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLight
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestBroadcastEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLight
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestBroadcastEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestBroadcastEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLight
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &types.Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLight(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLight
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseLightBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLight
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseLightBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseLightBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLight
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LightBlock == nil {
+				m.LightBlock = &types.LightBlock{}
+			}
+			if err := m.LightBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLight(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLight
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseBroadcastEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLight
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseBroadcastEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseBroadcastEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLight
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLight(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLight
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLight(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLight
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLight
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLight
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLight
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLight
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLight
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLight        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLight          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLight = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,241 @@
+package coregrpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	core "github.com/cometbft/cometbft/rpc/core"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+)
+
+// queryAPI serves the read-only JSON-RPC endpoints over gRPC. A height of 0
+// in a request stands for the latest height, like an omitted one does in
+// JSON-RPC. The errors carry the codes of lightBlockAPI: NotFound for the
+// pruned heights and OutOfRange for those above the latest height.
+type queryAPI struct {
+}
+
+func (qapi *queryAPI) Status(ctx context.Context, req *RequestStatus) (*ResponseStatus, error) {
+	res, err := core.Status(&rpctypes.Context{})
+	if err != nil {
+		return nil, coreError(err)
+	}
+
+	valInfo := ValidatorInfo{
+		Address:     res.ValidatorInfo.Address,
+		VotingPower: res.ValidatorInfo.VotingPower,
+	}
+	if res.ValidatorInfo.PubKey != nil {
+		pk, err := cryptoenc.PubKeyToProto(res.ValidatorInfo.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		valInfo.PubKey = &pk
+	}
+
+	return &ResponseStatus{
+		NodeInfo: *res.NodeInfo.ToProto(),
+		SyncInfo: SyncInfo{
+			LatestBlockHash:     res.SyncInfo.LatestBlockHash,
+			LatestAppHash:       res.SyncInfo.LatestAppHash,
+			LatestBlockHeight:   res.SyncInfo.LatestBlockHeight,
+			LatestBlockTime:     res.SyncInfo.LatestBlockTime,
+			EarliestBlockHash:   res.SyncInfo.EarliestBlockHash,
+			EarliestAppHash:     res.SyncInfo.EarliestAppHash,
+			EarliestBlockHeight: res.SyncInfo.EarliestBlockHeight,
+			EarliestBlockTime:   res.SyncInfo.EarliestBlockTime,
+			CatchingUp:          res.SyncInfo.CatchingUp,
+		},
+		ValidatorInfo: valInfo,
+	}, nil
+}
+
+func (qapi *queryAPI) Block(ctx context.Context, req *RequestBlock) (*ResponseBlock, error) {
+	res, err := core.Block(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, coreError(err)
+	}
+	return responseBlock(res)
+}
+
+func (qapi *queryAPI) BlockByHash(ctx context.Context, req *RequestBlockByHash) (*ResponseBlock, error) {
+	res, err := core.BlockByHash(&rpctypes.Context{}, req.Hash)
+	if err != nil {
+		return nil, coreError(err)
+	}
+	return responseBlock(res)
+}
+
+func (qapi *queryAPI) BlockResults(ctx context.Context, req *RequestBlockResults) (*ResponseBlockResults, error) {
+	res, err := core.BlockResults(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, coreError(err)
+	}
+	return &ResponseBlockResults{
+		Height:                res.Height,
+		TxsResults:            res.TxsResults,
+		BeginBlockEvents:      res.BeginBlockEvents,
+		EndBlockEvents:        res.EndBlockEvents,
+		ValidatorUpdates:      res.ValidatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+	}, nil
+}
+
+func (qapi *queryAPI) Commit(ctx context.Context, req *RequestCommit) (*ResponseCommit, error) {
+	res, err := core.Commit(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, coreError(err)
+	}
+	if res == nil {
+		// not found, like for blocks
+		return &ResponseCommit{}, nil
+	}
+	return &ResponseCommit{
+		SignedHeader: &cmtproto.SignedHeader{
+			Header: res.Header.ToProto(),
+			Commit: res.Commit.ToProto(),
+		},
+		Canonical: res.CanonicalCommit,
+	}, nil
+}
+
+func (qapi *queryAPI) Validators(ctx context.Context, req *RequestValidators) (*ResponseValidators, error) {
+	res, err := core.Validators(&rpctypes.Context{}, heightPtr(req.Height), intPtr(req.Page), intPtr(req.PerPage))
+	if err != nil {
+		return nil, coreError(err)
+	}
+	vals := make([]*cmtproto.Validator, len(res.Validators))
+	for i, val := range res.Validators {
+		vals[i], err = val.ToProto()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ResponseValidators{
+		BlockHeight: res.BlockHeight,
+		Validators:  vals,
+		Count:       int32(res.Count),
+		Total:       int32(res.Total),
+	}, nil
+}
+
+func (qapi *queryAPI) ConsensusParams(
+	ctx context.Context,
+	req *RequestConsensusParams,
+) (*ResponseConsensusParams, error) {
+	res, err := core.ConsensusParams(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, coreError(err)
+	}
+	return &ResponseConsensusParams{
+		BlockHeight:     res.BlockHeight,
+		ConsensusParams: res.ConsensusParams.ToProto(),
+	}, nil
+}
+
+func (qapi *queryAPI) Tx(ctx context.Context, req *RequestTx) (*ResponseTx, error) {
+	res, err := core.Tx(&rpctypes.Context{}, req.Hash, req.Prove)
+	if err != nil {
+		return nil, coreError(err)
+	}
+	return responseTx(res, req.Prove), nil
+}
+
+func (qapi *queryAPI) TxSearch(ctx context.Context, req *RequestTxSearch) (*ResponseTxSearch, error) {
+	res, err := core.TxSearch(
+		&rpctypes.Context{},
+		req.Query,
+		req.Prove,
+		intPtr(req.Page),
+		intPtr(req.PerPage),
+		req.OrderBy,
+		nil,
+	)
+	if err != nil {
+		return nil, coreError(err)
+	}
+	txs := make([]*ResponseTx, len(res.Txs))
+	for i, tx := range res.Txs {
+		txs[i] = responseTx(tx, req.Prove)
+	}
+	return &ResponseTxSearch{
+		Txs:        txs,
+		TotalCount: int32(res.TotalCount),
+	}, nil
+}
+
+// responseBlock converts the result, leaving the block nil when it was not
+// found, like JSON-RPC does.
+func responseBlock(res *ctypes.ResultBlock) (*ResponseBlock, error) {
+	if res.Block == nil {
+		return &ResponseBlock{BlockId: res.BlockID.ToProto()}, nil
+	}
+	block, err := res.Block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseBlock{
+		BlockId: res.BlockID.ToProto(),
+		Block:   block,
+	}, nil
+}
+
+func responseTx(res *ctypes.ResultTx, prove bool) *ResponseTx {
+	tx := &ResponseTx{
+		Hash:     res.Hash,
+		Height:   res.Height,
+		Index:    res.Index,
+		TxResult: res.TxResult,
+		Tx:       res.Tx,
+	}
+	if prove {
+		proof := res.Proof.ToProto()
+		tx.Proof = &proof
+	}
+	return tx
+}
+
+// coreError returns the gRPC status of an error of rpc/core, or err itself if
+// it has no matching code.
+func coreError(err error) error {
+	var (
+		tooHigh      core.ErrHeightTooHigh
+		notAvailable core.ErrHeightNotAvailable
+		noResponses  sm.ErrNoABCIResponsesForHeight
+	)
+	switch {
+	case errors.As(err, &tooHigh):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.As(err, &notAvailable), errors.As(err, &noResponses), errors.Is(err, core.ErrTxNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, core.ErrInvalidQuery):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+// heightPtr returns nil for a height of 0, so that rpc/core uses the latest
+// height.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
+
+// intPtr returns nil for 0, so that rpc/core uses the default value.
+func intPtr(i int32) *int {
+	if i == 0 {
+		return nil
+	}
+	v := int(i)
+	return &v
+}
@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// Scopes of the RPC functions. An API key can only call the functions in the
+// scopes it was granted.
+const (
+	ScopeRead      = "read"
+	ScopeBroadcast = "broadcast"
+	ScopeUnsafe    = "unsafe"
+)
+
+// WithScope sets the scope an API key needs to call the function. Functions
+// are in ScopeRead by default.
+func WithScope(scope string) Option {
+	return func(r *RPCFunc) {
+		r.scope = scope
+	}
+}
+
+var (
+	errUnauthorized = errors.New("missing or invalid API key")
+	errRateLimited  = errors.New("rate limit exceeded")
+)
+
+// APIKey is a credential allowing a client to call the RPC functions.
+type APIKey struct {
+	// Key is sent by the client, as a bearer token or as the password of
+	// basic auth.
+	Key string
+	// Scopes the key is granted.
+	Scopes []string
+	// RateLimit is the number of calls per second the key can make, with
+	// bursts of up to RateBurst calls. 0 means unlimited.
+	RateLimit float64
+	RateBurst int
+	// MaxBatchSize is the maximum number of requests in a JSON-RPC batch.
+	// 0 means unlimited.
+	MaxBatchSize int
+}
+
+// Authenticator identifies the clients by their API key and checks the calls
+// they make against the scopes and limits of the key.
+type Authenticator struct {
+	// keys by the hash of their value, so that looking them up does not
+	// leak their value through timing
+	keys map[[sha256.Size]byte]*apiKey
+}
+
+type apiKey struct {
+	scopes       map[string]bool
+	maxBatchSize int
+	limiter      *rateLimiter // nil if unlimited
+}
+
+type apiKeyContextKey struct{}
+
+// NewAuthenticator returns an Authenticator accepting the given keys.
+func NewAuthenticator(keys []APIKey) (*Authenticator, error) {
+	a := &Authenticator{keys: make(map[[sha256.Size]byte]*apiKey, len(keys))}
+	for i, k := range keys {
+		if k.Key == "" {
+			return nil, fmt.Errorf("API key #%d is empty", i)
+		}
+		hash := sha256.Sum256([]byte(k.Key))
+		if _, ok := a.keys[hash]; ok {
+			return nil, fmt.Errorf("API key #%d is a duplicate", i)
+		}
+		if k.RateLimit < 0 || k.RateBurst < 0 || k.MaxBatchSize < 0 {
+			return nil, fmt.Errorf("API key #%d has a negative limit", i)
+		}
+
+		key := &apiKey{
+			scopes:       make(map[string]bool, len(k.Scopes)),
+			maxBatchSize: k.MaxBatchSize,
+		}
+		for _, scope := range k.Scopes {
+			key.scopes[scope] = true
+		}
+		if k.RateLimit > 0 {
+			key.limiter = newRateLimiter(k.RateLimit, k.RateBurst)
+		}
+		a.keys[hash] = key
+	}
+	return a, nil
+}
+
+// Handler wraps handler, rejecting the requests without a valid API key with
+// a 401. The key of the request is passed to the RPC handlers through the
+// request's context, so that they can check each call.
+func (a *Authenticator) Handler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, ok := a.keys[sha256.Sum256([]byte(requestAPIKey(r)))]
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="cometbft"`)
+			res := types.RPCInvalidRequestError(nil, errUnauthorized)
+			_ = WriteRPCResponseHTTPError(w, http.StatusUnauthorized, res)
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, key)))
+	})
+}
+
+// RequireScope wraps handler, which is not an RPCFunc, e.g. an event stream,
+// rejecting the requests whose API key is not granted scope, or is rate
+// limited. It needs Authenticator.Handler to come first, and lets all the
+// requests through if authentication is disabled.
+func RequireScope(scope string, handler http.Handler) http.Handler {
+	rpcFunc := &RPCFunc{scope: scope}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := apiKeyFromContext(r.Context()).authorize(rpcFunc); err != nil {
+			code := http.StatusForbidden
+			if err == errRateLimited {
+				code = http.StatusTooManyRequests
+			}
+			_ = WriteRPCResponseHTTPError(w, code, types.RPCInvalidRequestError(nil, err))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// requestAPIKey returns the API key sent with the request, as a bearer token
+// or as the password of basic auth, which the JSON-RPC clients send when the
+// remote address contains one.
+func requestAPIKey(r *http.Request) string {
+	if _, password, ok := r.BasicAuth(); ok {
+		return password
+	}
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) > len(prefix) && strings.EqualFold(auth[:len(prefix)], prefix) {
+		return auth[len(prefix):]
+	}
+	return ""
+}
+
+// apiKeyFromContext returns the key of the request, nil if authentication is
+// disabled.
+func apiKeyFromContext(ctx context.Context) *apiKey {
+	key, _ := ctx.Value(apiKeyContextKey{}).(*apiKey)
+	return key
+}
+
+// authorize checks whether the key can call the function now. A nil key
+// can call everything.
+func (k *apiKey) authorize(rpcFunc *RPCFunc) error {
+	if k == nil {
+		return nil
+	}
+	scope := rpcFunc.scope
+	if scope == "" {
+		scope = ScopeRead
+	}
+	if !k.scopes[scope] {
+		return fmt.Errorf("API key is not granted the %q scope", scope)
+	}
+	if k.limiter != nil && !k.limiter.allow(time.Now()) {
+		return errRateLimited
+	}
+	return nil
+}
+
+// checkBatchSize checks the number of requests in a batch against the limit
+// of the key.
+func (k *apiKey) checkBatchSize(n int) error {
+	if k == nil || k.maxBatchSize == 0 || n <= k.maxBatchSize {
+		return nil
+	}
+	return fmt.Errorf("batch of %d requests exceeds the limit of %d", n, k.maxBatchSize)
+}
+
+// rateLimiter is a token bucket, filled at rate tokens per second up to
+// burst tokens.
+type rateLimiter struct {
+	mtx    cmtsync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// allow takes a token from the bucket if there is one.
+func (l *rateLimiter) allow(now time.Time) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
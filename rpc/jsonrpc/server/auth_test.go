@@ -0,0 +1,164 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+func testAuthHandler(t *testing.T) http.Handler {
+	funcMap := map[string]*RPCFunc{
+		"read":      NewRPCFunc(func(ctx *types.Context) (string, error) { return "read", nil }, ""),
+		"broadcast": NewRPCFunc(func(ctx *types.Context) (string, error) { return "sent", nil }, "", WithScope(ScopeBroadcast)),
+		"subscribe": NewWSRPCFunc(func(ctx *types.Context) (string, error) { return "subscribed", nil }, ""),
+		"unsafe":    NewWSRPCFunc(func(ctx *types.Context) (string, error) { return "unsafe", nil }, "", WithScope(ScopeUnsafe)),
+	}
+	mux := http.NewServeMux()
+	RegisterRPCFuncs(mux, funcMap, log.TestingLogger())
+	wm := NewWebsocketManager(funcMap)
+	wm.SetLogger(log.TestingLogger())
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	mux.Handle("/stream", RequireScope(ScopeUnsafe, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})))
+
+	auth, err := NewAuthenticator([]APIKey{
+		{Key: "reader", Scopes: []string{ScopeRead}},
+		{Key: "admin", Scopes: []string{ScopeRead, ScopeBroadcast, ScopeUnsafe}, MaxBatchSize: 2},
+		{Key: "limited", Scopes: []string{ScopeRead}, RateLimit: 0.001, RateBurst: 2},
+	})
+	require.NoError(t, err)
+	return auth.Handler(mux)
+}
+
+func authRequest(method, path, body, key string) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+	return req
+}
+
+func TestAuthURI(t *testing.T) {
+	handler := testAuthHandler(t)
+
+	tests := []struct {
+		path string
+		key  string
+		code int
+	}{
+		{"/read", "", http.StatusUnauthorized},
+		{"/read", "unknown", http.StatusUnauthorized},
+		{"/read", "reader", http.StatusOK},
+		{"/broadcast", "reader", http.StatusForbidden},
+		{"/broadcast", "admin", http.StatusOK},
+		{"/stream", "", http.StatusUnauthorized},
+		{"/stream", "reader", http.StatusForbidden},
+		{"/stream", "admin", http.StatusOK},
+	}
+	for i, tt := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, authRequest(http.MethodGet, tt.path, "", tt.key))
+		assert.Equal(t, tt.code, rec.Code, "#%d", i)
+	}
+
+	// basic auth, which the JSON-RPC clients use
+	req := authRequest(http.MethodGet, "/read", "", "")
+	req.SetBasicAuth("", "reader")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAuthRateLimit(t *testing.T) {
+	handler := testAuthHandler(t)
+
+	codes := make([]int, 3)
+	for i := range codes {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, authRequest(http.MethodGet, "/read", "", "limited"))
+		codes[i] = rec.Code
+	}
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, codes)
+
+	// other keys are not limited
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, authRequest(http.MethodGet, "/read", "", "reader"))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAuthJSONRPC(t *testing.T) {
+	handler := testAuthHandler(t)
+
+	call := func(body, key string) (int, []types.RPCResponse) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, authRequest(http.MethodPost, "/", body, key))
+		res := rec.Result()
+		defer res.Body.Close()
+		blob, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		var responses []types.RPCResponse
+		if err := json.Unmarshal(blob, &responses); err != nil {
+			var response types.RPCResponse
+			require.NoError(t, json.Unmarshal(blob, &response))
+			responses = []types.RPCResponse{response}
+		}
+		return res.StatusCode, responses
+	}
+
+	code, responses := call(`[{"jsonrpc":"2.0","id":1,"method":"read"},{"jsonrpc":"2.0","id":2,"method":"broadcast"}]`, "reader")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, responses, 2)
+	assert.Nil(t, responses[0].Error)
+	require.NotNil(t, responses[1].Error)
+	assert.Contains(t, responses[1].Error.Data, `"broadcast" scope`)
+
+	code, responses = call(`[{"jsonrpc":"2.0","id":1,"method":"read"},{"jsonrpc":"2.0","id":2,"method":"broadcast"}]`, "admin")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, responses, 2)
+	assert.Nil(t, responses[0].Error)
+	assert.Nil(t, responses[1].Error)
+
+	code, responses = call(
+		`[{"jsonrpc":"2.0","id":1,"method":"read"},{"jsonrpc":"2.0","id":2,"method":"read"},{"jsonrpc":"2.0","id":3,"method":"read"}]`,
+		"admin",
+	)
+	assert.Equal(t, http.StatusBadRequest, code)
+	require.Len(t, responses, 1)
+	require.NotNil(t, responses[0].Error)
+	assert.Contains(t, responses[0].Error.Data, "exceeds the limit of 2")
+}
+
+func TestAuthWebsocket(t *testing.T) {
+	s := httptest.NewServer(testAuthHandler(t))
+	defer s.Close()
+	url := "ws://" + s.Listener.Addr().String() + "/websocket"
+
+	d := websocket.Dialer{HandshakeTimeout: 5 * time.Second}
+	_, dialResp, err := d.Dial(url, nil)
+	require.Error(t, err)
+	require.NotNil(t, dialResp)
+	assert.Equal(t, http.StatusUnauthorized, dialResp.StatusCode)
+	dialResp.Body.Close()
+
+	c, dialResp, err := d.Dial(url, http.Header{"Authorization": []string{"Bearer reader"}})
+	require.NoError(t, err)
+	defer c.Close()
+	dialResp.Body.Close()
+
+	for method, allowed := range map[string]bool{"subscribe": true, "unsafe": false} {
+		require.NoError(t, c.WriteJSON(types.RPCRequest{JSONRPC: "2.0", ID: types.JSONRPCStringID(method), Method: method}))
+		var resp types.RPCResponse
+		require.NoError(t, c.ReadJSON(&resp))
+		assert.Equal(t, allowed, resp.Error == nil, method)
+	}
+}
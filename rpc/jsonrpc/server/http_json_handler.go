@@ -55,6 +55,15 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 			requests = []types.RPCRequest{request}
 		}
 
+		key := apiKeyFromContext(r.Context())
+		if err := key.checkBatchSize(len(requests)); err != nil {
+			res := types.RPCInvalidRequestError(nil, err)
+			if wErr := WriteRPCResponseHTTPError(w, http.StatusBadRequest, res); wErr != nil {
+				logger.Error("failed to write response", "res", res, "err", wErr)
+			}
+			return
+		}
+
 		// Set the default response cache to true unless
 		// 1. Any RPC request error.
 		// 2. Any RPC request doesn't allow to be cached.
@@ -86,6 +95,11 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				cache = false
 				continue
 			}
+			if err := key.authorize(rpcFunc); err != nil {
+				responses = append(responses, types.RPCInvalidRequestError(request.ID, err))
+				cache = false
+				continue
+			}
 			ctx := &types.Context{JSONReq: &request, HTTPReq: r}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
@@ -246,6 +246,12 @@ func (w *responseWriterWrapper) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return w.ResponseWriter.(http.Hijacker).Hijack()
 }
 
+// Unwrap returns the wrapped http.ResponseWriter, so that handlers can flush
+// it, e.g. to stream events, with http.ResponseController.
+func (w *responseWriterWrapper) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 type maxBytesHandler struct {
 	h http.Handler
 	n int64
@@ -36,6 +36,18 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger.Debug("HTTP HANDLER", "req", r)
 
+		if err := apiKeyFromContext(r.Context()).authorize(rpcFunc); err != nil {
+			code := http.StatusForbidden
+			if err == errRateLimited {
+				code = http.StatusTooManyRequests
+			}
+			res := types.RPCInvalidRequestError(dummyID, err)
+			if wErr := WriteRPCResponseHTTPError(w, code, res); wErr != nil {
+				logger.Error("failed to write response", "res", res, "err", wErr)
+			}
+			return
+		}
+
 		ctx := &types.Context{HTTPReq: r}
 		args := []reflect.Value{reflect.ValueOf(ctx)}
 
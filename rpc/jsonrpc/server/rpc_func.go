@@ -56,6 +56,7 @@ type RPCFunc struct {
 	argNames       []string               // name of each argument
 	cacheable      bool                   // enable cache control
 	ws             bool                   // enable websocket communication
+	scope          string                 // scope API keys need to call the function
 	noCacheDefArgs map[string]interface{} // a lookup table of args that, if not supplied or are set to default values, cause us to not cache
 }
 
@@ -86,6 +86,7 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 
 	// register connection
 	con := newWSConnection(wsConn, wm.funcMap, wm.wsConnOptions...)
+	con.apiKey = apiKeyFromContext(r.Context())
 	con.SetLogger(wm.logger.With("remote", wsConn.RemoteAddr()))
 	wm.logger.Info("New websocket connection", "remote", con.remoteAddr)
 	err = con.Start() // BLOCKING
@@ -136,6 +137,10 @@ type wsConnection struct {
 	// callback which is called upon disconnect
 	onDisconnect func(remoteAddr string)
 
+	// API key the connection was opened with, nil if authentication is
+	// disabled
+	apiKey *apiKey
+
 	ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -363,6 +368,13 @@ func (wsc *wsConnection) readRoutine() {
 				continue
 			}
 
+			if err := wsc.apiKey.authorize(rpcFunc); err != nil {
+				if err := wsc.WriteRPCResponse(writeCtx, types.RPCInvalidRequestError(request.ID, err)); err != nil {
+					wsc.Logger.Error("Error writing RPC response", "err", err)
+				}
+				continue
+			}
+
 			ctx := &types.Context{JSONReq: &request, WSConn: wsc}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
@@ -22,3 +22,44 @@ type BlockIndexer interface {
 	// and Endblock event search criteria.
 	Search(ctx context.Context, q *query.Query) ([]int64, error)
 }
+
+// Page selects a page of the results of a search, ordered by height (and
+// index, for transactions).
+type Page struct {
+	Desc   bool // order by descending height
+	Offset int
+	Limit  int
+}
+
+// BlockPageSearcher is implemented by the BlockIndexers which can order and
+// paginate the results of a search themselves, e.g. in a database, rather
+// than returning all of them.
+type BlockPageSearcher interface {
+	// SearchPage returns the page of the heights matching the query, and the
+	// total number of matching heights.
+	SearchPage(ctx context.Context, q *query.Query, page Page) ([]int64, int, error)
+}
+
+// FirstHeighter is implemented by the indexers which can tell the lowest
+// height they indexed, e.g. the height a node was state synced to.
+type FirstHeighter interface {
+	// FirstHeight returns the lowest indexed height, or 0 if nothing was
+	// indexed.
+	FirstHeight() (int64, error)
+}
+
+// Order orders the results of a search by the values of an attribute with a
+// range index (see RangeIndexes), rather than by height.
+type Order struct {
+	Key  string // composite key of the attribute, e.g. "transfer.amount"
+	Desc bool   // order by descending value
+}
+
+// BlockOrderSearcher is implemented by the BlockIndexers which can order the
+// results of a search by the values of an attribute.
+type BlockOrderSearcher interface {
+	// SearchOrder returns the heights matching the query and having the
+	// attribute, ordered by its values, up to limit heights if it is
+	// positive.
+	SearchOrder(ctx context.Context, q *query.Query, order Order, limit int) ([]int64, error)
+}
@@ -19,7 +19,10 @@ import (
 	"github.com/cometbft/cometbft/types"
 )
 
-var _ indexer.BlockIndexer = (*BlockerIndexer)(nil)
+var (
+	_ indexer.BlockIndexer  = (*BlockerIndexer)(nil)
+	_ indexer.FirstHeighter = (*BlockerIndexer)(nil)
+)
 
 // BlockerIndexer implements a block indexer, indexing BeginBlock and EndBlock
 // events with an underlying KV store. Block events are indexed by their height,
@@ -30,12 +33,19 @@ type BlockerIndexer struct {
 	// Add unique event identifier to use when querying
 	// Matching will be done both on height AND eventSeq
 	eventSeq int64
+
+	// The attributes indexed in the order of their values
+	rangeIndexes indexer.RangeIndexes
 }
 
-func New(store dbm.DB) *BlockerIndexer {
-	return &BlockerIndexer{
+func New(store dbm.DB, options ...Option) *BlockerIndexer {
+	idx := &BlockerIndexer{
 		store: store,
 	}
+	for _, option := range options {
+		option(idx)
+	}
+	return idx
 }
 
 // Has returns true if the given height has been indexed. An error is returned
@@ -49,12 +59,41 @@ func (idx *BlockerIndexer) Has(height int64) (bool, error) {
 	return idx.store.Has(key)
 }
 
+// FirstHeight returns the lowest indexed height, or 0 if nothing was indexed,
+// as part of indexer.FirstHeighter.
+func (idx *BlockerIndexer) FirstHeight() (int64, error) {
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create prefix iterator: %w", err)
+	}
+	defer it.Close()
+
+	// the primary keys are ordered by height
+	if !it.Valid() {
+		return 0, it.Error()
+	}
+	var (
+		compositeKey string
+		height       int64
+	)
+	if _, err := orderedcode.Parse(string(it.Key()), &compositeKey, &height); err != nil {
+		return 0, fmt.Errorf("failed to parse primary key: %w", err)
+	}
+	return height, nil
+}
+
 // Index indexes BeginBlock and EndBlock events for a given block by its height.
 // The following is indexed:
 //
 // primary key: encode(block.height | height) => encode(height)
 // BeginBlock events: encode(eventType.eventAttr|eventValue|height|begin_block) => encode(height)
 // EndBlock events: encode(eventType.eventAttr|eventValue|height|end_block) => encode(height)
+// range indexes: encode(range|eventType.eventAttr|encode(eventValue)|height|begin_block/end_block|eventSeq) => encode(height)
 func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 	batch := idx.store.NewBatch()
 	defer batch.Close()
@@ -88,6 +127,16 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+//
+// The range conditions on the attributes with a range index (see
+// WithRangeIndexes) only scan the values within the range.
+//
+// The conditions joined by AND are matched within the same event. The results
+// of OR and NOT, and the intersection of those with other results, are sets
+// of blocks (see searchExpr).
+//
+// If the index was pruned, the searches for heights below its retain height
+// fail with indexer.ErrPruned (see indexer.CheckRetainHeight).
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -97,10 +146,172 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions, err := q.Conditions()
+	retainHeight, err := idx.RetainHeight()
+	if err != nil {
+		return nil, err
+	}
+	if err := indexer.CheckRetainHeight(q, types.BlockHeightKey, retainHeight); err != nil {
+		return nil, err
+	}
+
+	heights, err := idx.searchExpr(ctx, q.Expr())
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse query conditions: %w", err)
+		return nil, err
+	}
+
+	for h := range heights {
+		results = append(results, h)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// searchExpr returns the heights of the blocks matching e. Each conjunction
+// of conditions is searched at once (see searchConditions), and their results
+// are combined as sets of blocks: a NOT is the set of the blocks not matching
+// its argument.
+func (idx *BlockerIndexer) searchExpr(ctx context.Context, e *query.Expr) (map[int64]struct{}, error) {
+	if conditions, ok := e.Conjunction(); ok {
+		return idx.searchConditions(ctx, conditions)
 	}
+
+	switch e.Op {
+	case query.ExprOr:
+		union := make(map[int64]struct{})
+		for _, arg := range e.Args {
+			heights, err := idx.searchExpr(ctx, arg)
+			if err != nil {
+				return nil, err
+			}
+			for h := range heights {
+				union[h] = struct{}{}
+			}
+		}
+		return union, nil
+
+	case query.ExprAnd:
+		// The conditions are searched together, to match within the same
+		// event, and the negations are subtracted from the result rather than
+		// intersected with it.
+		var (
+			conditions []query.Condition
+			others     []*query.Expr
+			negations  []*query.Expr
+		)
+		for _, arg := range e.Args {
+			switch arg.Op {
+			case query.ExprCondition:
+				conditions = append(conditions, arg.Condition)
+			case query.ExprNot:
+				negations = append(negations, arg.Args[0])
+			default:
+				others = append(others, arg)
+			}
+		}
+
+		var result map[int64]struct{}
+		if len(conditions) > 0 {
+			heights, err := idx.searchConditions(ctx, conditions)
+			if err != nil {
+				return nil, err
+			}
+			result = heights
+		}
+		for _, arg := range others {
+			if result != nil && len(result) == 0 {
+				return result, nil
+			}
+			heights, err := idx.searchExpr(ctx, arg)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				result = heights
+				continue
+			}
+			for h := range result {
+				if _, ok := heights[h]; !ok {
+					delete(result, h)
+				}
+			}
+		}
+		if result == nil {
+			var err error
+			if result, err = idx.allHeights(ctx); err != nil {
+				return nil, err
+			}
+		}
+		for _, arg := range negations {
+			if len(result) == 0 {
+				break
+			}
+			heights, err := idx.searchExpr(ctx, arg)
+			if err != nil {
+				return nil, err
+			}
+			for h := range heights {
+				delete(result, h)
+			}
+		}
+		return result, nil
+
+	case query.ExprNot:
+		heights, err := idx.searchExpr(ctx, e.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		result, err := idx.allHeights(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for h := range heights {
+			delete(result, h)
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected query expression %v", e.Op)
+	}
+}
+
+// allHeights returns the heights of all the indexed blocks.
+func (idx *BlockerIndexer) allHeights(ctx context.Context) (map[int64]struct{}, error) {
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix iterator: %w", err)
+	}
+	defer it.Close()
+
+	heights := make(map[int64]struct{})
+LOOP:
+	for ; it.Valid(); it.Next() {
+		heights[int64FromBytes(it.Value())] = struct{}{}
+
+		select {
+		case <-ctx.Done():
+			break LOOP
+
+		default:
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	return heights, nil
+}
+
+// searchConditions returns the heights of the blocks matching all the
+// conditions, within the same event.
+func (idx *BlockerIndexer) searchConditions(ctx context.Context, conditions []query.Condition) (map[int64]struct{}, error) {
+	results := make(map[int64]struct{})
+
 	// conditions to skip because they're handled before "everything else"
 	skipIndexes := make([]int, 0)
 
@@ -129,7 +340,7 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 
 		if ok {
-			return []int64{heightInfo.height}, nil
+			results[heightInfo.height] = struct{}{}
 		}
 
 		return results, nil
@@ -158,30 +369,31 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 				continue
 
 			}
-			prefix, err := orderedcode.Append(nil, qr.Key)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create prefix key: %w", err)
-			}
-
-			if !heightsInitialized {
-				filteredHeights, err = idx.matchRange(ctx, qr, prefix, filteredHeights, true, heightInfo)
+			firstRun := !heightsInitialized
+			if typ, ok := idx.rangeIndexes[qr.Key]; ok {
+				var err error
+				filteredHeights, err = idx.matchRangeIndex(ctx, typ, qr, filteredHeights, firstRun, heightInfo)
 				if err != nil {
 					return nil, err
 				}
-
-				heightsInitialized = true
-
-				// Ignore any remaining conditions if the first condition resulted in no
-				// matches (assuming implicit AND operand).
-				if len(filteredHeights) == 0 {
-					break
-				}
 			} else {
-				filteredHeights, err = idx.matchRange(ctx, qr, prefix, filteredHeights, false, heightInfo)
+				prefix, err := orderedcode.Append(nil, qr.Key)
+				if err != nil {
+					return nil, fmt.Errorf("failed to create prefix key: %w", err)
+				}
+
+				filteredHeights, err = idx.matchRange(ctx, qr, prefix, filteredHeights, firstRun, heightInfo)
 				if err != nil {
 					return nil, err
 				}
 			}
+			heightsInitialized = true
+
+			// Ignore any remaining conditions if the first condition resulted in no
+			// matches (assuming implicit AND operand).
+			if firstRun && len(filteredHeights) == 0 {
+				break
+			}
 		}
 	}
 
@@ -219,8 +431,6 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	}
 
 	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-	resultMap := make(map[int64]struct{})
 	for _, hBz := range filteredHeights {
 		h := int64FromBytes(hBz)
 
@@ -229,10 +439,7 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 			return nil, err
 		}
 		if ok {
-			if _, ok := resultMap[h]; !ok {
-				resultMap[h] = struct{}{}
-				results = append(results, h)
-			}
+			results[h] = struct{}{}
 		}
 
 		select {
@@ -243,8 +450,6 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
 	return results, nil
 }
 
@@ -559,6 +764,16 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, typ
 				if err := batch.Set(key, heightBz); err != nil {
 					return err
 				}
+
+				key, err = idx.rangeKey(compositeKey, typ, attr.Value, height, idx.eventSeq)
+				if err != nil {
+					return fmt.Errorf("failed to create block range index key: %w", err)
+				}
+				if key != nil {
+					if err := batch.Set(key, heightBz); err != nil {
+						return err
+					}
+				}
 			}
 		}
 	}
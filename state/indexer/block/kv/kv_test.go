@@ -11,6 +11,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/types"
 )
@@ -142,6 +143,30 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustParse("end_event.foo CONTAINS '1'"),
 			results: []int64{1, 10},
 		},
+		"end_event.foo = 2 OR end_event.foo = 10": {
+			q:       query.MustParse("end_event.foo = 2 OR end_event.foo = 10"),
+			results: []int64{2, 10},
+		},
+		"end_event.foo IN (4, 6, 100)": {
+			q:       query.MustParse("end_event.foo IN (4, 6, 100)"),
+			results: []int64{1, 4, 6},
+		},
+		"NOT end_event.foo EXISTS": {
+			q:       query.MustParse("NOT end_event.foo EXISTS"),
+			results: []int64{3, 5, 7, 9, 11},
+		},
+		"block.height < 6 AND NOT end_event.foo <= 5": {
+			q:       query.MustParse("block.height < 6 AND NOT end_event.foo <= 5"),
+			results: []int64{1, 3, 5},
+		},
+		"(block.height = 3 OR block.height = 9) AND begin_event.proposer = 'FCAA001'": {
+			q:       query.MustParse("(block.height = 3 OR block.height = 9) AND begin_event.proposer = 'FCAA001'"),
+			results: []int64{3, 9},
+		},
+		"NOT (begin_event.proposer = 'FCAA001' OR end_event.foo EXISTS)": {
+			q:       query.MustParse("NOT (begin_event.proposer = 'FCAA001' OR end_event.foo EXISTS)"),
+			results: []int64{},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -340,3 +365,122 @@ func TestBlockIndexerMulti(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockIndexerPrune(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	idx := blockidxkv.New(store)
+
+	for h := int64(1); h <= 600; h++ {
+		require.NoError(t, idx.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: h},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					{
+						Type: "end_event",
+						Attributes: []abci.EventAttribute{
+							{Key: "foo", Value: fmt.Sprintf("%d", h), Index: true},
+						},
+					},
+				},
+			},
+		}))
+	}
+
+	// an interrupted pruning already rejects the searches for pruned heights,
+	// and is resumed by the next one
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, idx.Prune(ctx, 591), context.Canceled)
+	_, err := idx.Search(context.Background(), query.MustParse("block.height = 5"))
+	require.ErrorIs(t, err, indexer.ErrPruned)
+
+	require.NoError(t, idx.Prune(context.Background(), 591))
+	retainHeight, err := idx.RetainHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 591, retainHeight)
+
+	ok, err := idx.Has(590)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = idx.Has(591)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	results, err := idx.Search(context.Background(), query.MustParse("end_event.foo EXISTS"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{591, 592, 593, 594, 595, 596, 597, 598, 599, 600}, results)
+
+	results, err = idx.Search(context.Background(), query.MustParse("block.height >= 595 AND end_event.foo < 598"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{595, 596, 597}, results)
+
+	_, err = idx.Search(context.Background(), query.MustParse("block.height < 595"))
+	require.ErrorIs(t, err, indexer.ErrPruned)
+}
+
+func TestBlockIndexerFirstHeight(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	idx := blockidxkv.New(store)
+
+	height, err := idx.FirstHeight()
+	require.NoError(t, err)
+	require.Zero(t, height)
+
+	for _, h := range []int64{7, 5, 300} {
+		require.NoError(t, idx.Index(types.EventDataNewBlockHeader{Header: types.Header{Height: h}}))
+	}
+	height, err = idx.FirstHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 5, height)
+
+	require.NoError(t, idx.Prune(context.Background(), 6))
+	height, err = idx.FirstHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 7, height)
+}
+
+func TestBlockIndexerRangeIndex(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	idx := blockidxkv.New(store, blockidxkv.WithRangeIndexes(indexer.RangeIndexes{
+		"rewards.total": indexer.RangeIndexDecimal,
+	}))
+
+	// the totals of the heights 1, 2, 3... are 30, 29.5, 29...
+	for h := int64(1); h <= 20; h++ {
+		require.NoError(t, idx.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: h},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					{
+						Type: "rewards",
+						Attributes: []abci.EventAttribute{
+							{Key: "total", Value: fmt.Sprintf("%.1fstake", 30.5-float64(h)/2), Index: true},
+						},
+					},
+				},
+			},
+		}))
+	}
+
+	results, err := idx.Search(context.Background(), query.MustParse("rewards.total > 28 AND rewards.total <= 29.5"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3, 4}, results)
+
+	results, err = idx.Search(context.Background(), query.MustParse("rewards.total < 25 AND block.height < 13"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{12}, results)
+
+	results, err = idx.SearchOrder(context.Background(), query.MustParse("rewards.total EXISTS"),
+		indexer.Order{Key: "rewards.total"}, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int64{20, 19, 18}, results)
+
+	results, err = idx.SearchOrder(context.Background(), query.MustParse("block.height <= 5"),
+		indexer.Order{Key: "rewards.total", Desc: true}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, results)
+
+	_, err = idx.SearchOrder(context.Background(), query.MustParse("block.height <= 5"),
+		indexer.Order{Key: "rewards.count"}, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,131 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/google/orderedcode"
+
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/types"
+)
+
+// pruneBatchSize is the maximum number of keys deleted at once by Prune.
+const pruneBatchSize = 1000
+
+// retainHeightKey is the key of the height below which the index was pruned.
+var retainHeightKey = []byte("retain_height")
+
+var _ indexer.Pruner = (*BlockerIndexer)(nil)
+
+// RetainHeight returns the height below which the index was pruned, or 0 if
+// it never was, as part of indexer.Pruner.
+func (idx *BlockerIndexer) RetainHeight() (int64, error) {
+	bz, err := idx.store.Get(retainHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64FromBytes(bz), nil
+}
+
+// Prune deletes the events indexed for the blocks below retainHeight, as part
+// of indexer.Pruner. The searches for these heights fail with
+// indexer.ErrPruned as soon as it is called.
+//
+// The event keys only have the height after the event value, so Prune goes
+// through all the keys of the index, deleting at most pruneBatchSize of them
+// at once.
+func (idx *BlockerIndexer) Prune(ctx context.Context, retainHeight int64) error {
+	current, err := idx.RetainHeight()
+	if err != nil {
+		return err
+	}
+	if retainHeight > current {
+		if err := idx.store.SetSync(retainHeightKey, int64ToBytes(retainHeight)); err != nil {
+			return err
+		}
+	}
+
+	heightPrefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return fmt.Errorf("failed to create prefix key: %w", err)
+	}
+
+	var start []byte
+	for {
+		keys, next, err := idx.keysToPrune(start, heightPrefix, retainHeight)
+		if err != nil {
+			return err
+		}
+
+		batch := idx.store.NewBatch()
+		for _, key := range keys {
+			if err := batch.Delete(key); err != nil {
+				batch.Close()
+				return err
+			}
+		}
+		err = batch.WriteSync()
+		batch.Close()
+		if err != nil {
+			return err
+		}
+
+		if next == nil {
+			return nil
+		}
+		start = next
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+}
+
+// keysToPrune returns up to pruneBatchSize keys of the blocks below
+// retainHeight, from start, and the key to continue from, or nil if it
+// reached the end of the index.
+//
+// NOTE: the store is only written once the iterator is closed.
+func (idx *BlockerIndexer) keysToPrune(start, heightPrefix []byte, retainHeight int64) (keys [][]byte, next []byte, err error) {
+	it, err := idx.store.Iterator(start, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if len(keys) == pruneBatchSize {
+			return keys, append([]byte{}, it.Key()...), nil
+		}
+
+		if height, ok := heightOfKey(it.Key(), heightPrefix); ok && height < retainHeight {
+			keys = append(keys, append([]byte{}, it.Key()...))
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, err
+	}
+	return keys, nil, nil
+}
+
+// heightOfKey returns the height of a primary, event or range index key. It
+// returns false for the other keys, e.g. retainHeightKey.
+func heightOfKey(key, heightPrefix []byte) (int64, bool) {
+	if height, _, err := parseRangeKey(key); err == nil {
+		return height, true
+	}
+	if bytes.HasPrefix(key, heightPrefix) {
+		var (
+			compositeKey string
+			height       int64
+		)
+		_, err := orderedcode.Parse(string(key), &compositeKey, &height)
+		return height, err == nil
+	}
+	height, err := parseHeightFromEventKey(key)
+	return height, err == nil
+}
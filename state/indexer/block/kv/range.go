@@ -0,0 +1,253 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/orderedcode"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/types"
+)
+
+// rangeKeyPrefix starts the keys of the range indexes. Having no dot, it is
+// not the composite key of an event attribute.
+const rangeKeyPrefix = "range"
+
+var _ indexer.BlockOrderSearcher = (*BlockerIndexer)(nil)
+
+// Option sets an optional parameter on the BlockerIndexer.
+type Option func(*BlockerIndexer)
+
+// WithRangeIndexes indexes the values of the attributes of indexes in the
+// order of their types, besides their strings. The range conditions on these
+// attributes then only scan the values within the range, and the results of
+// a search can be ordered by them (see SearchOrder).
+//
+// The blocks indexed before an attribute was added are only in its range
+// index once indexed again, e.g. with the reindex-event command.
+func WithRangeIndexes(indexes indexer.RangeIndexes) Option {
+	return func(idx *BlockerIndexer) { idx.rangeIndexes = indexes }
+}
+
+// rangeKey returns the key of the value of an attribute in its range index,
+// or nil if it has none or the value is not of the type of the index.
+func (idx *BlockerIndexer) rangeKey(compositeKey, typ, value string, height, eventSeq int64) ([]byte, error) {
+	rangeType, ok := idx.rangeIndexes[compositeKey]
+	if !ok {
+		return nil, nil
+	}
+	encoded, err := rangeType.EncodeValue(value)
+	if err != nil {
+		return nil, nil
+	}
+	return orderedcode.Append(nil, rangeKeyPrefix, compositeKey, string(encoded), height, typ, eventSeq)
+}
+
+// SearchOrder returns the heights matching the query and having the
+// attribute of order, ordered by its values and then by height, up to limit
+// heights if it is positive, as part of indexer.BlockOrderSearcher. The
+// attribute must have a range index.
+//
+// A query only made of range conditions on the attribute, e.g.
+// "rewards.total > 1000", is searched by scanning the range index, up to the
+// limit. The other queries are searched first, and their results are then
+// ordered.
+func (idx *BlockerIndexer) SearchOrder(
+	ctx context.Context,
+	q *query.Query,
+	order indexer.Order,
+	limit int,
+) ([]int64, error) {
+	typ, ok := idx.rangeIndexes[order.Key]
+	if !ok {
+		return nil, fmt.Errorf("can't order by %s, which has no range index", order.Key)
+	}
+
+	retainHeight, err := idx.RetainHeight()
+	if err != nil {
+		return nil, err
+	}
+	if err := indexer.CheckRetainHeight(q, types.BlockHeightKey, retainHeight); err != nil {
+		return nil, err
+	}
+
+	var (
+		bounds   indexer.RangeBounds
+		filtered map[int64]struct{}
+	)
+	if qr, ok := rangeOf(q, order.Key); ok {
+		if bounds, err = typ.Bounds(qr); err != nil {
+			return nil, err
+		}
+	} else if filtered, err = idx.searchExpr(ctx, q.Expr()); err != nil {
+		return nil, err
+	}
+
+	results := make([]int64, 0)
+	seen := make(map[int64]struct{})
+	err = idx.iterateRange(order.Key, bounds, order.Desc, func(it dbm.Iterator) bool {
+		height := int64FromBytes(it.Value())
+		if _, ok := seen[height]; ok {
+			return true
+		}
+		if filtered != nil {
+			if _, ok := filtered[height]; !ok {
+				return true
+			}
+		}
+		// a block is ordered by the first of its values
+		seen[height] = struct{}{}
+		results = append(results, height)
+		return ctx.Err() == nil && (limit <= 0 || len(results) < limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// matchRangeIndex is matchRange for an attribute with a range index, only
+// scanning the keys of the values within qr.
+func (idx *BlockerIndexer) matchRangeIndex(
+	ctx context.Context,
+	typ indexer.RangeIndexType,
+	qr indexer.QueryRange,
+	filteredHeights map[string][]byte,
+	firstRun bool,
+	heightInfo HeightInfo,
+) (map[string][]byte, error) {
+	// A previous match was attempted but resulted in no matches, so we return
+	// no matches (assuming AND operand).
+	if !firstRun && len(filteredHeights) == 0 {
+		return filteredHeights, nil
+	}
+
+	bounds, err := typ.Bounds(qr)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpHeights := make(map[string][]byte)
+	err = idx.iterateRange(qr.Key, bounds, false, func(it dbm.Iterator) bool {
+		height, eventSeq, err := parseRangeKey(it.Key())
+		if err == nil && checkHeightConditions(heightInfo, height) {
+			tmpHeights[string(it.Value())+strconv.FormatInt(eventSeq, 10)] = it.Value()
+		}
+		return ctx.Err() == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tmpHeights) == 0 || firstRun {
+		return tmpHeights, nil
+	}
+
+	// Remove/reduce matches in filteredHeights that were not found in this
+	// match (tmpHeights).
+	for k, v := range filteredHeights {
+		if tmpHeight := tmpHeights[k]; tmpHeight == nil || !bytes.Equal(tmpHeight, v) {
+			delete(filteredHeights, k)
+		}
+	}
+	return filteredHeights, nil
+}
+
+// rangeOf returns the range of the values of the attribute selected by the
+// query, if it is only made of range or EXISTS conditions on the attribute.
+func rangeOf(q *query.Query, compositeKey string) (indexer.QueryRange, bool) {
+	conditions, ok := q.Expr().Conjunction()
+	if !ok {
+		return indexer.QueryRange{}, false
+	}
+	for _, c := range conditions {
+		if c.CompositeKey != compositeKey || !(indexer.IsRangeOperation(c.Op) || c.Op == query.OpExists) {
+			return indexer.QueryRange{}, false
+		}
+	}
+	ranges, _, _ := indexer.LookForRangesWithHeight(conditions)
+	return ranges[compositeKey], true
+}
+
+// iterateRange calls fn with the keys of the range index of the attribute
+// whose values are within bounds, in the order of the values, until it
+// returns false.
+func (idx *BlockerIndexer) iterateRange(
+	compositeKey string,
+	bounds indexer.RangeBounds,
+	desc bool,
+	fn func(it dbm.Iterator) bool,
+) error {
+	start, end, err := rangeScan(compositeKey, bounds)
+	if err != nil {
+		return err
+	}
+
+	var it dbm.Iterator
+	if desc {
+		it, err = idx.store.ReverseIterator(start, end)
+	} else {
+		it, err = idx.store.Iterator(start, end)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var value string
+		if _, err := orderedcode.Parse(string(it.Key()), new(string), new(string), &value); err != nil {
+			continue
+		}
+		if !bounds.Contains([]byte(value)) {
+			continue
+		}
+		if !fn(it) {
+			break
+		}
+	}
+	return it.Error()
+}
+
+// rangeScan returns the keys to scan the values of an attribute within
+// bounds, from start included to end excluded.
+func rangeScan(compositeKey string, bounds indexer.RangeBounds) (start, end []byte, err error) {
+	prefix, err := orderedcode.Append(nil, rangeKeyPrefix, compositeKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+
+	start = prefix
+	if bounds.Lower != nil {
+		if start, err = orderedcode.Append(nil, rangeKeyPrefix, compositeKey, string(bounds.Lower)); err != nil {
+			return nil, nil, fmt.Errorf("failed to create start key: %w", err)
+		}
+	}
+	end = prefix
+	if bounds.Upper != nil {
+		if end, err = orderedcode.Append(nil, rangeKeyPrefix, compositeKey, string(bounds.Upper)); err != nil {
+			return nil, nil, fmt.Errorf("failed to create end key: %w", err)
+		}
+	}
+	// The encoded strings end with 0x01, and the keys of the values equal to
+	// the upper bound are before the key incrementing it.
+	end = append(end[:len(end)-1:len(end)-1], end[len(end)-1]+1)
+	return start, end, nil
+}
+
+// parseRangeKey returns the height and the event sequence of a key of a range
+// index.
+func parseRangeKey(key []byte) (height, eventSeq int64, err error) {
+	var prefix, compositeKey, value, typ string
+	_, err = orderedcode.Parse(string(key), &prefix, &compositeKey, &value, &height, &typ, &eventSeq)
+	if err == nil && prefix != rangeKeyPrefix {
+		err = fmt.Errorf("not a range index key")
+	}
+	return height, eventSeq, err
+}
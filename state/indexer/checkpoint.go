@@ -0,0 +1,20 @@
+package indexer
+
+// Checkpoint records the progress of a reindexing of the heights from Start
+// to End: the heights up to Height were indexed.
+type Checkpoint struct {
+	Start  int64 `json:"start"`
+	End    int64 `json:"end"`
+	Height int64 `json:"height"`
+}
+
+// Checkpointer is implemented by the indexers which can record the progress
+// of a reindexing, for it to resume once interrupted.
+type Checkpointer interface {
+	// Checkpoint returns the checkpoint of the last reindexing, or nil if
+	// there is none.
+	Checkpoint() (*Checkpoint, error)
+
+	// SetCheckpoint records the checkpoint, or deletes it if it is nil.
+	SetCheckpoint(*Checkpoint) error
+}
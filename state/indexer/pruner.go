@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+)
+
+// ErrPruned is returned by the searches for heights which were pruned from
+// the indexer.
+var ErrPruned = errors.New("the indexed heights were pruned")
+
+// Pruner is implemented by the indexers which can delete what they indexed
+// for old heights.
+type Pruner interface {
+	// Prune deletes everything indexed for the heights below retainHeight.
+	// It can be interrupted by canceling ctx, and resumed by calling it
+	// again.
+	Prune(ctx context.Context, retainHeight int64) error
+
+	// RetainHeight returns the height below which the indexer was pruned, or
+	// 0 if it never was.
+	RetainHeight() (int64, error)
+}
+
+// CheckRetainHeight returns an error wrapping ErrPruned if the conditions on
+// heightKey which the other conditions of q are joined to by AND restrict the
+// search to a range of heights including some below retainHeight. The
+// searches without such conditions only find what was not pruned.
+func CheckRetainHeight(q *query.Query, heightKey string, retainHeight int64) error {
+	if retainHeight <= 1 {
+		return nil
+	}
+
+	var conditions []query.Condition
+	e := q.Expr()
+	if cs, ok := e.Conjunction(); ok {
+		conditions = cs
+	} else if e.Op == query.ExprAnd {
+		for _, arg := range e.Args {
+			if arg.Op == query.ExprCondition {
+				conditions = append(conditions, arg.Condition)
+			}
+		}
+	}
+
+	var (
+		found        bool
+		hasUpper     bool
+		lower, upper int64 = 1, 0
+	)
+	for _, c := range conditions {
+		h, ok := c.Operand.(int64)
+		if c.CompositeKey != heightKey || !ok {
+			continue
+		}
+		switch c.Op {
+		case query.OpEqual:
+			lower, upper, hasUpper = max64(lower, h), h, true
+		case query.OpGreater:
+			lower = max64(lower, h+1)
+		case query.OpGreaterEqual:
+			lower = max64(lower, h)
+		case query.OpLess:
+			if !hasUpper || h-1 < upper {
+				upper, hasUpper = h-1, true
+			}
+		case query.OpLessEqual:
+			if !hasUpper || h < upper {
+				upper, hasUpper = h, true
+			}
+		default:
+			continue
+		}
+		found = true
+	}
+
+	if !found || lower >= retainHeight || (hasUpper && upper < lower) {
+		return nil
+	}
+	return fmt.Errorf("%w: the query selects the heights from %d, but only those from %d are indexed",
+		ErrPruned, lower, retainHeight)
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
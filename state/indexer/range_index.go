@@ -0,0 +1,261 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+)
+
+// RangeIndexType is the type of the values of an attribute with a range
+// index. The kv indexers encode these values in the keys of the index, in
+// the order of the values, for the searches to scan the keys of a range
+// rather than decoding every value.
+type RangeIndexType string
+
+const (
+	// RangeIndexInt is the type of the integer values, up to math.MaxInt64.
+	RangeIndexInt RangeIndexType = "int"
+	// RangeIndexDecimal is the type of the decimal values, of any precision.
+	RangeIndexDecimal RangeIndexType = "decimal"
+	// RangeIndexTime is the type of the times and dates, in the formats of
+	// the query language.
+	RangeIndexTime RangeIndexType = "time"
+)
+
+// numRegex matches the number in an attribute value, as in the query
+// language, e.g. "100" in "100stake".
+var numRegex = regexp.MustCompile(`([0-9\.]+)`)
+
+// RangeIndexes maps the composite keys of the attributes with a range index,
+// e.g. "transfer.amount", to the type of their values.
+type RangeIndexes map[string]RangeIndexType
+
+// ParseRangeIndexes parses the range indexes of the configuration, each of
+// the form "<event type>.<attribute key>:<type>", e.g.
+// "transfer.amount:decimal".
+func ParseRangeIndexes(specs []string) (RangeIndexes, error) {
+	indexes := make(RangeIndexes, len(specs))
+	for _, spec := range specs {
+		i := strings.LastIndex(spec, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("range index %q: expected <event type>.<attribute key>:<type>", spec)
+		}
+		key, typ := spec[:i], RangeIndexType(spec[i+1:])
+		if !strings.Contains(key, ".") {
+			return nil, fmt.Errorf("range index %q: expected <event type>.<attribute key>:<type>", spec)
+		}
+		switch typ {
+		case RangeIndexInt, RangeIndexDecimal, RangeIndexTime:
+		default:
+			return nil, fmt.Errorf("range index %q: unknown type %q, expected int, decimal or time", spec, typ)
+		}
+		if _, ok := indexes[key]; ok {
+			return nil, fmt.Errorf("range index %q: duplicate attribute %s", spec, key)
+		}
+		indexes[key] = typ
+	}
+	return indexes, nil
+}
+
+// EncodeValue returns the encoding of an attribute value, ordered as the
+// values. The numbers are read as in the query language, e.g. 100 for
+// "100stake". It fails if the value is not of the type, in which case the
+// attribute is not in the range index.
+func (t RangeIndexType) EncodeValue(value string) ([]byte, error) {
+	switch t {
+	case RangeIndexInt:
+		number := numRegex.FindString(value)
+		// as the query language, the decimals are truncated
+		if i := strings.Index(number, "."); i >= 0 {
+			if strings.Count(number, ".") > 1 {
+				return nil, fmt.Errorf("invalid number %q", number)
+			}
+			number = number[:i]
+		}
+		v, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return encodeInt(v), nil
+
+	case RangeIndexDecimal:
+		return encodeDecimal(numRegex.FindString(value))
+
+	case RangeIndexTime:
+		var (
+			v   time.Time
+			err error
+		)
+		if strings.ContainsAny(value, "T") {
+			v, err = time.Parse(query.TimeLayout, value)
+		} else {
+			v, err = time.Parse(query.DateLayout, value)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return encodeTime(v), nil
+
+	default:
+		return nil, fmt.Errorf("unknown range index type %q", t)
+	}
+}
+
+// RangeBounds are the bounds of a range of encoded values, nil for no
+// bound.
+type RangeBounds struct {
+	Lower        []byte
+	Upper        []byte
+	IncludeLower bool
+	IncludeUpper bool
+}
+
+// Bounds returns the bounds of the encoded values within qr. It fails if the
+// operands of qr can't be compared with the values, e.g. a time with an
+// integer.
+func (t RangeIndexType) Bounds(qr QueryRange) (RangeBounds, error) {
+	b := RangeBounds{IncludeLower: qr.IncludeLowerBound, IncludeUpper: qr.IncludeUpperBound}
+	if qr.LowerBound != nil {
+		v, include, err := t.encodeOperand(qr.LowerBound, qr.IncludeLowerBound, math.Ceil)
+		if err != nil {
+			return RangeBounds{}, fmt.Errorf("lower bound of %s: %w", qr.Key, err)
+		}
+		b.Lower, b.IncludeLower = v, include
+	}
+	if qr.UpperBound != nil {
+		v, include, err := t.encodeOperand(qr.UpperBound, qr.IncludeUpperBound, math.Floor)
+		if err != nil {
+			return RangeBounds{}, fmt.Errorf("upper bound of %s: %w", qr.Key, err)
+		}
+		b.Upper, b.IncludeUpper = v, include
+	}
+	return b, nil
+}
+
+// encodeOperand returns the encoding of a bound, and whether the values
+// equal to it are included. A fractional bound of an integer is rounded to
+// the nearest integer within the range, which is then included.
+func (t RangeIndexType) encodeOperand(
+	operand interface{},
+	include bool,
+	round func(float64) float64,
+) ([]byte, bool, error) {
+	switch t {
+	case RangeIndexInt:
+		switch v := operand.(type) {
+		case int64:
+			return encodeInt(v), include, nil
+		case float64:
+			if r := round(v); r != v {
+				v, include = r, true
+			}
+			if v >= math.MaxInt64 {
+				return encodeInt(math.MaxInt64), include, nil
+			}
+			return encodeInt(int64(v)), include, nil
+		}
+
+	case RangeIndexDecimal:
+		switch v := operand.(type) {
+		case int64:
+			enc, err := encodeDecimal(strconv.FormatInt(v, 10))
+			return enc, include, err
+		case float64:
+			enc, err := encodeDecimal(strconv.FormatFloat(v, 'f', -1, 64))
+			return enc, include, err
+		}
+
+	case RangeIndexTime:
+		if v, ok := operand.(time.Time); ok {
+			return encodeTime(v), include, nil
+		}
+	}
+	return nil, false, fmt.Errorf("a %T can't be compared with values of type %s", operand, t)
+}
+
+// Contains reports whether the encoded value v is within the bounds.
+func (b RangeBounds) Contains(v []byte) bool {
+	if b.Lower != nil {
+		c := bytes.Compare(v, b.Lower)
+		if c < 0 || (c == 0 && !b.IncludeLower) {
+			return false
+		}
+	}
+	if b.Upper != nil {
+		c := bytes.Compare(v, b.Upper)
+		if c > 0 || (c == 0 && !b.IncludeUpper) {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeInt encodes the non-negative integers of the query language in big
+// endian, for 999 to come before 1000, unlike their strings.
+func encodeInt(v int64) []byte {
+	if v < 0 {
+		v = 0
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(v))
+	return bz
+}
+
+// encodeDecimal encodes the non-negative decimal numbers of the query
+// language, of any precision. The numbers from 1 start with the number of
+// digits of their integer part, and those below 1 with the number of zeros
+// after the point, so that their digits can then be compared as strings.
+//
+//	0      => 0x00
+//	0.0025 => 0x01 | MaxUint32-2 | "25"
+//	12.5   => 0x02 | 2 | "125"
+func encodeDecimal(number string) ([]byte, error) {
+	integer, fraction, _ := strings.Cut(number, ".")
+	if (integer == "" && fraction == "") || !isDigits(integer) || !isDigits(fraction) {
+		return nil, fmt.Errorf("invalid number %q", number)
+	}
+	integer = strings.TrimLeft(integer, "0")
+	fraction = strings.TrimRight(fraction, "0")
+
+	var bz []byte
+	switch {
+	case integer == "" && fraction == "":
+		return []byte{0x00}, nil
+
+	case integer == "":
+		digits := strings.TrimLeft(fraction, "0")
+		bz = binary.BigEndian.AppendUint32([]byte{0x01}, math.MaxUint32-uint32(len(fraction)-len(digits)))
+		bz = append(bz, digits...)
+
+	default:
+		bz = binary.BigEndian.AppendUint32([]byte{0x02}, uint32(len(integer)))
+		bz = append(bz, integer...)
+		bz = append(bz, fraction...)
+	}
+	return bz, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeTime encodes a time as its seconds since the epoch, with the sign bit
+// flipped for the times before it to come first, and its nanoseconds.
+func encodeTime(t time.Time) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, uint64(t.Unix())^(1<<63))
+	binary.BigEndian.PutUint32(bz[8:], uint32(t.Nanosecond()))
+	return bz
+}
@@ -15,10 +15,10 @@ package psql
 
 import (
 	"context"
-	"errors"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
@@ -47,16 +47,37 @@ func (b BackportTxIndexer) Index(txr *abci.TxResult) error {
 	return b.psql.IndexTxEvents([]*abci.TxResult{txr})
 }
 
-// Get is implemented to satisfy the TxIndexer interface, but is not supported
-// by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Get([]byte) (*abci.TxResult, error) {
-	return nil, errors.New("the TxIndexer.Get method is not supported")
+// Get returns the transaction with the given hash, or nil if it is not
+// indexed, as part of TxIndexer.
+func (b BackportTxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return b.psql.GetTxByHash(hash)
 }
 
-// Search is implemented to satisfy the TxIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Search(context.Context, *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("the TxIndexer.Search method is not supported")
+// Search returns the transactions matching the query, as part of TxIndexer.
+func (b BackportTxIndexer) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return b.psql.SearchTxEvents(ctx, q)
+}
+
+// SearchPage returns a page of the transactions matching the query, as part
+// of txindex.PageSearcher.
+func (b BackportTxIndexer) SearchPage(
+	ctx context.Context,
+	q *query.Query,
+	page indexer.Page,
+) ([]*abci.TxResult, int, error) {
+	return b.psql.SearchTxEventsPage(ctx, q, page)
+}
+
+// Checkpoint returns the checkpoint of the last reindexing, as part of
+// indexer.Checkpointer.
+func (b BackportTxIndexer) Checkpoint() (*indexer.Checkpoint, error) {
+	return b.psql.Checkpoint()
+}
+
+// SetCheckpoint records the checkpoint of a reindexing, as part of
+// indexer.Checkpointer.
+func (b BackportTxIndexer) SetCheckpoint(cp *indexer.Checkpoint) error {
+	return b.psql.SetCheckpoint(cp)
 }
 
 // BlockIndexer returns a bridge that implements the CometBFT v0.34 block
@@ -69,10 +90,10 @@ func (es *EventSink) BlockIndexer() BackportBlockIndexer {
 // delegating indexing operations to an underlying PostgreSQL event sink.
 type BackportBlockIndexer struct{ psql *EventSink }
 
-// Has is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Has(height int64) (bool, error) {
-	return false, errors.New("the BlockIndexer.Has method is not supported")
+// Has reports whether the block at the given height has been indexed, as
+// part of BlockIndexer.
+func (b BackportBlockIndexer) Has(height int64) (bool, error) {
+	return b.psql.HasBlock(height)
 }
 
 // Index indexes block begin and end events for the specified block.  It is
@@ -81,8 +102,14 @@ func (b BackportBlockIndexer) Index(block types.EventDataNewBlockHeader) error {
 	return b.psql.IndexBlockEvents(block)
 }
 
-// Search is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Search(context.Context, *query.Query) ([]int64, error) {
-	return nil, errors.New("the BlockIndexer.Search method is not supported")
+// Search returns the heights of the blocks matching the query, as part of
+// BlockIndexer.
+func (b BackportBlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.psql.SearchBlockEvents(ctx, q)
+}
+
+// SearchPage returns a page of the heights of the blocks matching the query,
+// as part of indexer.BlockPageSearcher.
+func (b BackportBlockIndexer) SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, int, error) {
+	return b.psql.SearchBlockEventsPage(ctx, q, page)
 }
@@ -6,6 +6,9 @@ import (
 )
 
 var (
-	_ indexer.BlockIndexer = BackportBlockIndexer{}
-	_ txindex.TxIndexer    = BackportTxIndexer{}
+	_ indexer.BlockIndexer      = BackportBlockIndexer{}
+	_ indexer.BlockPageSearcher = BackportBlockIndexer{}
+	_ txindex.TxIndexer         = BackportTxIndexer{}
+	_ txindex.PageSearcher      = BackportTxIndexer{}
+	_ indexer.Checkpointer      = BackportTxIndexer{}
 )
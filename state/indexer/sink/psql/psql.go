@@ -4,7 +4,6 @@ package psql
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -13,6 +12,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlsink"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -21,6 +22,7 @@ const (
 	tableTxResults  = "tx_results"
 	tableEvents     = "events"
 	tableAttributes = "attributes"
+	tableCheckpoint = "reindex_checkpoints"
 	driverName      = "postgres"
 )
 
@@ -32,14 +34,30 @@ type EventSink struct {
 	chainID string
 }
 
+// createCheckpointTable creates the table of the re-index checkpoints of
+// schema.sql, for the databases created before it was added.
+const createCheckpointTable = `
+CREATE TABLE IF NOT EXISTS ` + tableCheckpoint + ` (
+  chain_id     VARCHAR NOT NULL PRIMARY KEY,
+  start_height BIGINT NOT NULL,
+  end_height   BIGINT NOT NULL,
+  height       BIGINT NOT NULL
+);
+`
+
 // NewEventSink constructs an event sink associated with the PostgreSQL
 // database specified by connStr. Events written to the sink are attributed to
-// the specified chainID.
+// the specified chainID. The tables of schema.sql must have been created,
+// except for the reindex_checkpoints table, which is created if missing.
 func NewEventSink(connStr, chainID string) (*EventSink, error) {
 	db, err := sql.Open(driverName, connStr)
 	if err != nil {
 		return nil, err
 	}
+	if _, err := db.Exec(createCheckpointTable); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating the %s table: %w", tableCheckpoint, err)
+	}
 
 	return &EventSink{
 		store:   db,
@@ -230,24 +248,81 @@ INSERT INTO `+tableTxResults+` (block_id, index, created_at, tx_hash, tx_result)
 	return nil
 }
 
-// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
+// searcher returns the searcher of the records of the chain of es.
+func (es *EventSink) searcher() *sqlsink.Searcher {
+	return sqlsink.NewSearcher(es.store, es.chainID, &dialect)
+}
+
+// SearchBlockEvents returns the heights of the blocks whose events match q,
+// in ascending order.
 func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
-	return nil, errors.New("block search is not supported via the postgres event sink")
+	heights, _, err := es.searcher().SearchBlocks(ctx, q, nil)
+	return heights, err
 }
 
-// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
+// SearchBlockEventsPage returns the page of the heights of the blocks whose
+// events match q, and their total number.
+func (es *EventSink) SearchBlockEventsPage(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, int, error) {
+	return es.searcher().SearchBlocks(ctx, q, &page)
+}
+
+// SearchTxEvents returns the results of the transactions whose events match
+// q, ordered by height and index.
 func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("tx search is not supported via the postgres event sink")
+	results, _, err := es.searcher().SearchTxs(ctx, q, nil)
+	return results, err
+}
+
+// SearchTxEventsPage returns the page of the results of the transactions
+// whose events match q, and their total number.
+func (es *EventSink) SearchTxEventsPage(
+	ctx context.Context,
+	q *query.Query,
+	page indexer.Page,
+) ([]*abci.TxResult, int, error) {
+	return es.searcher().SearchTxs(ctx, q, &page)
 }
 
-// GetTxByHash is not implemented by this sink, and reports an error for all queries.
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it is not indexed.
 func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
-	return nil, errors.New("getTxByHash is not supported via the postgres event sink")
+	return es.searcher().GetTxByHash(hash)
 }
 
-// HasBlock is not implemented by this sink, and reports an error for all queries.
+// HasBlock reports whether the block at height h has been indexed.
 func (es *EventSink) HasBlock(h int64) (bool, error) {
-	return false, errors.New("hasBlock is not supported via the postgres event sink")
+	return es.searcher().HasBlock(h)
+}
+
+// Checkpoint returns the checkpoint of the last reindexing of the chain, or
+// nil if there is none.
+func (es *EventSink) Checkpoint() (*indexer.Checkpoint, error) {
+	var cp indexer.Checkpoint
+	err := es.store.QueryRow(`
+SELECT start_height, end_height, height FROM `+tableCheckpoint+` WHERE chain_id = $1;
+`, es.chainID).Scan(&cp.Start, &cp.End, &cp.Height)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("finding checkpoint: %w", err)
+	}
+	return &cp, nil
+}
+
+// SetCheckpoint records the checkpoint of a reindexing of the chain, or
+// deletes it if it is nil.
+func (es *EventSink) SetCheckpoint(cp *indexer.Checkpoint) error {
+	if cp == nil {
+		_, err := es.store.Exec(`DELETE FROM `+tableCheckpoint+` WHERE chain_id = $1;`, es.chainID)
+		return err
+	}
+	_, err := es.store.Exec(`
+INSERT INTO `+tableCheckpoint+` (chain_id, start_height, end_height, height)
+  VALUES ($1, $2, $3, $4)
+  ON CONFLICT (chain_id) DO UPDATE
+  SET start_height = $2, end_height = $3, height = $4;
+`, es.chainID, cp.Start, cp.End, cp.Height)
+	return err
 }
 
 // Stop closes the underlying PostgreSQL database.
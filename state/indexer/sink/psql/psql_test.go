@@ -19,6 +19,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 
@@ -145,13 +147,16 @@ func TestIndexing(t *testing.T) {
 		verifyBlock(t, 1)
 		verifyBlock(t, 2)
 
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
+		has, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, has)
+		has, err = indexer.HasBlock(2)
+		require.NoError(t, err)
+		assert.False(t, has)
 
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(context.Background(), nil)
-			return v != nil, err
-		})
+		heights, err := indexer.SearchBlockEvents(context.Background(), query.MustParse("end_event.foo = 100"))
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, heights)
 
 		require.NoError(t, verifyTimeStamp(tableBlocks))
 
@@ -184,14 +189,17 @@ func TestIndexing(t *testing.T) {
 		require.NoError(t, verifyTimeStamp(tableTxResults))
 		require.NoError(t, verifyTimeStamp(viewTxEvents))
 
-		verifyNotImplemented(t, "getTxByHash", func() (bool, error) {
-			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
-			return txr != nil, err
-		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(context.Background(), nil)
-			return txr != nil, err
-		})
+		txr, err = indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+		txr, err = indexer.GetTxByHash(types.Tx("unknown").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+
+		txrs, err := indexer.SearchTxEvents(context.Background(), query.MustParse("account.owner = 'Yulieta'"))
+		require.NoError(t, err)
+		require.Len(t, txrs, 1)
+		assert.Equal(t, txResult, txrs[0])
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
@@ -248,6 +256,123 @@ func TestIndexing(t *testing.T) {
 	})
 }
 
+func TestSearch(t *testing.T) {
+	// a chain of its own, not to find the data of the other tests
+	sink := &EventSink{store: testDB(), chainID: "search-chainID"}
+
+	// the last one only starts like a date, so it is not compared to times
+	paidAt := []string{"2023-01-01", "2023-01-02T10:00:00Z", "2023-01-03 or later"}
+	var txrs []*abci.TxResult
+	for h := int64(1); h <= 3; h++ {
+		require.NoError(t, sink.IndexBlockEvents(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: h},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					makeIndexedEvent("rewards.total", fmt.Sprintf("%dstake", h*100)),
+					makeIndexedEvent("rewards.paid_at", paidAt[h-1]),
+				},
+			},
+		}))
+		for i := uint32(0); i < 2; i++ {
+			txr := &abci.TxResult{
+				Height: h,
+				Index:  i,
+				Tx:     types.Tx(fmt.Sprintf("tx-%d-%d", h, i)),
+				Result: abci.ResponseDeliverTx{
+					Code: abci.CodeTypeOK,
+					Events: []abci.Event{
+						makeIndexedEvent("transfer.amount", fmt.Sprintf("%dstake", h*10+int64(i))),
+						makeIndexedEvent("transfer.recipient", fmt.Sprintf("addr%d", i)),
+					},
+				},
+			}
+			require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txr}))
+			txrs = append(txrs, txr)
+		}
+	}
+
+	txTests := []struct {
+		query string
+		want  []*abci.TxResult
+	}{
+		{"transfer.recipient = 'addr1'", []*abci.TxResult{txrs[1], txrs[3], txrs[5]}},
+		{"transfer.recipient CONTAINS 'addr'", txrs},
+		{"transfer.recipient EXISTS", txrs},
+		{"transfer.amount > 20", []*abci.TxResult{txrs[3], txrs[4], txrs[5]}},
+		{"transfer.amount >= 20 AND transfer.amount < 30", []*abci.TxResult{txrs[2], txrs[3]}},
+		{"tx.height = 2 AND transfer.recipient = 'addr0'", []*abci.TxResult{txrs[2]}},
+		{"tx.height > 1 AND tx.height <= 2", []*abci.TxResult{txrs[2], txrs[3]}},
+		{fmt.Sprintf("tx.hash = '%x'", types.Tx(txrs[4].Tx).Hash()), []*abci.TxResult{txrs[4]}},
+		{"transfer.recipient = 'nobody'", []*abci.TxResult{}},
+		{"transfer.amount > 20 AND transfer.recipient = 'nobody'", []*abci.TxResult{}},
+		{"transfer.recipient = 'addr0' OR transfer.amount = 31", []*abci.TxResult{txrs[0], txrs[2], txrs[4], txrs[5]}},
+		{"transfer.amount IN (11, 30)", []*abci.TxResult{txrs[1], txrs[4]}},
+		{"NOT transfer.recipient = 'addr1'", []*abci.TxResult{txrs[0], txrs[2], txrs[4]}},
+		{"tx.height >= 2 AND NOT (transfer.recipient = 'addr0' OR tx.height = 3)", []*abci.TxResult{txrs[3]}},
+	}
+	for _, tc := range txTests {
+		got, err := sink.SearchTxEvents(context.Background(), query.MustParse(tc.query))
+		require.NoError(t, err, tc.query)
+		assert.Equal(t, tc.want, got, tc.query)
+	}
+
+	blockTests := []struct {
+		query string
+		want  []int64
+	}{
+		{"rewards.total > 100", []int64{2, 3}},
+		{"rewards.total = 200", []int64{2}},
+		{"block.height >= 2", []int64{2, 3}},
+		{"block.height < 3 AND rewards.total EXISTS", []int64{1, 2}},
+		{"rewards.total = 100 OR block.height = 3", []int64{1, 3}},
+		{"NOT rewards.total IN (200)", []int64{1, 3}},
+		{"transfer.recipient = 'addr0'", []int64{}}, // tx events are not block events
+		{"rewards.paid_at >= DATE 2023-01-02", []int64{2}},
+		{"rewards.paid_at < TIME 2023-01-02T00:00:00Z", []int64{1}},
+	}
+	for _, tc := range blockTests {
+		got, err := sink.SearchBlockEvents(context.Background(), query.MustParse(tc.query))
+		require.NoError(t, err, tc.query)
+		assert.Equal(t, tc.want, got, tc.query)
+	}
+
+	// pagination
+	q := query.MustParse("transfer.recipient EXISTS")
+	page, total, err := sink.SearchTxEventsPage(context.Background(), q, indexer.Page{Desc: true, Offset: 1, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 6, total)
+	assert.Equal(t, []*abci.TxResult{txrs[4], txrs[3]}, page)
+	page, total, err = sink.SearchTxEventsPage(context.Background(), q, indexer.Page{Offset: 10, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 6, total)
+	assert.Empty(t, page)
+
+	heights, total, err := sink.SearchBlockEventsPage(context.Background(),
+		query.MustParse("rewards.total EXISTS"), indexer.Page{Offset: 2, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, []int64{3}, heights)
+}
+
+func TestCheckpoint(t *testing.T) {
+	sink := &EventSink{store: testDB(), chainID: "checkpoint-chainID"}
+
+	cp, err := sink.Checkpoint()
+	require.NoError(t, err)
+	assert.Nil(t, cp)
+
+	require.NoError(t, sink.SetCheckpoint(&indexer.Checkpoint{Start: 1, End: 100, Height: 10}))
+	require.NoError(t, sink.SetCheckpoint(&indexer.Checkpoint{Start: 1, End: 100, Height: 20}))
+	cp, err = sink.Checkpoint()
+	require.NoError(t, err)
+	assert.Equal(t, &indexer.Checkpoint{Start: 1, End: 100, Height: 20}, cp)
+
+	require.NoError(t, sink.SetCheckpoint(nil))
+	cp, err = sink.Checkpoint()
+	require.NoError(t, err)
+	assert.Nil(t, cp)
+}
+
 func TestStop(t *testing.T) {
 	indexer := &EventSink{store: testDB()}
 	require.NoError(t, indexer.Stop())
@@ -289,7 +414,7 @@ func readSchema() ([]*schema.Migration, error) {
 
 // resetDB drops all the data from the test database.
 func resetDatabase(db *sql.DB) error {
-	_, err := db.Exec(`DROP TABLE IF EXISTS blocks,tx_results,events,attributes CASCADE;`)
+	_, err := db.Exec(`DROP TABLE IF EXISTS blocks,tx_results,events,attributes,reindex_checkpoints CASCADE;`)
 	if err != nil {
 		return fmt.Errorf("dropping tables: %v", err)
 	}
@@ -371,20 +496,6 @@ SELECT type, height, chain_id FROM `+viewBlockEvents+`
 	}
 }
 
-// verifyNotImplemented calls f and verifies that it returns both a
-// false-valued flag and a non-nil error whose string matching the expected
-// "not supported" message with label prefixed.
-func verifyNotImplemented(t *testing.T, label string, f func() (bool, error)) {
-	t.Helper()
-	t.Logf("Verifying that %q reports it is not implemented", label)
-
-	want := label + " is not supported via the postgres event sink"
-	ok, err := f()
-	assert.False(t, ok)
-	require.NotNil(t, err)
-	assert.Equal(t, want, err.Error())
-}
-
 // waitForInterrupt blocks until a SIGINT is received by the process.
 func waitForInterrupt() {
 	ch := make(chan os.Signal, 1)
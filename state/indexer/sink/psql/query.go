@@ -0,0 +1,28 @@
+package psql
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlsink"
+)
+
+// timeRegex matches the attribute values which are dates or times in the
+// layouts of the query package, so that the others are not cast, which would
+// fail the whole search.
+const timeRegex = `^[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])` +
+	`(T([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]+)?(Z|[+-]([01][0-9]|2[0-3]):[0-5][0-9]))?$`
+
+// dialect writes the searches for PostgreSQL. As with the kv indexer, the
+// first number in an attribute value is compared to numbers, e.g. 100 for
+// "100stake".
+var dialect = sqlsink.Dialect{
+	Placeholder: func(n int) string { return fmt.Sprintf("$%d", n) },
+	Number: func(expr string) string {
+		return `CAST(substring(` + expr + ` from '[0-9]+(?:\.[0-9]+)?') AS NUMERIC)`
+	},
+	Time: func(expr string) string {
+		return `(CASE WHEN ` + expr + ` ~ '` + timeRegex + `'
+      THEN CAST(` + expr + ` AS TIMESTAMPTZ) END)`
+	},
+	Contains: func(expr, substr string) string { return "strpos(" + expr + ", " + substr + ") > 0" },
+}
@@ -83,3 +83,13 @@ CREATE VIEW tx_events AS
   FROM blocks JOIN tx_results ON (blocks.rowid = tx_results.block_id)
   JOIN event_attributes ON (tx_results.rowid = event_attributes.tx_id)
   WHERE event_attributes.tx_id IS NOT NULL;
+
+-- The reindex_checkpoints table records the progress of the reindex-event
+-- command for each chain, for it to resume once interrupted. The sink creates
+-- it if missing, for the databases created before it was added.
+CREATE TABLE IF NOT EXISTS reindex_checkpoints (
+  chain_id     VARCHAR NOT NULL PRIMARY KEY,
+  start_height BIGINT NOT NULL,
+  end_height   BIGINT NOT NULL,
+  height       BIGINT NOT NULL
+);
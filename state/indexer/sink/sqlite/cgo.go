@@ -0,0 +1,8 @@
+//go:build cgo
+// +build cgo
+
+package sqlite
+
+// cgoEnabled reports whether the binary is built with cgo, which the SQLite
+// driver requires.
+const cgoEnabled = true
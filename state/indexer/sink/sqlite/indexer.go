@@ -0,0 +1,96 @@
+package sqlite
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxIndexer returns the transaction indexer backed by es.
+func (es *EventSink) TxIndexer() TxIndexer {
+	return TxIndexer{sqlite: es}
+}
+
+// TxIndexer implements the txindex.TxIndexer interface by delegating
+// indexing operations to an underlying SQLite event sink.
+type TxIndexer struct{ sqlite *EventSink }
+
+// AddBatch indexes a batch of transactions in SQLite, as part of TxIndexer.
+func (b TxIndexer) AddBatch(batch *txindex.Batch) error {
+	return b.sqlite.IndexTxEvents(batch.Ops)
+}
+
+// Index indexes a single transaction result in SQLite, as part of TxIndexer.
+func (b TxIndexer) Index(txr *abci.TxResult) error {
+	return b.sqlite.IndexTxEvents([]*abci.TxResult{txr})
+}
+
+// Get returns the transaction with the given hash, or nil if it is not
+// indexed, as part of TxIndexer.
+func (b TxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return b.sqlite.GetTxByHash(hash)
+}
+
+// Search returns the transactions matching the query, as part of TxIndexer.
+func (b TxIndexer) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return b.sqlite.SearchTxEvents(ctx, q)
+}
+
+// SearchPage returns a page of the transactions matching the query, as part
+// of txindex.PageSearcher.
+func (b TxIndexer) SearchPage(
+	ctx context.Context,
+	q *query.Query,
+	page indexer.Page,
+) ([]*abci.TxResult, int, error) {
+	return b.sqlite.SearchTxEventsPage(ctx, q, page)
+}
+
+// Checkpoint returns the checkpoint of the last reindexing, as part of
+// indexer.Checkpointer.
+func (b TxIndexer) Checkpoint() (*indexer.Checkpoint, error) {
+	return b.sqlite.Checkpoint()
+}
+
+// SetCheckpoint records the checkpoint of a reindexing, as part of
+// indexer.Checkpointer.
+func (b TxIndexer) SetCheckpoint(cp *indexer.Checkpoint) error {
+	return b.sqlite.SetCheckpoint(cp)
+}
+
+// BlockIndexer returns the block indexer backed by es.
+func (es *EventSink) BlockIndexer() BlockIndexer {
+	return BlockIndexer{sqlite: es}
+}
+
+// BlockIndexer implements the indexer.BlockIndexer interface by delegating
+// indexing operations to an underlying SQLite event sink.
+type BlockIndexer struct{ sqlite *EventSink }
+
+// Has reports whether the block at the given height has been indexed, as
+// part of BlockIndexer.
+func (b BlockIndexer) Has(height int64) (bool, error) {
+	return b.sqlite.HasBlock(height)
+}
+
+// Index indexes block begin and end events for the specified block, as part
+// of BlockIndexer.
+func (b BlockIndexer) Index(block types.EventDataNewBlockHeader) error {
+	return b.sqlite.IndexBlockEvents(block)
+}
+
+// Search returns the heights of the blocks matching the query, as part of
+// BlockIndexer.
+func (b BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.sqlite.SearchBlockEvents(ctx, q)
+}
+
+// SearchPage returns a page of the heights of the blocks matching the query,
+// as part of indexer.BlockPageSearcher.
+func (b BlockIndexer) SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, int, error) {
+	return b.sqlite.SearchBlockEventsPage(ctx, q, page)
+}
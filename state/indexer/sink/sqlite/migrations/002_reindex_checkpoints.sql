@@ -0,0 +1,8 @@
+-- The reindex_checkpoints table records the progress of the reindex-event
+-- command for each chain, for it to resume once interrupted.
+CREATE TABLE reindex_checkpoints (
+  chain_id     TEXT NOT NULL PRIMARY KEY,
+  start_height INTEGER NOT NULL,
+  end_height   INTEGER NOT NULL,
+  height       INTEGER NOT NULL
+);
@@ -0,0 +1,88 @@
+package sqlite
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlsink"
+)
+
+const (
+	// funcNumber is the SQL function returning the number in an attribute
+	// value, see attrNumber.
+	funcNumber = "cometbft_number"
+
+	// funcTime is the SQL function returning the time in an attribute value,
+	// see attrTime.
+	funcTime = "cometbft_time"
+)
+
+// numRegex matches the number in an attribute value, as in the query
+// package.
+var numRegex = regexp.MustCompile(`([0-9\.]+)`)
+
+// dialect writes the searches for SQLite, converting the attribute values
+// with the functions the driver registers, which are those of the query
+// package.
+var dialect = sqlsink.Dialect{
+	Placeholder: func(n int) string { return fmt.Sprintf("?%d", n) },
+	Number:      func(expr string) string { return funcNumber + "(" + expr + ")" },
+	Time:        func(expr string) string { return funcTime + "(" + expr + ")" },
+	// as returned by attrTime
+	TimeOperand: func(t time.Time) interface{} { return t.UnixNano() },
+	Contains:    func(expr, substr string) string { return "instr(" + expr + ", " + substr + ") > 0" },
+}
+
+// attrNumber returns the first number in an attribute value, e.g. 100 for
+// "100stake", as an integer or, if it has a decimal point, a float. It
+// returns nil, i.e. NULL, matching nothing, if there is none.
+func attrNumber(value interface{}) interface{} {
+	number := numRegex.FindString(attrString(value))
+	if strings.Contains(number, ".") {
+		if v, err := strconv.ParseFloat(number, 64); err == nil {
+			return v
+		}
+		return nil
+	}
+	if v, err := strconv.ParseInt(number, 10, 64); err == nil {
+		return v
+	}
+	return nil
+}
+
+// attrTime returns the time or date in an attribute value, in nanoseconds
+// since the epoch. It returns nil, i.e. NULL, matching nothing, if the value
+// is not a time.
+func attrTime(value interface{}) interface{} {
+	s := attrString(value)
+	var (
+		t   time.Time
+		err error
+	)
+	if strings.ContainsAny(s, "T") {
+		t, err = time.Parse(query.TimeLayout, s)
+	} else {
+		t, err = time.Parse(query.DateLayout, s)
+	}
+	if err != nil {
+		return nil
+	}
+	return t.UnixNano()
+}
+
+// attrString returns the attribute value passed to a function, which is
+// NULL for the attributes without a value.
+func attrString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,369 @@
+// Package sqlite implements an event sink backed by an embedded SQLite
+// database, with the schema of the psql event sink.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/adlio/schema"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/mattn/go-sqlite3"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/sink/sqlsink"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	tableBlocks     = "blocks"
+	tableTxResults  = "tx_results"
+	tableEvents     = "events"
+	tableAttributes = "attributes"
+	tableCheckpoint = "reindex_checkpoints"
+	driverName      = "cometbft_sqlite3"
+
+	// DBFile is the name of the file of the database of the sink, in the
+	// data directory of the node.
+	DBFile = "event_index.sqlite"
+)
+
+// ErrCgoDisabled is returned by NewEventSink when the binary is built without
+// cgo, which leaves the SQLite driver a stub.
+var ErrCgoDisabled = errors.New("the sqlite event sink requires a binary built with cgo " +
+	"(CGO_ENABLED=1, or make build COMETBFT_BUILD_OPTIONS=sqlite)")
+
+// migrations holds the schema of the database, as the list of the scripts
+// to apply, in the order of their names.
+//
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+func init() {
+	// The driver provides the functions converting the attribute values for
+	// comparisons, see dialect.
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			if err := conn.RegisterFunc(funcNumber, attrNumber, true); err != nil {
+				return err
+			}
+			return conn.RegisterFunc(funcTime, attrTime, true)
+		},
+	})
+}
+
+// EventSink is an indexer backend providing the tx/block index services. This
+// implementation stores records in a SQLite database using the schema
+// defined in state/indexer/sink/sqlite/migrations.
+type EventSink struct {
+	store   *sql.DB
+	chainID string
+}
+
+// NewEventSink constructs an event sink associated with the SQLite database
+// in the file at path, which is created if it does not exist. The schema is
+// installed, or migrated to the latest version. Events written to the sink
+// are attributed to the specified chainID. It returns ErrCgoDisabled if the
+// binary is built without cgo.
+func NewEventSink(path, chainID string) (*EventSink, error) {
+	if !cgoEnabled {
+		return nil, ErrCgoDisabled
+	}
+	db, err := sql.Open(driverName,
+		"file:"+path+"?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrating the schema: %w", err)
+	}
+
+	return &EventSink{
+		store:   db,
+		chainID: chainID,
+	}, nil
+}
+
+// migrate applies the migrations which have not been applied to db yet.
+func migrate(db *sql.DB) error {
+	ms, err := schema.FSMigrations(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	return schema.NewMigrator(schema.WithDialect(schema.SQLite)).Apply(db, ms)
+}
+
+// DB returns the underlying SQLite connection used by the sink.
+// This is exported to support testing.
+func (es *EventSink) DB() *sql.DB { return es.store }
+
+// runInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// queryWithID executes the specified SQL query with the given arguments,
+// expecting a single-row, single-column result containing an ID. If the query
+// succeeds, the ID from the result is returned.
+func queryWithID(tx *sql.Tx, query string, args ...interface{}) (uint32, error) {
+	var id uint32
+	if err := tx.QueryRow(query, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// insertEvents inserts a slice of events and any indexed attributes of those
+// events into the database associated with dbtx.
+//
+// If txID > 0, the event is attributed to the transaction with that
+// ID; otherwise it is recorded as a block event.
+func insertEvents(dbtx *sql.Tx, blockID, txID uint32, evts []abci.Event) error {
+	// Populate the transaction ID field iff one is defined (> 0).
+	var txIDArg interface{}
+	if txID > 0 {
+		txIDArg = txID
+	}
+
+	// Add each event to the events table, and retrieve its row ID to use when
+	// adding any attributes the event provides.
+	for _, evt := range evts {
+		// Skip events with an empty type.
+		if evt.Type == "" {
+			continue
+		}
+
+		eid, err := queryWithID(dbtx, `
+INSERT INTO `+tableEvents+` (block_id, tx_id, type) VALUES (?, ?, ?)
+  RETURNING rowid;
+`, blockID, txIDArg, evt.Type)
+		if err != nil {
+			return err
+		}
+
+		// Add any attributes flagged for indexing.
+		for _, attr := range evt.Attributes {
+			if !attr.Index {
+				continue
+			}
+			compositeKey := evt.Type + "." + attr.Key
+			if _, err := dbtx.Exec(`
+INSERT INTO `+tableAttributes+` (event_id, key, composite_key, value)
+  VALUES (?, ?, ?, ?);
+`, eid, attr.Key, compositeKey, attr.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// makeIndexedEvent constructs an event from the specified composite key and
+// value. If the key has the form "type.name", the event will have a single
+// attribute with that name and the value; otherwise the event will have only
+// a type and no attributes.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return abci.Event{Type: compositeKey}
+	}
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: compositeKey[i+1:], Value: value, Index: true},
+	}}
+}
+
+// IndexBlockEvents indexes the specified block header, part of the
+// indexer.EventSink interface.
+func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockHeader) error {
+	ts := time.Now().UTC()
+
+	return runInTransaction(es.store, func(dbtx *sql.Tx) error {
+		// Add the block to the blocks table and report back its row ID for use
+		// in indexing the events for the block.
+		blockID, err := queryWithID(dbtx, `
+INSERT INTO `+tableBlocks+` (height, chain_id, created_at)
+  VALUES (?, ?, ?)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, h.Header.Height, es.chainID, ts)
+		if err == sql.ErrNoRows {
+			return nil // we already saw this block; quietly succeed
+		} else if err != nil {
+			return fmt.Errorf("indexing block header: %w", err)
+		}
+
+		// Insert the special block meta-event for height.
+		if err := insertEvents(dbtx, blockID, 0, []abci.Event{
+			makeIndexedEvent(types.BlockHeightKey, fmt.Sprint(h.Header.Height)),
+		}); err != nil {
+			return fmt.Errorf("block meta-events: %w", err)
+		}
+		// Insert all the block events. Order is important here,
+		if err := insertEvents(dbtx, blockID, 0, h.ResultBeginBlock.Events); err != nil {
+			return fmt.Errorf("begin-block events: %w", err)
+		}
+		if err := insertEvents(dbtx, blockID, 0, h.ResultEndBlock.Events); err != nil {
+			return fmt.Errorf("end-block events: %w", err)
+		}
+		return nil
+	})
+}
+
+// IndexTxEvents indexes the specified transaction results, part of the
+// indexer.EventSink interface. The block of the transactions must have been
+// indexed first.
+func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
+	ts := time.Now().UTC()
+
+	for _, txr := range txrs {
+		// Encode the result message in protobuf wire format for indexing.
+		resultData, err := proto.Marshal(txr)
+		if err != nil {
+			return fmt.Errorf("marshaling tx_result: %w", err)
+		}
+
+		// Index the hash of the underlying transaction as a hex string.
+		txHash := fmt.Sprintf("%X", types.Tx(txr.Tx).Hash())
+
+		if err := runInTransaction(es.store, func(dbtx *sql.Tx) error {
+			// Find the block associated with this transaction. The block header
+			// must have been indexed prior to the transactions belonging to it.
+			blockID, err := queryWithID(dbtx, `
+SELECT rowid FROM `+tableBlocks+` WHERE height = ? AND chain_id = ?;
+`, txr.Height, es.chainID)
+			if err != nil {
+				return fmt.Errorf("finding block ID: %w", err)
+			}
+
+			// Insert a record for this tx_result and capture its ID for indexing events.
+			txID, err := queryWithID(dbtx, `
+INSERT INTO `+tableTxResults+` (block_id, "index", created_at, tx_hash, tx_result)
+  VALUES (?, ?, ?, ?, ?)
+  ON CONFLICT DO NOTHING
+  RETURNING rowid;
+`, blockID, txr.Index, ts, txHash, resultData)
+			if err == sql.ErrNoRows {
+				return nil // we already saw this transaction; quietly succeed
+			} else if err != nil {
+				return fmt.Errorf("indexing tx_result: %w", err)
+			}
+
+			// Insert the special transaction meta-events for hash and height.
+			if err := insertEvents(dbtx, blockID, txID, []abci.Event{
+				makeIndexedEvent(types.TxHashKey, txHash),
+				makeIndexedEvent(types.TxHeightKey, fmt.Sprint(txr.Height)),
+			}); err != nil {
+				return fmt.Errorf("indexing transaction meta-events: %w", err)
+			}
+			// Index any events packaged with the transaction.
+			if err := insertEvents(dbtx, blockID, txID, txr.Result.Events); err != nil {
+				return fmt.Errorf("indexing transaction events: %w", err)
+			}
+			return nil
+
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searcher returns the searcher of the records of the chain of es.
+func (es *EventSink) searcher() *sqlsink.Searcher {
+	return sqlsink.NewSearcher(es.store, es.chainID, &dialect)
+}
+
+// SearchBlockEvents returns the heights of the blocks whose events match q,
+// in ascending order.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	heights, _, err := es.searcher().SearchBlocks(ctx, q, nil)
+	return heights, err
+}
+
+// SearchBlockEventsPage returns the page of the heights of the blocks whose
+// events match q, and their total number.
+func (es *EventSink) SearchBlockEventsPage(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, int, error) {
+	return es.searcher().SearchBlocks(ctx, q, &page)
+}
+
+// SearchTxEvents returns the results of the transactions whose events match
+// q, ordered by height and index.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	results, _, err := es.searcher().SearchTxs(ctx, q, nil)
+	return results, err
+}
+
+// SearchTxEventsPage returns the page of the results of the transactions
+// whose events match q, and their total number.
+func (es *EventSink) SearchTxEventsPage(
+	ctx context.Context,
+	q *query.Query,
+	page indexer.Page,
+) ([]*abci.TxResult, int, error) {
+	return es.searcher().SearchTxs(ctx, q, &page)
+}
+
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it is not indexed.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	return es.searcher().GetTxByHash(hash)
+}
+
+// HasBlock reports whether the block at height h has been indexed.
+func (es *EventSink) HasBlock(h int64) (bool, error) {
+	return es.searcher().HasBlock(h)
+}
+
+// Checkpoint returns the checkpoint of the last reindexing of the chain, or
+// nil if there is none.
+func (es *EventSink) Checkpoint() (*indexer.Checkpoint, error) {
+	var cp indexer.Checkpoint
+	err := es.store.QueryRow(`
+SELECT start_height, end_height, height FROM `+tableCheckpoint+` WHERE chain_id = ?;
+`, es.chainID).Scan(&cp.Start, &cp.End, &cp.Height)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("finding checkpoint: %w", err)
+	}
+	return &cp, nil
+}
+
+// SetCheckpoint records the checkpoint of a reindexing of the chain, or
+// deletes it if it is nil.
+func (es *EventSink) SetCheckpoint(cp *indexer.Checkpoint) error {
+	if cp == nil {
+		_, err := es.store.Exec(`DELETE FROM `+tableCheckpoint+` WHERE chain_id = ?;`, es.chainID)
+		return err
+	}
+	_, err := es.store.Exec(`
+INSERT INTO `+tableCheckpoint+` (chain_id, start_height, end_height, height)
+  VALUES (?, ?, ?, ?)
+  ON CONFLICT (chain_id) DO UPDATE
+  SET start_height = excluded.start_height, end_height = excluded.end_height,
+    height = excluded.height;
+`, es.chainID, cp.Start, cp.End, cp.Height)
+	return err
+}
+
+// Stop closes the underlying SQLite database.
+func (es *EventSink) Stop() error { return es.store.Close() }
@@ -0,0 +1,253 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+const chainID = "test-chainID"
+
+var (
+	_ indexer.BlockIndexer      = BlockIndexer{}
+	_ indexer.BlockPageSearcher = BlockIndexer{}
+	_ txindex.TxIndexer         = TxIndexer{}
+	_ txindex.PageSearcher      = TxIndexer{}
+	_ indexer.Checkpointer      = TxIndexer{}
+)
+
+// newTestSink returns a sink on a new database, closed at the end of the
+// test.
+func newTestSink(t *testing.T) *EventSink {
+	t.Helper()
+	sink, err := NewEventSink(filepath.Join(t.TempDir(), "events.sqlite"), chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sink.Stop() })
+	return sink
+}
+
+func TestMigrations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.sqlite")
+	sink, err := NewEventSink(path, chainID)
+	require.NoError(t, err)
+	require.NoError(t, sink.IndexBlockEvents(newTestBlock(1)))
+	require.NoError(t, sink.Stop())
+
+	// reopening applies no migration twice, and keeps the data
+	sink, err = NewEventSink(path, chainID)
+	require.NoError(t, err)
+	defer sink.Stop()
+	has, err := sink.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	ms, err := sink.DB().Query(`SELECT id FROM schema_migrations;`)
+	require.NoError(t, err)
+	defer ms.Close()
+	var ids []string
+	for ms.Next() {
+		var id string
+		require.NoError(t, ms.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, ms.Err())
+	assert.Equal(t, []string{"001_schema", "002_reindex_checkpoints"}, ids)
+}
+
+func TestCheckpoint(t *testing.T) {
+	sink := newTestSink(t)
+
+	cp, err := sink.Checkpoint()
+	require.NoError(t, err)
+	assert.Nil(t, cp)
+
+	require.NoError(t, sink.SetCheckpoint(&indexer.Checkpoint{Start: 1, End: 100, Height: 10}))
+	require.NoError(t, sink.SetCheckpoint(&indexer.Checkpoint{Start: 1, End: 100, Height: 20}))
+	cp, err = sink.Checkpoint()
+	require.NoError(t, err)
+	assert.Equal(t, &indexer.Checkpoint{Start: 1, End: 100, Height: 20}, cp)
+
+	require.NoError(t, sink.SetCheckpoint(nil))
+	cp, err = sink.Checkpoint()
+	require.NoError(t, err)
+	assert.Nil(t, cp)
+}
+
+func TestIndexing(t *testing.T) {
+	sink := newTestSink(t)
+
+	require.NoError(t, sink.IndexBlockEvents(newTestBlock(1)))
+	// indexing the block again is a no-op
+	require.NoError(t, sink.IndexBlockEvents(newTestBlock(1)))
+
+	rows, err := sink.DB().Query(`
+SELECT type, key, value FROM block_events WHERE height = 1 AND chain_id = ? ORDER BY key;
+`, chainID)
+	require.NoError(t, err)
+	defer rows.Close()
+	var got [][3]string
+	for rows.Next() {
+		var r [3]string
+		require.NoError(t, rows.Scan(&r[0], &r[1], &r[2]))
+		got = append(got, r)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, [][3]string{
+		{"block", "height", "1"},
+		{"rewards", "total", "100stake"},
+	}, got)
+
+	has, err := sink.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, has)
+	has, err = sink.HasBlock(2)
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	txr := newTestTx(1, 0, "Ivan")
+	require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txr}))
+	// indexing the transaction again is a no-op
+	require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txr}))
+
+	var n int
+	require.NoError(t, sink.DB().QueryRow(`
+SELECT COUNT(*) FROM tx_events WHERE height = 1 AND "index" = 0 AND composite_key = 'account.owner' AND value = 'Ivan';
+`).Scan(&n))
+	assert.Equal(t, 1, n)
+
+	got2, err := sink.GetTxByHash(types.Tx(txr.Tx).Hash())
+	require.NoError(t, err)
+	assert.Equal(t, txr, got2)
+	got2, err = sink.GetTxByHash(types.Tx("unknown").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, got2)
+
+	// the transactions of blocks which are not indexed are rejected
+	assert.Error(t, sink.IndexTxEvents([]*abci.TxResult{newTestTx(2, 0, "Ivan")}))
+
+	// another chain sees none of it
+	other := &EventSink{store: sink.DB(), chainID: "other-chainID"}
+	has, err = other.HasBlock(1)
+	require.NoError(t, err)
+	assert.False(t, has)
+	got2, err = other.GetTxByHash(types.Tx(txr.Tx).Hash())
+	require.NoError(t, err)
+	assert.Nil(t, got2)
+}
+
+func TestSearch(t *testing.T) {
+	sink := newTestSink(t)
+
+	var txrs []*abci.TxResult
+	for h := int64(1); h <= 3; h++ {
+		require.NoError(t, sink.IndexBlockEvents(newTestBlock(h)))
+		for i := uint32(0); i < 2; i++ {
+			txr := newTestTx(h, i, fmt.Sprintf("addr%d", i))
+			require.NoError(t, sink.IndexTxEvents([]*abci.TxResult{txr}))
+			txrs = append(txrs, txr)
+		}
+	}
+
+	txTests := []struct {
+		query string
+		want  []*abci.TxResult
+	}{
+		{"account.owner = 'addr1'", []*abci.TxResult{txrs[1], txrs[3], txrs[5]}},
+		{"account.owner CONTAINS 'addr'", txrs},
+		{"account.owner EXISTS", txrs},
+		{"transfer.amount > 20", []*abci.TxResult{txrs[3], txrs[4], txrs[5]}},
+		{"transfer.amount >= 20 AND transfer.amount < 30", []*abci.TxResult{txrs[2], txrs[3]}},
+		{"transfer.amount = 21.0", []*abci.TxResult{txrs[3]}},
+		{"transfer.fee > 2.75", []*abci.TxResult{txrs[4], txrs[5]}},
+		{"transfer.date >= DATE 2023-01-02", []*abci.TxResult{txrs[2], txrs[3], txrs[4], txrs[5]}},
+		{"transfer.time < TIME 2023-01-02T00:00:00Z", []*abci.TxResult{txrs[0], txrs[1]}},
+		{"tx.height = 2 AND account.owner = 'addr0'", []*abci.TxResult{txrs[2]}},
+		{"tx.height > 1 AND tx.height <= 2", []*abci.TxResult{txrs[2], txrs[3]}},
+		{fmt.Sprintf("tx.hash = '%x'", types.Tx(txrs[4].Tx).Hash()), []*abci.TxResult{txrs[4]}},
+		{"account.owner = 'nobody'", []*abci.TxResult{}},
+		{"transfer.amount > 20 AND account.owner = 'nobody'", []*abci.TxResult{}},
+		{"account.owner = 'addr0' OR transfer.amount = 31", []*abci.TxResult{txrs[0], txrs[2], txrs[4], txrs[5]}},
+		{"transfer.amount IN (11, 30)", []*abci.TxResult{txrs[1], txrs[4]}},
+		{"NOT account.owner = 'addr1'", []*abci.TxResult{txrs[0], txrs[2], txrs[4]}},
+		{"tx.height >= 2 AND NOT (account.owner = 'addr0' OR tx.height = 3)", []*abci.TxResult{txrs[3]}},
+	}
+	for _, tc := range txTests {
+		got, err := sink.SearchTxEvents(context.Background(), query.MustParse(tc.query))
+		require.NoError(t, err, tc.query)
+		assert.Equal(t, tc.want, got, tc.query)
+	}
+
+	blockTests := []struct {
+		query string
+		want  []int64
+	}{
+		{"rewards.total > 100", []int64{2, 3}},
+		{"rewards.total = 200", []int64{2}},
+		{"block.height >= 2", []int64{2, 3}},
+		{"block.height < 3 AND rewards.total EXISTS", []int64{1, 2}},
+		{"rewards.total = 100 OR block.height = 3", []int64{1, 3}},
+		{"NOT rewards.total IN (200)", []int64{1, 3}},
+		{"account.owner = 'addr0'", []int64{}}, // tx events are not block events
+	}
+	for _, tc := range blockTests {
+		got, err := sink.SearchBlockEvents(context.Background(), query.MustParse(tc.query))
+		require.NoError(t, err, tc.query)
+		assert.Equal(t, tc.want, got, tc.query)
+	}
+
+	// pagination
+	q := query.MustParse("account.owner EXISTS")
+	page, total, err := sink.SearchTxEventsPage(context.Background(), q, indexer.Page{Desc: true, Offset: 1, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 6, total)
+	assert.Equal(t, []*abci.TxResult{txrs[4], txrs[3]}, page)
+	page, total, err = sink.SearchTxEventsPage(context.Background(), q, indexer.Page{Offset: 10, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 6, total)
+	assert.Empty(t, page)
+
+	heights, total, err := sink.SearchBlockEventsPage(context.Background(),
+		query.MustParse("rewards.total EXISTS"), indexer.Page{Offset: 2, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, []int64{3}, heights)
+}
+
+func newTestBlock(height int64) types.EventDataNewBlockHeader {
+	return types.EventDataNewBlockHeader{
+		Header: types.Header{Height: height},
+		ResultEndBlock: abci.ResponseEndBlock{
+			Events: []abci.Event{makeIndexedEvent("rewards.total", fmt.Sprintf("%dstake", height*100))},
+		},
+	}
+}
+
+func newTestTx(height int64, index uint32, owner string) *abci.TxResult {
+	day := time.Date(2023, 1, int(height), 12, 0, 0, 0, time.UTC)
+	return &abci.TxResult{
+		Height: height,
+		Index:  index,
+		Tx:     types.Tx(fmt.Sprintf("tx-%d-%d", height, index)),
+		Result: abci.ResponseDeliverTx{
+			Code: abci.CodeTypeOK,
+			Events: []abci.Event{
+				makeIndexedEvent("account.owner", owner),
+				makeIndexedEvent("transfer.amount", fmt.Sprintf("%dstake", height*10+int64(index))),
+				makeIndexedEvent("transfer.fee", fmt.Sprintf("%d.5stake", height)),
+				makeIndexedEvent("transfer.date", day.Format(query.DateLayout)),
+				makeIndexedEvent("transfer.time", day.Format(query.TimeLayout)),
+			},
+		},
+	}
+}
@@ -0,0 +1,218 @@
+package sqlsink
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Dialect holds the parts of the searches which differ between the SQL
+// databases of the event sinks.
+type Dialect struct {
+	// Placeholder returns the placeholder of the nth argument of a query,
+	// counting from 1.
+	Placeholder func(n int) string
+	// Number returns the expression converting the attribute value expr to
+	// the first number in it, e.g. 100 for "100stake", like the kv indexer.
+	// The values without a number must be NULL, so match nothing.
+	Number func(expr string) string
+	// Time returns the expression converting the attribute value expr to a
+	// time or date. The values which aren't one must be NULL.
+	Time func(expr string) string
+	// TimeOperand converts a time operand of a query to the type of the
+	// expressions returned by Time. If nil, the time is passed as is.
+	TimeOperand func(time.Time) interface{}
+	// Contains returns the condition that the string expr contains the
+	// string substr.
+	Contains func(expr, substr string) string
+}
+
+// sqlQuery accumulates the conditions of the WHERE clause of a search, and
+// the arguments they refer to.
+type sqlQuery struct {
+	dialect *Dialect
+	conds   []string
+	args    []interface{}
+}
+
+// arg adds an argument to the query, and returns the placeholder for it.
+func (s *sqlQuery) arg(v interface{}) string {
+	s.args = append(s.args, v)
+	return s.dialect.Placeholder(len(s.args))
+}
+
+// where returns the WHERE clause joining all the conditions.
+func (s *sqlQuery) where() string {
+	return "WHERE " + strings.Join(s.conds, " AND ")
+}
+
+// page returns the ORDER BY, LIMIT and OFFSET clauses selecting page,
+// ordering by the given columns. A nil page selects all the rows.
+func (s *sqlQuery) page(page *indexer.Page, columns ...string) string {
+	dir := " ASC"
+	if page != nil && page.Desc {
+		dir = " DESC"
+	}
+	clause := "ORDER BY " + strings.Join(columns, dir+", ") + dir
+	if page != nil {
+		clause += " LIMIT " + s.arg(page.Limit) + " OFFSET " + s.arg(page.Offset)
+	}
+	return clause
+}
+
+// txSearchQuery translates q into a query selecting the tx_results of the
+// chain, joined with their block.
+func txSearchQuery(dialect *Dialect, chainID string, q *query.Query) (*sqlQuery, error) {
+	s := &sqlQuery{dialect: dialect}
+	s.conds = append(s.conds, "blocks.chain_id = "+s.arg(chainID))
+	cond, err := s.expr(q.Expr(), func(c query.Condition) (string, error) {
+		switch {
+		case c.CompositeKey == types.TxHeightKey && isComparison(c):
+			return s.compare("blocks.height", c)
+
+		case c.CompositeKey == types.TxHashKey && c.Op == query.OpEqual:
+			hash, ok := c.Operand.(string)
+			if !ok {
+				return "", fmt.Errorf("%s must be compared to a string", types.TxHashKey)
+			}
+			return "tx_results.tx_hash = " + s.arg(strings.ToUpper(hash)), nil
+
+		default:
+			return s.attributeExists("events.tx_id = tx_results.rowid", c)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.conds = append(s.conds, cond)
+	return s, nil
+}
+
+// blockSearchQuery translates q into a query selecting the blocks of the
+// chain.
+func blockSearchQuery(dialect *Dialect, chainID string, q *query.Query) (*sqlQuery, error) {
+	s := &sqlQuery{dialect: dialect}
+	s.conds = append(s.conds, "blocks.chain_id = "+s.arg(chainID))
+	cond, err := s.expr(q.Expr(), func(c query.Condition) (string, error) {
+		if c.CompositeKey == types.BlockHeightKey && isComparison(c) {
+			return s.compare("blocks.height", c)
+		}
+		return s.attributeExists("events.block_id = blocks.rowid AND events.tx_id IS NULL", c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.conds = append(s.conds, cond)
+	return s, nil
+}
+
+// expr translates e into a condition, translating its conditions with cond.
+func (s *sqlQuery) expr(e *query.Expr, cond func(query.Condition) (string, error)) (string, error) {
+	var sep string
+	switch e.Op {
+	case query.ExprCondition:
+		return cond(e.Condition)
+	case query.ExprNot:
+		arg, err := s.expr(e.Args[0], cond)
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + arg + ")", nil
+	case query.ExprAnd:
+		sep = " AND "
+	case query.ExprOr:
+		sep = " OR "
+	default:
+		return "", fmt.Errorf("unexpected query expression %v", e.Op)
+	}
+
+	args := make([]string, 0, len(e.Args))
+	for _, a := range e.Args {
+		arg, err := s.expr(a, cond)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, arg)
+	}
+	return "(" + strings.Join(args, sep) + ")", nil
+}
+
+// isComparison returns true if the condition compares the key to a number,
+// which is how the heights are queried.
+func isComparison(c query.Condition) bool {
+	_, ok := c.Operand.(int64)
+	return ok && c.Op != query.OpContains && c.Op != query.OpExists
+}
+
+// attributeExists returns a condition selecting the rows for which an event,
+// selected by eventCond, has an attribute matching c.
+func (s *sqlQuery) attributeExists(eventCond string, c query.Condition) (string, error) {
+	cond := `EXISTS (SELECT 1 FROM ` + tableEvents + ` JOIN ` + tableAttributes + `
+    ON (events.rowid = attributes.event_id)
+    WHERE ` + eventCond + ` AND attributes.composite_key = ` + s.arg(c.CompositeKey)
+	if c.Op != query.OpExists {
+		valueCond, err := s.compare(s.attributeValue(c.Operand), c)
+		if err != nil {
+			return "", err
+		}
+		cond += " AND " + valueCond
+	}
+	return cond + ")", nil
+}
+
+// attributeValue returns the expression converting the value of an
+// attribute for the comparison to operand.
+func (s *sqlQuery) attributeValue(operand interface{}) string {
+	const value = "attributes.value"
+	switch operand.(type) {
+	case int64, float64:
+		return s.dialect.Number(value)
+	case time.Time:
+		return s.dialect.Time(value)
+	default:
+		return value
+	}
+}
+
+// compare returns the condition comparing expr to the operand of c.
+func (s *sqlQuery) compare(expr string, c query.Condition) (string, error) {
+	if c.Op == query.OpContains {
+		operand, ok := c.Operand.(string)
+		if !ok {
+			return "", fmt.Errorf("%s: CONTAINS needs a string", c.CompositeKey)
+		}
+		return s.dialect.Contains(expr, s.arg(operand)), nil
+	}
+
+	var op string
+	switch c.Op {
+	case query.OpLessEqual:
+		op = "<="
+	case query.OpGreaterEqual:
+		op = ">="
+	case query.OpLess:
+		op = "<"
+	case query.OpGreater:
+		op = ">"
+	case query.OpEqual:
+		op = "="
+	default:
+		return "", fmt.Errorf("%s: unsupported operator %v", c.CompositeKey, c.Op)
+	}
+	operand := c.Operand
+	switch v := operand.(type) {
+	case string:
+		if op != "=" {
+			return "", fmt.Errorf("%s: strings can only be compared with = and CONTAINS", c.CompositeKey)
+		}
+	case time.Time:
+		if s.dialect.TimeOperand != nil {
+			operand = s.dialect.TimeOperand(v)
+		}
+	}
+	return expr + " " + op + " " + s.arg(operand), nil
+}
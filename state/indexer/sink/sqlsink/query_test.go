@@ -0,0 +1,60 @@
+package sqlsink
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+)
+
+var testDialect = Dialect{
+	Placeholder: func(n int) string { return fmt.Sprintf(":%d", n) },
+	Number:      func(expr string) string { return "number(" + expr + ")" },
+	Time:        func(expr string) string { return "time(" + expr + ")" },
+	TimeOperand: func(t time.Time) interface{} { return t.Unix() },
+	Contains:    func(expr, substr string) string { return "contains(" + expr + ", " + substr + ")" },
+}
+
+func TestTxSearchQuery(t *testing.T) {
+	q := query.MustParse(`tx.height > 5 AND tx.hash = 'ab' AND account.owner CONTAINS 'iv' AND ` +
+		`account.number <= 10 AND account.opened = TIME 2023-01-02T03:04:05Z`)
+	s, err := txSearchQuery(&testDialect, "test-chain", q)
+	require.NoError(t, err)
+	page := s.page(&indexer.Page{Offset: 20, Limit: 10, Desc: true}, "blocks.height", `tx_results."index"`)
+
+	assert.Equal(t, []interface{}{
+		"test-chain",
+		int64(5),
+		"AB",
+		"account.owner", "iv",
+		"account.number", int64(10),
+		"account.opened", time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC).Unix(),
+		10, 20,
+	}, s.args)
+	where := s.where()
+	assert.Contains(t, where, "blocks.chain_id = :1")
+	assert.Contains(t, where, "blocks.height > :2")
+	assert.Contains(t, where, "tx_results.tx_hash = :3")
+	assert.Contains(t, where, "contains(attributes.value, :5)")
+	assert.Contains(t, where, "number(attributes.value) <= :7")
+	assert.Contains(t, where, "time(attributes.value) = :9")
+	assert.Equal(t, `ORDER BY blocks.height DESC, tx_results."index" DESC LIMIT :10 OFFSET :11`, page)
+
+	_, err = txSearchQuery(&testDialect, "test-chain", query.MustParse(`tx.hash = 5`))
+	assert.Error(t, err)
+}
+
+func TestBlockSearchQuery(t *testing.T) {
+	s, err := blockSearchQuery(&testDialect, "test-chain",
+		query.MustParse(`block.height >= 2 AND NOT (reward.denom = 'stake' OR reward.amount EXISTS)`))
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"test-chain", int64(2), "reward.denom", "stake", "reward.amount"}, s.args)
+	assert.Contains(t, s.where(), "blocks.height >= :2")
+	assert.Contains(t, s.where(), "events.block_id = blocks.rowid AND events.tx_id IS NULL")
+	assert.Contains(t, s.where(), "NOT ((EXISTS")
+}
@@ -0,0 +1,169 @@
+// Package sqlsink implements the searches shared by the SQL event sinks,
+// psql and sqlite, on the schema they have in common. The parts of the
+// queries which differ between their databases are given by a Dialect.
+package sqlsink
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+)
+
+const (
+	tableBlocks     = "blocks"
+	tableTxResults  = "tx_results"
+	tableEvents     = "events"
+	tableAttributes = "attributes"
+)
+
+// Searcher searches the blocks and transactions of a chain recorded by an
+// event sink.
+type Searcher struct {
+	db      *sql.DB
+	chainID string
+	dialect *Dialect
+}
+
+// NewSearcher returns a searcher of the records of the chain in db, whose
+// queries are written in dialect.
+func NewSearcher(db *sql.DB, chainID string, dialect *Dialect) *Searcher {
+	return &Searcher{
+		db:      db,
+		chainID: chainID,
+		dialect: dialect,
+	}
+}
+
+// SearchBlocks returns the heights of the blocks whose events match q, in
+// ascending order or as given by page, and their total number. A nil page
+// selects all of them.
+func (s *Searcher) SearchBlocks(ctx context.Context, q *query.Query, page *indexer.Page) ([]int64, int, error) {
+	sq, err := blockSearchQuery(s.dialect, s.chainID, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	where := sq.where()
+	rows, err := s.db.QueryContext(ctx, `
+SELECT blocks.height, COUNT(*) OVER () FROM `+tableBlocks+`
+  `+where+`
+  `+sq.page(page, "blocks.height")+`;
+`, sq.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("searching blocks: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		heights = make([]int64, 0)
+		total   int
+	)
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height, &total); err != nil {
+			return nil, 0, fmt.Errorf("searching blocks: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("searching blocks: %w", err)
+	}
+	if len(heights) == 0 && page != nil && page.Offset > 0 {
+		// past the last page, which leaves no row to count
+		total, err = s.count(ctx, `SELECT COUNT(*) FROM `+tableBlocks+` `+where, sq.args[:len(sq.args)-2])
+	}
+	return heights, total, err
+}
+
+// SearchTxs returns the results of the transactions whose events match q,
+// ordered by height and index or as given by page, and their total number.
+// A nil page selects all of them.
+func (s *Searcher) SearchTxs(ctx context.Context, q *query.Query, page *indexer.Page) ([]*abci.TxResult, int, error) {
+	sq, err := txSearchQuery(s.dialect, s.chainID, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	from := `FROM ` + tableTxResults + ` JOIN ` + tableBlocks + ` ON (blocks.rowid = tx_results.block_id)
+  ` + sq.where()
+	rows, err := s.db.QueryContext(ctx, `
+SELECT tx_results.tx_result, COUNT(*) OVER () `+from+`
+  `+sq.page(page, "blocks.height", `tx_results."index"`)+`;
+`, sq.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("searching transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		results = make([]*abci.TxResult, 0)
+		total   int
+	)
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData, &total); err != nil {
+			return nil, 0, fmt.Errorf("searching transactions: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, 0, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		results = append(results, txr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("searching transactions: %w", err)
+	}
+	if len(results) == 0 && page != nil && page.Offset > 0 {
+		// past the last page, which leaves no row to count
+		total, err = s.count(ctx, `SELECT COUNT(*) `+from, sq.args[:len(sq.args)-2])
+	}
+	return results, total, err
+}
+
+// count runs a query counting rows.
+func (s *Searcher) count(ctx context.Context, query string, args []interface{}) (int, error) {
+	var n int
+	if err := s.db.QueryRowContext(ctx, query, args...).Scan(&n); err != nil {
+		return 0, fmt.Errorf("counting results: %w", err)
+	}
+	return n, nil
+}
+
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it is not indexed.
+func (s *Searcher) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	var resultData []byte
+	err := s.db.QueryRow(`
+SELECT tx_results.tx_result FROM `+tableTxResults+` JOIN `+tableBlocks+`
+  ON (blocks.rowid = tx_results.block_id)
+  WHERE tx_results.tx_hash = `+s.dialect.Placeholder(1)+` AND blocks.chain_id = `+s.dialect.Placeholder(2)+`
+  ORDER BY tx_results.rowid DESC LIMIT 1;
+`, fmt.Sprintf("%X", hash), s.chainID).Scan(&resultData)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("finding transaction: %w", err)
+	}
+
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(resultData, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
+}
+
+// HasBlock reports whether the block at height h has been indexed.
+func (s *Searcher) HasBlock(h int64) (bool, error) {
+	var found bool
+	if err := s.db.QueryRow(`
+SELECT EXISTS (SELECT 1 FROM `+tableBlocks+` WHERE height = `+s.dialect.Placeholder(1)+
+		` AND chain_id = `+s.dialect.Placeholder(2)+`);
+`, h, s.chainID).Scan(&found); err != nil {
+		return false, fmt.Errorf("finding block: %w", err)
+	}
+	return found, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 )
 
 // XXX/TODO: These types should be moved to the indexer package.
@@ -28,6 +29,24 @@ type TxIndexer interface {
 	Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error)
 }
 
+// PageSearcher is implemented by the TxIndexers which can order and paginate
+// the results of a search themselves, e.g. in a database, rather than
+// returning all of them.
+type PageSearcher interface {
+	// SearchPage returns the page of the transactions matching the query, and
+	// the total number of matching transactions.
+	SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]*abci.TxResult, int, error)
+}
+
+// OrderSearcher is implemented by the TxIndexers which can order the results
+// of a search by the values of an attribute.
+type OrderSearcher interface {
+	// SearchOrder returns the transactions matching the query and having the
+	// attribute, ordered by its values, up to limit transactions if it is
+	// positive.
+	SearchOrder(ctx context.Context, q *query.Query, order indexer.Order, limit int) ([]*abci.TxResult, error)
+}
+
 // Batch groups together multiple Index operations to be performed at the same time.
 // NOTE: Batch is NOT thread-safe and must not be modified after starting its execution.
 type Batch struct {
@@ -0,0 +1,39 @@
+package kv
+
+import (
+	"encoding/json"
+
+	"github.com/cometbft/cometbft/state/indexer"
+)
+
+// checkpointKey is the key of the checkpoint of a reindexing.
+var checkpointKey = []byte("reindex.checkpoint")
+
+var _ indexer.Checkpointer = (*TxIndex)(nil)
+
+// Checkpoint returns the checkpoint of the last reindexing, or nil if there is
+// none, as part of indexer.Checkpointer.
+func (txi *TxIndex) Checkpoint() (*indexer.Checkpoint, error) {
+	bz, err := txi.store.Get(checkpointKey)
+	if err != nil || bz == nil {
+		return nil, err
+	}
+	var cp indexer.Checkpoint
+	if err := json.Unmarshal(bz, &cp); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+// SetCheckpoint records the checkpoint of a reindexing, or deletes it if it is
+// nil, as part of indexer.Checkpointer.
+func (txi *TxIndex) SetCheckpoint(cp *indexer.Checkpoint) error {
+	if cp == nil {
+		return txi.store.DeleteSync(checkpointKey)
+	}
+	bz, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return txi.store.SetSync(checkpointKey, bz)
+}
@@ -31,13 +31,19 @@ type TxIndex struct {
 	store dbm.DB
 	// Number the events in the event list
 	eventSeq int64
+	// The attributes indexed in the order of their values
+	rangeIndexes indexer.RangeIndexes
 }
 
 // NewTxIndex creates new KV indexer.
-func NewTxIndex(store dbm.DB) *TxIndex {
-	return &TxIndex{
+func NewTxIndex(store dbm.DB, options ...Option) *TxIndex {
+	txi := &TxIndex{
 		store: store,
 	}
+	for _, option := range options {
+		option(txi)
+	}
+	return txi
 }
 
 // Get gets transaction from the TxIndex storage and returns it or nil if the
@@ -178,6 +184,9 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 				if err != nil {
 					return err
 				}
+				if err := txi.indexRange(compositeTag, attr.Value, result, hash, txi.eventSeq, store); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -191,8 +200,17 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 // condition, it queries the DB index. One special use cases here: (1) if
 // "tx.hash" is found, it returns tx result for it (2) for range queries it is
 // better for the client to provide both lower and upper bounds, so we are not
-// performing a full scan. Results from querying indexes are then intersected
-// and returned to the caller, in no particular order.
+// performing a full scan. The range conditions on the attributes with a range
+// index (see WithRangeIndexes) only scan the values within the range. Results
+// from querying indexes are then intersected and returned to the caller, in
+// no particular order.
+//
+// The conditions joined by AND are matched within the same event. The results
+// of OR and NOT, and the intersection of those with other results, are sets
+// of transactions (see searchExpr).
+//
+// If the index was pruned, the searches for heights below its retain height
+// fail with indexer.ErrPruned (see indexer.CheckRetainHeight).
 //
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
@@ -204,15 +222,179 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
-	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
+	retainHeight, err := txi.RetainHeight()
+	if err != nil {
+		return nil, err
+	}
+	if err := indexer.CheckRetainHeight(q, types.TxHeightKey, retainHeight); err != nil {
+		return nil, err
+	}
 
-	// get a list of conditions (like "tx.height > 5")
-	conditions, err := q.Conditions()
+	filteredHashes, err := txi.searchExpr(ctx, q.Expr())
 	if err != nil {
-		return nil, fmt.Errorf("error during parsing conditions from query: %w", err)
+		return nil, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(filteredHashes))
+	resultMap := make(map[string]struct{})
+RESULTS_LOOP:
+	for _, h := range filteredHashes {
+
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		hashString := string(h)
+		if _, ok := resultMap[hashString]; !ok {
+			resultMap[hashString] = struct{}{}
+			results = append(results, res)
+		}
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break RESULTS_LOOP
+		default:
+		}
 	}
 
+	return results, nil
+}
+
+// searchExpr returns the hashes of the transactions matching e. Each
+// conjunction of conditions is searched at once (see searchConditions), and
+// their results are combined as sets of transactions: a NOT is the set of
+// the transactions not matching its argument.
+func (txi *TxIndex) searchExpr(ctx context.Context, e *query.Expr) (map[string][]byte, error) {
+	if conditions, ok := e.Conjunction(); ok {
+		return txi.searchConditions(ctx, conditions)
+	}
+
+	switch e.Op {
+	case query.ExprOr:
+		union := make(map[string][]byte)
+		for _, arg := range e.Args {
+			hashes, err := txi.searchExpr(ctx, arg)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range hashes {
+				union[k] = v
+			}
+		}
+		return union, nil
+
+	case query.ExprAnd:
+		// The conditions are searched together, to match within the same
+		// event, and the negations are subtracted from the result rather than
+		// intersected with it.
+		var (
+			conditions []query.Condition
+			others     []*query.Expr
+			negations  []*query.Expr
+		)
+		for _, arg := range e.Args {
+			switch arg.Op {
+			case query.ExprCondition:
+				conditions = append(conditions, arg.Condition)
+			case query.ExprNot:
+				negations = append(negations, arg.Args[0])
+			default:
+				others = append(others, arg)
+			}
+		}
+
+		var result map[string][]byte
+		if len(conditions) > 0 {
+			hashes, err := txi.searchConditions(ctx, conditions)
+			if err != nil {
+				return nil, err
+			}
+			result = hashes
+		}
+		for _, arg := range others {
+			if result != nil && len(result) == 0 {
+				return result, nil
+			}
+			hashes, err := txi.searchExpr(ctx, arg)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				result = hashes
+				continue
+			}
+			for k := range result {
+				if _, ok := hashes[k]; !ok {
+					delete(result, k)
+				}
+			}
+		}
+		if result == nil {
+			result = txi.allHashes(ctx)
+		}
+		for _, arg := range negations {
+			if len(result) == 0 {
+				break
+			}
+			hashes, err := txi.searchExpr(ctx, arg)
+			if err != nil {
+				return nil, err
+			}
+			for k := range hashes {
+				delete(result, k)
+			}
+		}
+		return result, nil
+
+	case query.ExprNot:
+		hashes, err := txi.searchExpr(ctx, e.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		result := txi.allHashes(ctx)
+		for k := range hashes {
+			delete(result, k)
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected query expression %v", e.Op)
+	}
+}
+
+// allHashes returns the hashes of all the indexed transactions.
+func (txi *TxIndex) allHashes(ctx context.Context) map[string][]byte {
+	hashes := make(map[string][]byte)
+
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey))
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+LOOP:
+	for ; it.Valid(); it.Next() {
+		hashes[string(it.Value())] = it.Value()
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break LOOP
+		default:
+		}
+	}
+	if err := it.Error(); err != nil {
+		panic(err)
+	}
+	return hashes
+}
+
+// searchConditions returns the hashes of the transactions matching all the
+// conditions, within the same event.
+func (txi *TxIndex) searchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
+	var hashesInitialized bool
+	filteredHashes := make(map[string][]byte)
+
 	// if there is a hash condition, return the result immediately
 	hash, ok, err := lookForHash(conditions)
 	if err != nil {
@@ -221,12 +403,11 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		res, err := txi.Get(hash)
 		switch {
 		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+			return nil, fmt.Errorf("error while retrieving the result: %w", err)
+		case res != nil:
+			filteredHashes[string(hash)] = hash
 		}
+		return filteredHashes, nil
 	}
 
 	// conditions to skip because they're handled before "everything else"
@@ -258,17 +439,21 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 			if qr.Key == types.TxHeightKey && !heightInfo.onlyHeightRange {
 				continue
 			}
-			if !hashesInitialized {
-				filteredHashes = txi.matchRange(ctx, qr, startKey(qr.Key), filteredHashes, true, heightInfo)
-				hashesInitialized = true
-
-				// Ignore any remaining conditions if the first condition resulted
-				// in no matches (assuming implicit AND operand).
-				if len(filteredHashes) == 0 {
-					break
+			firstRun := !hashesInitialized
+			if typ, ok := txi.rangeIndexes[qr.Key]; ok {
+				filteredHashes, err = txi.matchRangeIndex(ctx, typ, qr, filteredHashes, firstRun, heightInfo)
+				if err != nil {
+					return nil, err
 				}
 			} else {
-				filteredHashes = txi.matchRange(ctx, qr, startKey(qr.Key), filteredHashes, false, heightInfo)
+				filteredHashes = txi.matchRange(ctx, qr, startKey(qr.Key), filteredHashes, firstRun, heightInfo)
+			}
+			hashesInitialized = true
+
+			// Ignore any remaining conditions if the first condition resulted
+			// in no matches (assuming implicit AND operand).
+			if firstRun && len(filteredHashes) == 0 {
+				break
 			}
 		}
 	}
@@ -295,29 +480,12 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	resultMap := make(map[string]struct{})
-RESULTS_LOOP:
+	// the matches are keyed by hash and event sequence
+	hashes := make(map[string][]byte, len(filteredHashes))
 	for _, h := range filteredHashes {
-
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		hashString := string(h)
-		if _, ok := resultMap[hashString]; !ok {
-			resultMap[hashString] = struct{}{}
-			results = append(results, res)
-		}
-		// Potentially exit early.
-		select {
-		case <-ctx.Done():
-			break RESULTS_LOOP
-		default:
-		}
+		hashes[string(h)] = h
 	}
-
-	return results, nil
+	return hashes, nil
 }
 
 func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
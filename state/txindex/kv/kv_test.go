@@ -15,6 +15,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
@@ -582,6 +583,269 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchExpr(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "owner", Value: "Ivan", Index: true},
+			{Key: "number", Value: "1", Index: true},
+		}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "10", Index: true}}},
+	})
+	txResult.Tx = types.Tx("Ivan's transfer")
+	require.NoError(t, indexer.Index(txResult))
+
+	txResult2 := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "owner", Value: "Vlad", Index: true},
+			{Key: "number", Value: "2", Index: true},
+		}},
+	})
+	txResult2.Tx = types.Tx("Vlad's account")
+	txResult2.Height = 2
+	require.NoError(t, indexer.Index(txResult2))
+
+	// the owner and the number are in different events
+	txResult3 := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{{Key: "owner", Value: "Ivan", Index: true}}},
+		{Type: "account", Attributes: []abci.EventAttribute{{Key: "number", Value: "3", Index: true}}},
+	})
+	txResult3.Tx = types.Tx("Ivan's account")
+	txResult3.Height = 3
+	require.NoError(t, indexer.Index(txResult3))
+
+	testCases := []struct {
+		q   string
+		txs []string
+	}{
+		{"account.owner = 'Vlad' OR account.number = 3", []string{"Vlad's account", "Ivan's account"}},
+		{"account.owner IN ('Ivan', 'Vlad')", []string{"Ivan's transfer", "Vlad's account", "Ivan's account"}},
+		{"account.number IN (2)", []string{"Vlad's account"}},
+		{"NOT account.owner = 'Ivan'", []string{"Vlad's account"}},
+		{"account.owner = 'Ivan' AND NOT transfer.amount EXISTS", []string{"Ivan's account"}},
+		// conditions joined by AND match within the same event...
+		{"account.owner = 'Ivan' AND account.number = 3", []string{}},
+		// ...but OR applies to whole transactions
+		{"account.owner = 'Ivan' AND (account.number = 3 OR tx.height = 1)", []string{"Ivan's transfer", "Ivan's account"}},
+		{"tx.height > 1 AND NOT (account.number = 2)", []string{"Ivan's account"}},
+		{fmt.Sprintf("tx.hash = '%X' OR account.number = 2", types.Tx("Ivan's transfer").Hash()), []string{"Ivan's transfer", "Vlad's account"}},
+		{"NOT (account.owner EXISTS OR transfer.amount EXISTS)", []string{}},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(ctx, query.MustParse(tc.q))
+			require.NoError(t, err)
+
+			txs := make([]string, 0, len(results))
+			for _, txr := range results {
+				txs = append(txs, string(txr.Tx))
+			}
+			assert.ElementsMatch(t, tc.txs, txs)
+		})
+	}
+}
+
+func TestTxIndexPrune(t *testing.T) {
+	store := db.NewMemDB()
+	txi := NewTxIndex(store)
+
+	accountEvents := func(number int64) []abci.Event {
+		return []abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: "number", Value: fmt.Sprintf("%d", number), Index: true},
+				{Key: "owner", Value: "Ivan", Index: true},
+			}},
+		}
+	}
+	for h := int64(1); h <= 400; h++ {
+		txResult := txResultWithEvents(accountEvents(h))
+		txResult.Tx = types.Tx(fmt.Sprintf("tx-%d", h))
+		txResult.Height = h
+		require.NoError(t, txi.Index(txResult))
+	}
+
+	// a transaction which failed, and was indexed again when it succeeded
+	retried := txResultWithEvents(accountEvents(1000))
+	retried.Tx = types.Tx("retried")
+	retried.Height = 100
+	retried.Index = 1
+	retried.Result.Code = 1
+	require.NoError(t, txi.Index(retried))
+	retried = txResultWithEvents(accountEvents(1000))
+	retried.Tx = types.Tx("retried")
+	retried.Height = 380
+	retried.Index = 1
+	require.NoError(t, txi.Index(retried))
+
+	retainHeight, err := txi.RetainHeight()
+	require.NoError(t, err)
+	assert.Zero(t, retainHeight)
+
+	// an interrupted pruning already rejects the searches for pruned heights,
+	// and is resumed by the next one
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, txi.Prune(ctx, 351), context.Canceled)
+	_, err = txi.Search(context.Background(), query.MustParse("tx.height = 5"))
+	require.ErrorIs(t, err, indexer.ErrPruned)
+
+	require.NoError(t, txi.Prune(context.Background(), 351))
+	retainHeight, err = txi.RetainHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 351, retainHeight)
+
+	res, err := txi.Get(types.Tx("tx-350").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, res)
+	res, err = txi.Get(types.Tx("tx-351").Hash())
+	require.NoError(t, err)
+	assert.NotNil(t, res)
+	res, err = txi.Get(types.Tx("retried").Hash())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(retried, res))
+
+	// no key of the pruned heights is left
+	it, err := store.Iterator(nil, nil)
+	require.NoError(t, err)
+	for ; it.Valid(); it.Next() {
+		if !isTagKey(it.Key()) {
+			continue
+		}
+		height, err := extractHeightFromKey(it.Key())
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, height, int64(351), string(it.Key()))
+	}
+	require.NoError(t, it.Close())
+
+	testCases := []struct {
+		q             string
+		resultsLength int
+		pruned        bool
+	}{
+		{"account.owner = 'Ivan'", 51, false},
+		{"account.number <= 350", 0, false},
+		{"tx.height >= 351", 51, false},
+		{"tx.height = 380", 2, false},
+		{"tx.height = 380 OR tx.height = 5", 2, false},
+		{"tx.height = 5", 0, true},
+		{"tx.height < 400", 0, true},
+		{"tx.height > 300 AND account.owner = 'Ivan'", 0, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := txi.Search(context.Background(), query.MustParse(tc.q))
+			if tc.pruned {
+				require.ErrorIs(t, err, indexer.ErrPruned)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, results, tc.resultsLength)
+		})
+	}
+
+	// the retain height is never lowered
+	require.NoError(t, txi.Prune(context.Background(), 10))
+	retainHeight, err = txi.RetainHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 351, retainHeight)
+}
+
+func TestTxSearchRangeIndex(t *testing.T) {
+	store := db.NewMemDB()
+	txi := NewTxIndex(store, WithRangeIndexes(indexer.RangeIndexes{
+		"transfer.amount":  indexer.RangeIndexDecimal,
+		"transfer.fee":     indexer.RangeIndexInt,
+		"auction.deadline": indexer.RangeIndexTime,
+	}))
+
+	transfer := func(height int64, amount, fee, deadline string) {
+		require.NoError(t, txi.Index(&abci.TxResult{
+			Height: height,
+			Tx:     types.Tx(fmt.Sprintf("tx-%d", height)),
+			Result: abci.ResponseDeliverTx{Events: []abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: amount, Index: true},
+					{Key: "fee", Value: fee, Index: true},
+				}},
+				{Type: "auction", Attributes: []abci.EventAttribute{
+					{Key: "deadline", Value: deadline, Index: true},
+				}},
+			}},
+		}))
+	}
+	// e.g. 100.1stake, 200.2stake, ... 2000.20stake
+	for h := int64(1); h <= 20; h++ {
+		transfer(h, fmt.Sprintf("%d.%dstake", h*100, h), fmt.Sprintf("%d", h), fmt.Sprintf("2023-01-%02d", h))
+	}
+	transfer(21, "99999999999999999999999.5stake", "21", "2023-01-21T12:00:00Z")
+	// not in the range indexes
+	transfer(22, "many", "none", "never")
+
+	heights := func(results []*abci.TxResult) []int64 {
+		heights := make([]int64, 0, len(results))
+		for _, txr := range results {
+			heights = append(heights, txr.Height)
+		}
+		return heights
+	}
+
+	testCases := []struct {
+		q       string
+		heights []int64
+	}{
+		{"transfer.amount > 1800", []int64{18, 19, 20, 21}},
+		{"transfer.amount >= 500.5 AND transfer.amount < 800", []int64{5, 6, 7}},
+		{"transfer.amount > 1 AND transfer.amount <= 200.2", []int64{1, 2}},
+		{"transfer.fee > 2.5 AND transfer.fee <= 4", []int64{3, 4}},
+		{"transfer.fee >= 20", []int64{20, 21}},
+		{"auction.deadline >= DATE 2023-01-19", []int64{19, 20, 21}},
+		{"auction.deadline > TIME 2023-01-21T00:00:00Z", []int64{21}},
+		{"transfer.amount > 1800 AND tx.height < 20", []int64{18, 19}},
+		{"transfer.amount > 1000 AND transfer.fee < 12", []int64{10, 11}},
+		{"transfer.amount > 1800 OR transfer.fee = 'none'", []int64{18, 19, 20, 21, 22}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := txi.Search(context.Background(), query.MustParse(tc.q))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.heights, heights(results))
+		})
+	}
+
+	_, err := txi.Search(context.Background(), query.MustParse("auction.deadline > 5"))
+	require.Error(t, err)
+
+	// ordered searches
+	results, err := txi.SearchOrder(context.Background(), query.MustParse("transfer.amount > 1000"),
+		indexer.Order{Key: "transfer.amount", Desc: true}, 3)
+	require.NoError(t, err)
+	require.Equal(t, []int64{21, 20, 19}, heights(results))
+
+	results, err = txi.SearchOrder(context.Background(), query.MustParse("transfer.fee < 5 OR tx.height = 22"),
+		indexer.Order{Key: "auction.deadline"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4}, heights(results))
+
+	_, err = txi.SearchOrder(context.Background(), query.MustParse("transfer.fee < 5"),
+		indexer.Order{Key: "transfer.recipient"}, 0)
+	require.Error(t, err)
+
+	// the range keys are pruned with the others
+	require.NoError(t, txi.Prune(context.Background(), 20))
+	results, err = txi.SearchOrder(context.Background(), query.MustParse("transfer.amount EXISTS"),
+		indexer.Order{Key: "transfer.amount"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{20, 21}, heights(results))
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
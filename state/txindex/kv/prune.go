@@ -0,0 +1,153 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/state/indexer"
+)
+
+// pruneBatchSize is the maximum number of keys deleted at once by Prune.
+const pruneBatchSize = 1000
+
+// retainHeightKey is the key of the height below which the index was pruned.
+var retainHeightKey = []byte("tx.retain_height")
+
+var _ indexer.Pruner = (*TxIndex)(nil)
+
+// RetainHeight returns the height below which the index was pruned, or 0 if
+// it never was, as part of indexer.Pruner.
+func (txi *TxIndex) RetainHeight() (int64, error) {
+	bz, err := txi.store.Get(retainHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(bz), 10, 64)
+}
+
+// Prune deletes the transactions indexed for the heights below retainHeight,
+// and the keys of their events, as part of indexer.Pruner. The searches for
+// these heights fail with indexer.ErrPruned as soon as it is called.
+//
+// The event keys only have the height after the event value, so Prune goes
+// through all the keys of the index, deleting at most pruneBatchSize of them
+// at once.
+func (txi *TxIndex) Prune(ctx context.Context, retainHeight int64) error {
+	current, err := txi.RetainHeight()
+	if err != nil {
+		return err
+	}
+	if retainHeight > current {
+		err := txi.store.SetSync(retainHeightKey, []byte(strconv.FormatInt(retainHeight, 10)))
+		if err != nil {
+			return err
+		}
+	}
+
+	var start []byte
+	for {
+		next, err := txi.pruneBatch(start, retainHeight)
+		if err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+}
+
+// pruneBatch deletes up to pruneBatchSize keys of the heights below
+// retainHeight, going through the keys from start. It returns the key to
+// continue from, or nil if it reached the end of the index.
+func (txi *TxIndex) pruneBatch(start []byte, retainHeight int64) ([]byte, error) {
+	keys, hashes, next, err := txi.keysToPrune(start, retainHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	b := txi.store.NewBatch()
+	defer b.Close()
+
+	for _, key := range keys {
+		if err := b.Delete(key); err != nil {
+			return nil, err
+		}
+	}
+
+	// A transaction indexed again at a later height, e.g. after it failed,
+	// is kept.
+	for _, hash := range hashes {
+		res, err := txi.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil && res.Height < retainHeight {
+			if err := b.Delete(hash); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := b.WriteSync(); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// keysToPrune returns up to pruneBatchSize keys of the events and heights of
+// the transactions below retainHeight, from start, with the hashes of the
+// transactions, and the key to continue from.
+//
+// NOTE: the store is only written once the iterator is closed.
+func (txi *TxIndex) keysToPrune(start []byte, retainHeight int64) (keys, hashes [][]byte, next []byte, err error) {
+	it, err := txi.store.Iterator(start, nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("creating iterator: %w", err)
+	}
+	defer it.Close()
+
+	seen := make(map[string]struct{})
+	for ; it.Valid(); it.Next() {
+		if len(keys) == pruneBatchSize {
+			return keys, hashes, append([]byte{}, it.Key()...), nil
+		}
+
+		key, value := it.Key(), it.Value()
+		if height, _, err := parseRangeKey(key); err == nil {
+			if height < retainHeight {
+				keys = append(keys, append([]byte{}, key...))
+			}
+			continue
+		}
+
+		// The height and event keys point to the hash of a transaction. It
+		// excludes the results, keyed by their hash, and the block events
+		// sharing the store.
+		if !isTagKey(key) || len(value) != tmhash.Size {
+			continue
+		}
+		height, err := extractHeightFromKey(key)
+		if err != nil || height >= retainHeight {
+			continue
+		}
+
+		keys = append(keys, append([]byte{}, key...))
+		if _, ok := seen[string(value)]; !ok {
+			seen[string(value)] = struct{}{}
+			hashes = append(hashes, append([]byte{}, value...))
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, nil, err
+	}
+	return keys, hashes, nil, nil
+}
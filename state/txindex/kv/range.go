@@ -0,0 +1,283 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/orderedcode"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/types"
+)
+
+// rangeKeyPrefix starts the keys of the range indexes. Having no dot, it is
+// not the composite key of an event attribute.
+const rangeKeyPrefix = "range"
+
+var _ txindex.OrderSearcher = (*TxIndex)(nil)
+
+// Option sets an optional parameter on the TxIndex.
+type Option func(*TxIndex)
+
+// WithRangeIndexes indexes the values of the attributes of indexes in the
+// order of their types, besides their strings. The range conditions on these
+// attributes then only scan the values within the range, and the results of
+// a search can be ordered by them (see SearchOrder).
+//
+// The transactions indexed before an attribute was added are only in its
+// range index once indexed again, e.g. with the reindex-event command.
+func WithRangeIndexes(indexes indexer.RangeIndexes) Option {
+	return func(txi *TxIndex) { txi.rangeIndexes = indexes }
+}
+
+// indexRange indexes the value of an attribute in its range index, if it
+// has one. The values which are not of the type of the index are skipped.
+func (txi *TxIndex) indexRange(
+	compositeKey, value string,
+	result *abci.TxResult,
+	hash []byte,
+	eventSeq int64,
+	store dbm.Batch,
+) error {
+	typ, ok := txi.rangeIndexes[compositeKey]
+	if !ok {
+		return nil
+	}
+	encoded, err := typ.EncodeValue(value)
+	if err != nil {
+		return nil
+	}
+	key, err := orderedcode.Append(nil, rangeKeyPrefix, compositeKey, string(encoded),
+		result.Height, int64(result.Index), eventSeq)
+	if err != nil {
+		return fmt.Errorf("creating range index key: %w", err)
+	}
+	return store.Set(key, hash)
+}
+
+// SearchOrder returns the transactions matching the query and having the
+// attribute of order, ordered by its values and then by height, up to limit
+// transactions if it is positive, as part of txindex.OrderSearcher. The
+// attribute must have a range index.
+//
+// A query only made of range conditions on the attribute, e.g.
+// "transfer.amount > 1000", is searched by scanning the range index, up to
+// the limit. The other queries are searched first, and their results are
+// then ordered.
+func (txi *TxIndex) SearchOrder(
+	ctx context.Context,
+	q *query.Query,
+	order indexer.Order,
+	limit int,
+) ([]*abci.TxResult, error) {
+	typ, ok := txi.rangeIndexes[order.Key]
+	if !ok {
+		return nil, fmt.Errorf("can't order by %s, which has no range index", order.Key)
+	}
+
+	retainHeight, err := txi.RetainHeight()
+	if err != nil {
+		return nil, err
+	}
+	if err := indexer.CheckRetainHeight(q, types.TxHeightKey, retainHeight); err != nil {
+		return nil, err
+	}
+
+	var (
+		bounds   indexer.RangeBounds
+		filtered map[string][]byte
+	)
+	if qr, ok := rangeOf(q, order.Key); ok {
+		if bounds, err = typ.Bounds(qr); err != nil {
+			return nil, err
+		}
+	} else if filtered, err = txi.searchExpr(ctx, q.Expr()); err != nil {
+		return nil, err
+	}
+
+	// The results are only read once the iterator is closed.
+	var hashes [][]byte
+	err = iterateRange(txi.store, order.Key, bounds, order.Desc, func(it dbm.Iterator) bool {
+		if filtered != nil {
+			if _, ok := filtered[string(it.Value())]; !ok {
+				return true
+			}
+			// a transaction is ordered by the first of its values
+			delete(filtered, string(it.Value()))
+		}
+		hashes = append(hashes, append([]byte{}, it.Value()...))
+		return ctx.Err() == nil && (limit <= 0 || len(hashes) < limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(hashes))
+	seen := make(map[string]struct{}, len(hashes))
+	for _, hash := range hashes {
+		if _, ok := seen[string(hash)]; ok {
+			continue
+		}
+		seen[string(hash)] = struct{}{}
+
+		res, err := txi.Get(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", hash, err)
+		}
+		if res != nil {
+			results = append(results, res)
+		}
+	}
+	return results, nil
+}
+
+// matchRangeIndex is matchRange for an attribute with a range index, only
+// scanning the keys of the values within qr.
+func (txi *TxIndex) matchRangeIndex(
+	ctx context.Context,
+	typ indexer.RangeIndexType,
+	qr indexer.QueryRange,
+	filteredHashes map[string][]byte,
+	firstRun bool,
+	heightInfo HeightInfo,
+) (map[string][]byte, error) {
+	// A previous match was attempted but resulted in no matches, so we return
+	// no matches (assuming AND operand).
+	if !firstRun && len(filteredHashes) == 0 {
+		return filteredHashes, nil
+	}
+
+	bounds, err := typ.Bounds(qr)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpHashes := make(map[string][]byte)
+	err = iterateRange(txi.store, qr.Key, bounds, false, func(it dbm.Iterator) bool {
+		height, eventSeq, err := parseRangeKey(it.Key())
+		if err == nil && checkHeightConditions(heightInfo, height) {
+			tmpHashes[string(it.Value())+strconv.FormatInt(eventSeq, 10)] = it.Value()
+		}
+		return ctx.Err() == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tmpHashes) == 0 || firstRun {
+		return tmpHashes, nil
+	}
+
+	// Remove/reduce matches in filteredHashes that were not found in this
+	// match (tmpHashes).
+	for k, v := range filteredHashes {
+		if tmpHash := tmpHashes[k]; tmpHash == nil || !bytes.Equal(tmpHash, v) {
+			delete(filteredHashes, k)
+		}
+	}
+	return filteredHashes, nil
+}
+
+// rangeOf returns the range of the values of the attribute selected by the
+// query, if it is only made of range or EXISTS conditions on the attribute.
+func rangeOf(q *query.Query, compositeKey string) (indexer.QueryRange, bool) {
+	conditions, ok := q.Expr().Conjunction()
+	if !ok {
+		return indexer.QueryRange{}, false
+	}
+	for _, c := range conditions {
+		if c.CompositeKey != compositeKey || !(indexer.IsRangeOperation(c.Op) || c.Op == query.OpExists) {
+			return indexer.QueryRange{}, false
+		}
+	}
+	ranges, _, _ := indexer.LookForRangesWithHeight(conditions)
+	return ranges[compositeKey], true
+}
+
+// iterateRange calls fn with the keys of the range index of the attribute
+// whose values are within bounds, in the order of the values, until it
+// returns false.
+func iterateRange(
+	store dbm.DB,
+	compositeKey string,
+	bounds indexer.RangeBounds,
+	desc bool,
+	fn func(it dbm.Iterator) bool,
+) error {
+	start, end, err := rangeScan(compositeKey, bounds)
+	if err != nil {
+		return err
+	}
+
+	var it dbm.Iterator
+	if desc {
+		it, err = store.ReverseIterator(start, end)
+	} else {
+		it, err = store.Iterator(start, end)
+	}
+	if err != nil {
+		return fmt.Errorf("creating iterator: %w", err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var value string
+		if _, err := orderedcode.Parse(string(it.Key()), new(string), new(string), &value); err != nil {
+			continue
+		}
+		if !bounds.Contains([]byte(value)) {
+			continue
+		}
+		if !fn(it) {
+			break
+		}
+	}
+	return it.Error()
+}
+
+// rangeScan returns the keys to scan the values of an attribute within
+// bounds, from start included to end excluded.
+func rangeScan(compositeKey string, bounds indexer.RangeBounds) (start, end []byte, err error) {
+	prefix, err := orderedcode.Append(nil, rangeKeyPrefix, compositeKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start = prefix
+	if bounds.Lower != nil {
+		if start, err = orderedcode.Append(nil, rangeKeyPrefix, compositeKey, string(bounds.Lower)); err != nil {
+			return nil, nil, err
+		}
+	}
+	end = prefix
+	if bounds.Upper != nil {
+		if end, err = orderedcode.Append(nil, rangeKeyPrefix, compositeKey, string(bounds.Upper)); err != nil {
+			return nil, nil, err
+		}
+	}
+	// The encoded strings end with 0x01, and the keys of the values equal to
+	// the upper bound are before the key incrementing it.
+	end = append(end[:len(end)-1:len(end)-1], end[len(end)-1]+1)
+	return start, end, nil
+}
+
+// parseRangeKey returns the height and the event sequence of a key of a range
+// index.
+func parseRangeKey(key []byte) (height, eventSeq int64, err error) {
+	var (
+		prefix, compositeKey, value string
+		index                       int64
+	)
+	_, err = orderedcode.Parse(string(key), &prefix, &compositeKey, &value, &height, &index, &eventSeq)
+	if err == nil && prefix != rangeKeyPrefix {
+		err = fmt.Errorf("not a range index key")
+	}
+	return height, eventSeq, err
+}
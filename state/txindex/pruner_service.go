@@ -0,0 +1,106 @@
+package txindex
+
+import (
+	"context"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/state/indexer"
+)
+
+// PrunerService prunes, in the background, the transaction and block indexers
+// which implement indexer.Pruner, keeping the retainBlocks latest heights.
+type PrunerService struct {
+	service.BaseService
+
+	txIdxr       TxIndexer
+	blockIdxr    indexer.BlockIndexer
+	retainBlocks int64
+	interval     time.Duration
+	latestHeight func() int64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPrunerService returns a new service instance, pruning the indexers
+// every interval up to latestHeight() - retainBlocks + 1.
+func NewPrunerService(
+	txIdxr TxIndexer,
+	blockIdxr indexer.BlockIndexer,
+	retainBlocks int64,
+	interval time.Duration,
+	latestHeight func() int64,
+) *PrunerService {
+	ps := &PrunerService{
+		txIdxr:       txIdxr,
+		blockIdxr:    blockIdxr,
+		retainBlocks: retainBlocks,
+		interval:     interval,
+		latestHeight: latestHeight,
+	}
+	ps.BaseService = *service.NewBaseService(nil, "IndexerPruner", ps)
+	return ps
+}
+
+// OnStart implements service.Service by starting to prune in the background.
+func (ps *PrunerService) OnStart() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	ps.cancel = cancel
+	ps.done = make(chan struct{})
+
+	go func() {
+		defer close(ps.done)
+
+		ticker := time.NewTicker(ps.interval)
+		defer ticker.Stop()
+
+		var pruned int64
+		for {
+			if retainHeight := ps.latestHeight() - ps.retainBlocks + 1; retainHeight > pruned {
+				if err := ps.Prune(ctx, retainHeight); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					ps.Logger.Error("failed to prune the indexers", "retain_height", retainHeight, "err", err)
+				} else {
+					pruned = retainHeight
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// OnStop implements service.Service by interrupting the pruning.
+func (ps *PrunerService) OnStop() {
+	ps.cancel()
+	<-ps.done
+}
+
+// Prune prunes the indexers up to retainHeight, which is a no-op for those
+// not implementing indexer.Pruner.
+func (ps *PrunerService) Prune(ctx context.Context, retainHeight int64) error {
+	if retainHeight <= 1 {
+		return nil
+	}
+	for _, idxr := range []interface{}{ps.txIdxr, ps.blockIdxr} {
+		pruner, ok := idxr.(indexer.Pruner)
+		if !ok {
+			continue
+		}
+		start := time.Now()
+		if err := pruner.Prune(ctx, retainHeight); err != nil {
+			return err
+		}
+		ps.Logger.Debug("pruned indexer", "retain_height", retainHeight, "took", time.Since(start))
+	}
+	ps.Logger.Info("pruned indexers", "retain_height", retainHeight)
+	return nil
+}
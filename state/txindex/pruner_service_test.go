@@ -0,0 +1,91 @@
+package txindex_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	db "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestPrunerServicePrunesIndexers(t *testing.T) {
+	// the indexers share the store, as in the node
+	store := db.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+
+	for h := int64(1); h <= 10; h++ {
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: h},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{{Type: "end_event", Attributes: []abci.EventAttribute{
+					{Key: "foo", Value: fmt.Sprintf("%d", h), Index: true},
+				}}},
+			},
+		}))
+		require.NoError(t, txIndexer.Index(&abci.TxResult{
+			Height: h,
+			Tx:     types.Tx(fmt.Sprintf("tx-%d", h)),
+			Result: abci.ResponseDeliverTx{Events: []abci.Event{{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: "number", Value: fmt.Sprintf("%d", h), Index: true},
+			}}}},
+		}))
+	}
+
+	service := txindex.NewPrunerService(txIndexer, blockIndexer, 4, 10*time.Millisecond, func() int64 { return 10 })
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	require.Eventually(t, func() bool {
+		txRetain, err := txIndexer.RetainHeight()
+		if err != nil {
+			return false
+		}
+		blockRetain, err := blockIndexer.RetainHeight()
+		return err == nil && txRetain == 7 && blockRetain == 7
+	}, time.Second, 10*time.Millisecond)
+
+	// the latest 4 heights are kept
+	res, err := txIndexer.Get(types.Tx("tx-6").Hash())
+	require.NoError(t, err)
+	require.Nil(t, res)
+	res, err = txIndexer.Get(types.Tx("tx-7").Hash())
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ok, err := blockIndexer.Has(6)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = blockIndexer.Has(7)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	txs, err := txIndexer.Search(context.Background(), query.MustParse("account.number EXISTS"))
+	require.NoError(t, err)
+	require.Len(t, txs, 4)
+	heights, err := blockIndexer.Search(context.Background(), query.MustParse("end_event.foo EXISTS"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 8, 9, 10}, heights)
+
+	_, err = txIndexer.Search(context.Background(), query.MustParse("tx.height > 2"))
+	require.ErrorIs(t, err, indexer.ErrPruned)
+	_, err = blockIndexer.Search(context.Background(), query.MustParse("block.height = 6"))
+	require.ErrorIs(t, err, indexer.ErrPruned)
+}
@@ -12,6 +12,7 @@ import (
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/light"
 	lightprovider "github.com/cometbft/cometbft/light/provider"
+	lightgrpc "github.com/cometbft/cometbft/light/provider/grpc"
 	lighthttp "github.com/cometbft/cometbft/light/provider/http"
 	lightrpc "github.com/cometbft/cometbft/light/rpc"
 	lightdb "github.com/cometbft/cometbft/light/store/db"
@@ -45,27 +46,44 @@ type lightClientStateProvider struct {
 }
 
 // NewLightClientStateProvider creates a new StateProvider using a light client and RPC clients.
+// If grpcServers is not empty, the light client fetches the light blocks from
+// the gRPC servers, grpcServers[i] being the one of the node serving servers[i],
+// and the RPC servers are only used for the consensus parameters.
 func NewLightClientStateProvider(
 	ctx context.Context,
 	chainID string,
 	version cmtstate.Version,
 	initialHeight int64,
 	servers []string,
+	grpcServers []string,
 	trustOptions light.TrustOptions,
 	logger log.Logger,
 ) (StateProvider, error) {
 	if len(servers) < 2 {
 		return nil, fmt.Errorf("at least 2 RPC servers are required, got %v", len(servers))
 	}
+	if len(grpcServers) != 0 && len(grpcServers) != len(servers) {
+		return nil, fmt.Errorf("expected %v gRPC servers, one per RPC server, got %v",
+			len(servers), len(grpcServers))
+	}
 
 	providers := make([]lightprovider.Provider, 0, len(servers))
 	providerRemotes := make(map[lightprovider.Provider]string)
-	for _, server := range servers {
-		client, err := rpcClient(server)
-		if err != nil {
-			return nil, fmt.Errorf("failed to set up RPC client: %w", err)
+	for i, server := range servers {
+		var provider lightprovider.Provider
+		if len(grpcServers) != 0 {
+			var err error
+			provider, err = lightgrpc.New(chainID, grpcServers[i])
+			if err != nil {
+				return nil, fmt.Errorf("failed to set up gRPC client: %w", err)
+			}
+		} else {
+			client, err := rpcClient(server)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set up RPC client: %w", err)
+			}
+			provider = lighthttp.NewWithClient(chainID, client)
 		}
-		provider := lighthttp.NewWithClient(chainID, client)
 		providers = append(providers, provider)
 		// We store the RPC addresses keyed by provider, so we can find the address of the primary
 		// provider used by the light client and use it to fetch consensus parameters.